@@ -0,0 +1,181 @@
+package goemoji
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/jo-hoe/goemoji/internal/phrase"
+)
+
+// binaryMagic starts every binary dictionary, followed by the version of the format.
+const (
+	binaryMagic   = "GOEMOJI"
+	binaryVersion = 1
+)
+
+// WriteBinaryDictionary writes the dictionary in the compact binary format read by
+// ReadBinaryDictionary. Keywords are sorted and emojis keep the order of the dictionary.
+//
+// The format is the magic "GOEMOJI", a version byte and the number of keywords,
+// followed by every keyword with the number of its emojis and for every emoji
+// the emoji, the source byte and the weight. Numbers are varints and strings
+// are prefixed with their length in bytes.
+func WriteBinaryDictionary(w io.Writer, dictionary Dictionary) error {
+	keywords := make([]string, 0)
+	dictionary.Range(func(keyword string, _ []string) bool {
+		keywords = append(keywords, keyword)
+		return true
+	})
+	slices.Sort(keywords)
+
+	data := append([]byte(binaryMagic), binaryVersion)
+	data = binary.AppendUvarint(data, uint64(len(keywords)))
+	for _, keyword := range keywords {
+		emojis, _ := lookupWeighted(dictionary, keyword)
+		data = appendString(data, keyword)
+		data = binary.AppendUvarint(data, uint64(len(emojis)))
+		for _, emoji := range emojis {
+			data = appendString(data, emoji.Emoji)
+			data = append(data, byte(emoji.Source))
+			data = binary.AppendVarint(data, int64(emoji.Weight))
+		}
+	}
+
+	_, err := w.Write(data)
+	return err
+}
+
+func appendString(data []byte, value string) []byte {
+	data = binary.AppendUvarint(data, uint64(len(value)))
+	return append(data, value...)
+}
+
+// ReadBinaryDictionary reads a Dictionary written by WriteBinaryDictionary.
+// The entries are used as they are, so loading does not need to sort or hash keywords.
+func ReadBinaryDictionary(reader io.Reader) (WeightedDictionary, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dictionary: %w", err)
+	}
+	if !IsBinaryDictionary(data) {
+		return nil, errors.New("failed to decode dictionary: not a binary dictionary")
+	}
+	if version := data[len(binaryMagic)]; version != binaryVersion {
+		return nil, fmt.Errorf("failed to decode dictionary: unsupported version %d", version)
+	}
+
+	decoder := binaryDecoder{data: data[len(binaryMagic)+1:]}
+	entries, err := decoder.entries()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode dictionary: %w", err)
+	}
+	return newTableDictionary(entries), nil
+}
+
+// IsBinaryDictionary reports whether the data starts like a dictionary written by WriteBinaryDictionary.
+func IsBinaryDictionary(data []byte) bool {
+	return len(data) > len(binaryMagic) && bytes.HasPrefix(data, []byte(binaryMagic))
+}
+
+// newTableDictionary creates a Dictionary from entries sorted by keyword.
+func newTableDictionary(entries []dictionaryEntry) *tableDictionary {
+	emojis := make([]string, 0)
+	maxLength := 0
+	for _, entry := range entries {
+		emojis = append(emojis, EmojiStrings(entry.emojis)...)
+		maxLength = max(maxLength, phrase.Length(entry.keyword))
+	}
+	slices.Sort(emojis)
+
+	return &tableDictionary{
+		entries:         entries,
+		emojis:          slices.Compact(emojis),
+		maxPhraseLength: maxLength,
+	}
+}
+
+type binaryDecoder struct {
+	data []byte
+}
+
+var errTruncated = errors.New("unexpected end of data")
+
+func (d *binaryDecoder) entries() ([]dictionaryEntry, error) {
+	count, err := d.count()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]dictionaryEntry, count)
+	for i := range entries {
+		if entries[i], err = d.entry(); err != nil {
+			return nil, err
+		}
+		if i > 0 && strings.Compare(entries[i-1].keyword, entries[i].keyword) >= 0 {
+			return nil, fmt.Errorf("keyword '%s' is not sorted", entries[i].keyword)
+		}
+	}
+	if len(d.data) > 0 {
+		return nil, fmt.Errorf("%d unexpected bytes after the last keyword", len(d.data))
+	}
+	return entries, nil
+}
+
+func (d *binaryDecoder) entry() (dictionaryEntry, error) {
+	keyword, err := d.string()
+	if err != nil {
+		return dictionaryEntry{}, err
+	}
+	count, err := d.count()
+	if err != nil {
+		return dictionaryEntry{}, err
+	}
+
+	emojis := make([]WeightedEmoji, count)
+	for i := range emojis {
+		if emojis[i].Emoji, err = d.string(); err != nil {
+			return dictionaryEntry{}, err
+		}
+		if len(d.data) == 0 {
+			return dictionaryEntry{}, errTruncated
+		}
+		emojis[i].Source = KeywordSource(d.data[0])
+		d.data = d.data[1:]
+		weight, n := binary.Varint(d.data)
+		if n <= 0 {
+			return dictionaryEntry{}, errTruncated
+		}
+		emojis[i].Weight = int(weight)
+		d.data = d.data[n:]
+	}
+	return dictionaryEntry{keyword: keyword, emojis: emojis}, nil
+}
+
+// count reads a number of items. As every item takes at least one byte,
+// larger numbers than the remaining bytes are rejected before allocating.
+func (d *binaryDecoder) count() (int, error) {
+	value, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		return 0, errTruncated
+	}
+	d.data = d.data[n:]
+	if value > uint64(len(d.data)) {
+		return 0, errTruncated
+	}
+	return int(value), nil
+}
+
+func (d *binaryDecoder) string() (string, error) {
+	length, err := d.count()
+	if err != nil {
+		return "", err
+	}
+	value := string(d.data[:length])
+	d.data = d.data[length:]
+	return value, nil
+}
@@ -0,0 +1,92 @@
+package goemoji
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestBinaryDictionary_RoundTrip(t *testing.T) {
+	dictionary := NewWeightedMapDictionary(map[string][]WeightedEmoji{
+		"apple":        {{"🍎", SourceDescription, WeightDescription}, {"🍏", SourceTag, WeightTag}},
+		"hot dog":      {{"🌭", SourceCustom, WeightCustom}},
+		"woman artist": {{"👩\u200d🎨", SourceUnknown, WeightUnknown}},
+	})
+
+	var buffer bytes.Buffer
+	if err := WriteBinaryDictionary(&buffer, dictionary); err != nil {
+		t.Fatalf("WriteBinaryDictionary() error = %v", err)
+	}
+	if !IsBinaryDictionary(buffer.Bytes()) {
+		t.Error("IsBinaryDictionary() = false, want true")
+	}
+
+	decoded, err := ReadBinaryDictionary(&buffer)
+	if err != nil {
+		t.Fatalf("ReadBinaryDictionary() error = %v", err)
+	}
+	for _, keyword := range []string{"apple", "hot dog", "woman artist"} {
+		want, _ := dictionary.LookupWeighted(keyword)
+		if got, ok := decoded.LookupWeighted(keyword); !ok || !reflect.DeepEqual(got, want) {
+			t.Errorf("LookupWeighted(%s) = %v, %v, want %v, true", keyword, got, ok, want)
+		}
+	}
+	if !decoded.ContainsEmoji("🍏") || decoded.ContainsEmoji("🍌") {
+		t.Error("ContainsEmoji() does not match the written emojis")
+	}
+	if got := decoded.MaxPhraseLength(); got != 2 {
+		t.Errorf("MaxPhraseLength() = %d, want 2", got)
+	}
+}
+
+func TestBinaryDictionary_DefaultDictionary(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := WriteBinaryDictionary(&buffer, defaultDictionary); err != nil {
+		t.Fatalf("WriteBinaryDictionary() error = %v", err)
+	}
+	decoded, err := ReadBinaryDictionary(&buffer)
+	if err != nil {
+		t.Fatalf("ReadBinaryDictionary() error = %v", err)
+	}
+	if !reflect.DeepEqual(decoded, defaultDictionary) {
+		t.Error("ReadBinaryDictionary() does not reproduce the default dictionary")
+	}
+}
+
+func TestReadBinaryDictionary_Invalid(t *testing.T) {
+	var valid bytes.Buffer
+	dictionary := NewMapDictionary(map[string][]string{"apple": {"🍎"}, "pear": {"🍐"}})
+	if err := WriteBinaryDictionary(&valid, dictionary); err != nil {
+		t.Fatalf("WriteBinaryDictionary() error = %v", err)
+	}
+	data := valid.Bytes()
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "JSON", data: []byte(`{"apple": ["🍎"]}`)},
+		{name: "unsupported version", data: append([]byte(binaryMagic), 99, 0)},
+		{name: "truncated", data: data[:len(data)-3]},
+		{name: "trailing bytes", data: append(bytes.Clone(data), 0)},
+		{name: "huge count", data: append([]byte(binaryMagic), binaryVersion, 0xff, 0xff, 0xff, 0x7f)},
+		{name: "unsorted", data: unsortedBinaryDictionary()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadBinaryDictionary(bytes.NewReader(tt.data)); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func unsortedBinaryDictionary() []byte {
+	data := append([]byte(binaryMagic), binaryVersion, 2)
+	for _, keyword := range []string{"pear", "apple"} {
+		data = appendString(data, keyword)
+		data = append(data, 0)
+	}
+	return data
+}
@@ -0,0 +1,229 @@
+package goemoji
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Country is a country or region with a flag emoji.
+type Country struct {
+	// Code is the ISO 3166-1 alpha-2 code, like "DE".
+	Code string
+	Name string
+}
+
+// countryAliases maps common alternative names of countries to their codes, in
+// addition to the names of defaultCountries. Names which are also common words,
+// like "turkey", are left out.
+var countryAliases = map[string]string{
+	"usa":                              "US",
+	"united states of america":         "US",
+	"uk":                               "GB",
+	"britain":                          "GB",
+	"great britain":                    "GB",
+	"holland":                          "NL",
+	"the netherlands":                  "NL",
+	"czech republic":                   "CZ",
+	"ivory coast":                      "CI",
+	"cote d'ivoire":                    "CI",
+	"burma":                            "MM",
+	"vatican":                          "VA",
+	"uae":                              "AE",
+	"dr congo":                         "CD",
+	"democratic republic of the congo": "CD",
+	"republic of the congo":            "CG",
+	"swaziland":                        "SZ",
+	"macedonia":                        "MK",
+	"cape verde":                       "CV",
+	"east timor":                       "TL",
+	"hong kong":                        "HK",
+	"macau":                            "MO",
+	"macao":                            "MO",
+	"palestine":                        "PS",
+}
+
+// commonWordCountries are names of defaultCountries which are also common words or
+// given names, like "Chad" or "Jordan". Like the common words left out of countryAliases,
+// they are not matched as country names unless they are passed as aliases.
+var commonWordCountries = map[string]bool{
+	"chad":         true,
+	"chile":        true,
+	"diego garcia": true,
+	"georgia":      true,
+	"guernsey":     true,
+	"guinea":       true,
+	"jersey":       true,
+	"jordan":       true,
+}
+
+// countryNameReplacer spells out abbreviations of country names, like "St. Lucia" as "Saint Lucia".
+var countryNameReplacer = strings.NewReplacer(" & ", " and ", "St. ", "Saint ", "’", "'")
+
+// Countries returns the countries and regions which have a flag emoji, sorted by code.
+func Countries() []Country {
+	return slices.Clone(defaultCountries)
+}
+
+// CountryFlag returns the flag emoji of an ISO 3166-1 alpha-2 code, e.g. 🇩🇪 for "DE".
+// The code is case-insensitive. Returns an error if the code has no flag emoji.
+func CountryFlag(code string) (string, error) {
+	upper := strings.ToUpper(code)
+	if _, ok := findCountry(upper); !ok {
+		return "", fmt.Errorf("no flag emoji for country code '%s'", code)
+	}
+
+	flag := make([]rune, 0, len(upper))
+	for _, letter := range upper {
+		flag = append(flag, regionalIndicatorFirst+letter-'A')
+	}
+	return string(flag), nil
+}
+
+// CountryCode returns the ISO 3166-1 alpha-2 code of a flag emoji, e.g. "DE" for 🇩🇪.
+// Returns an error if the text is not a flag of a country.
+func CountryCode(flag string) (string, error) {
+	first, size := utf8.DecodeRuneInString(flag)
+	second, _ := utf8.DecodeRuneInString(flag[size:])
+	if !isRegionalIndicator(first) || !isRegionalIndicator(second) || len(flag) != 2*size {
+		return "", fmt.Errorf("'%s' is not a country flag", flag)
+	}
+
+	code := string([]rune{'A' + first - regionalIndicatorFirst, 'A' + second - regionalIndicatorFirst})
+	if _, ok := findCountry(code); !ok {
+		return "", fmt.Errorf("'%s' is not a country flag", flag)
+	}
+	return code, nil
+}
+
+func findCountry(code string) (Country, bool) {
+	i, found := slices.BinarySearchFunc(defaultCountries, code, func(country Country, target string) int {
+		return strings.Compare(country.Code, target)
+	})
+	if !found {
+		return Country{}, false
+	}
+	return defaultCountries[i], true
+}
+
+// CountryMatcher matches country names like "Germany" or "Czech Republic" to their
+// flags, independent of the minimum word length. Names which are also common words or
+// given names, like "Jordan", are not matched. Other phrases are matched by the
+// wrapped Matcher; those overlapping a country name are dropped.
+type CountryMatcher struct {
+	matcher   Matcher
+	countries Dictionary
+}
+
+// NewCountryMatcher creates a CountryMatcher which uses the matcher for all other
+// phrases, PhraseMatcher if nil. The aliases add names to ISO codes, e.g.
+// "deutschland" to "DE". Returns an error if an alias has a code without a flag emoji.
+func NewCountryMatcher(matcher Matcher, aliases map[string]string) (*CountryMatcher, error) {
+	if matcher == nil {
+		matcher = PhraseMatcher{}
+	}
+
+	keywords := make(map[string][]string)
+	for _, country := range defaultCountries {
+		flag, _ := CountryFlag(country.Code)
+		for _, name := range countryNames(country.Name) {
+			if !commonWordCountries[name] {
+				keywords[name] = []string{flag}
+			}
+		}
+	}
+	for _, names := range []map[string]string{countryAliases, aliases} {
+		for name, code := range names {
+			flag, err := CountryFlag(code)
+			if err != nil {
+				return nil, fmt.Errorf("invalid alias '%s': %w", name, err)
+			}
+			keywords[countryKeyword(name)] = []string{flag}
+		}
+	}
+
+	return &CountryMatcher{matcher: matcher, countries: NewMapDictionary(keywords)}, nil
+}
+
+// Match finds the country names and the phrases of the wrapped matcher which do not overlap them.
+func (c *CountryMatcher) Match(tokens []Token, minimumWordLength int, dictionary Dictionary) []Match {
+	matches := PhraseMatcher{}.Match(tokens, 0, c.countries)
+	for _, match := range c.matcher.Match(tokens, minimumWordLength, dictionary) {
+		overlaps := slices.ContainsFunc(matches, func(country Match) bool {
+			return match.Start < country.End && country.Start < match.End
+		})
+		if !overlaps {
+			matches = append(matches, match)
+		}
+	}
+	slices.SortFunc(matches, func(a, b Match) int {
+		return a.Start - b.Start
+	})
+	return matches
+}
+
+// countryNames returns the keywords of a country name: the name itself, the name with
+// abbreviations spelled out and the name without parenthesized parts, like "Myanmar"
+// for "Myanmar (Burma)".
+func countryNames(name string) []string {
+	names := []string{countryKeyword(name), countryKeyword(countryNameReplacer.Replace(name))}
+	if start, _, found := strings.Cut(name, " ("); found {
+		names = append(names, countryKeyword(start))
+	}
+	return names
+}
+
+// countryKeyword returns the name as it is matched by PhraseMatcher after tokenization.
+func countryKeyword(name string) string {
+	words := make([]string, 0)
+	for _, token := range tokenizeWords(name, 0, len(name), "") {
+		words = append(words, strings.ToLower(token.Text))
+	}
+	return strings.Join(words, " ")
+}
+
+// WithoutCountryCodes stops two-letter keywords like "de" or "us" from matching the
+// flag of the country with that code, which avoids false positives in other languages.
+func WithoutCountryCodes() Option {
+	return func(e *Emojifier) {
+		e.withoutCountryCodes = true
+	}
+}
+
+// countryCodeFilter removes the flags of two-letter country codes from the keywords of a Dictionary.
+type countryCodeFilter struct {
+	Dictionary
+}
+
+func (c countryCodeFilter) Lookup(keyword string) (emojis []string, ok bool) {
+	emojis, ok = c.Dictionary.Lookup(keyword)
+	if !ok || utf8.RuneCountInString(keyword) != 2 {
+		return emojis, ok
+	}
+	emojis = slices.DeleteFunc(slices.Clone(emojis), func(emoji string) bool {
+		return isCountryCodeFlag(keyword, emoji)
+	})
+	return emojis, len(emojis) > 0
+}
+
+func (c countryCodeFilter) LookupWeighted(keyword string) ([]WeightedEmoji, bool) {
+	emojis, ok := lookupWeighted(c.Dictionary, keyword)
+	if !ok || utf8.RuneCountInString(keyword) != 2 {
+		return emojis, ok
+	}
+	emojis = slices.DeleteFunc(slices.Clone(emojis), func(emoji WeightedEmoji) bool {
+		return isCountryCodeFlag(keyword, emoji.Emoji)
+	})
+	return emojis, len(emojis) > 0
+}
+
+func (c countryCodeFilter) ToLower(text string) string {
+	return toLower(c.Dictionary, text)
+}
+
+// isCountryCodeFlag reports whether the emoji is the flag of the country code.
+func isCountryCodeFlag(code, emoji string) bool {
+	flagCode, err := CountryCode(emoji)
+	return err == nil && strings.EqualFold(flagCode, code)
+}
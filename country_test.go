@@ -0,0 +1,143 @@
+package goemoji
+
+import (
+	"testing"
+)
+
+func TestCountryFlag(t *testing.T) {
+	tests := []struct {
+		code    string
+		want    string
+		wantErr bool
+	}{
+		{code: "DE", want: "🇩🇪"},
+		{code: "us", want: "🇺🇸"},
+		{code: "Eu", want: "🇪🇺"},
+		{code: "ZZ", wantErr: true},
+		{code: "DEU", wantErr: true},
+		{code: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := CountryFlag(tt.code)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("CountryFlag(%q) = %q, %v, want %q, error %v", tt.code, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestCountryCode(t *testing.T) {
+	tests := []struct {
+		flag    string
+		want    string
+		wantErr bool
+	}{
+		{flag: "🇩🇪", want: "DE"},
+		{flag: "🇯🇵", want: "JP"},
+		{flag: "🇿🇿", wantErr: true},
+		{flag: "🇩🇪🇫🇷", wantErr: true},
+		{flag: "🇩", wantErr: true},
+		{flag: "DE", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := CountryCode(tt.flag)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("CountryCode(%q) = %q, %v, want %q, error %v", tt.flag, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestCountries(t *testing.T) {
+	countries := Countries()
+	for i, country := range countries {
+		if i > 0 && countries[i-1].Code >= country.Code {
+			t.Fatalf("Countries() are not sorted at %s", country.Code)
+		}
+		if _, err := CountryFlag(country.Code); err != nil {
+			t.Errorf("CountryFlag(%q) error = %v", country.Code, err)
+		}
+	}
+	countries[0].Name = "changed"
+	if Countries()[0].Name == "changed" {
+		t.Error("Countries() returned the default table instead of a copy")
+	}
+}
+
+func TestCountryMatcher(t *testing.T) {
+	matcher, err := NewCountryMatcher(nil, map[string]string{"deutschland": "de"})
+	if err != nil {
+		t.Fatalf("NewCountryMatcher() error = %v", err)
+	}
+	dictionary := NewMapDictionary(map[string][]string{"music": {"🎶"}, "islands": {"🏝️"}})
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "name", text: "Greetings from Germany!", want: "Greetings from 🇩🇪!"},
+		{name: "multiple words", text: "music in the United States", want: "🎶 in the 🇺🇸"},
+		{name: "spelled out", text: "Saint Lucia and Bosnia and Herzegovina", want: "🇱🇨 and 🇧🇦"},
+		{name: "abbreviation", text: "St. Lucia", want: "🇱🇨"},
+		{name: "apostrophe", text: "Côte d'Ivoire", want: "🇨🇮"},
+		{name: "parenthesized", text: "Myanmar or Burma", want: "🇲🇲 or 🇲🇲"},
+		{name: "alias", text: "UK, USA and Deutschland", want: "🇬🇧, 🇺🇸 and 🇩🇪"},
+		{name: "short name", text: "Oman", want: "🇴🇲"},
+		{name: "given name", text: "Jordan scored", want: "Jordan scored"},
+		{name: "common word", text: "a jersey from Georgia", want: "a jersey from Georgia"},
+		{name: "longer name with common word", text: "Papua New Guinea", want: "🇵🇬"},
+		{name: "country wins", text: "Cook Islands", want: "🇨🇰"},
+		{name: "no country", text: "islands", want: "🏝️"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emojifier, err := NewEmojifier(Pipeline{Matcher: matcher}, 4, WithDictionary(dictionary))
+			if err != nil {
+				t.Fatalf("NewEmojifier() error = %v", err)
+			}
+			if got := emojifier.Emojify(tt.text); got != tt.want {
+				t.Errorf("Emojify() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	withAlias, err := NewCountryMatcher(nil, map[string]string{"jordan": "JO"})
+	if err != nil {
+		t.Fatalf("NewCountryMatcher() error = %v", err)
+	}
+	if got := (Pipeline{Matcher: withAlias}).Emojify("Jordan", 4, dictionary); got != "🇯🇴" {
+		t.Errorf("Emojify() with alias = %q, want %q", got, "🇯🇴")
+	}
+
+	if _, err := NewCountryMatcher(nil, map[string]string{"nowhere": "ZZ"}); err == nil {
+		t.Error("NewCountryMatcher() expected an error for an unknown code")
+	}
+}
+
+func TestWithoutCountryCodes(t *testing.T) {
+	dictionary := NewMapDictionary(map[string][]string{
+		"de":      {"🇩🇪"},
+		"it":      {"🇮🇹", "💻"},
+		"germany": {"🇩🇪"},
+	})
+
+	tests := []struct {
+		name    string
+		options []Option
+		want    string
+	}{
+		{name: "with country codes", want: "🇩🇪 🇮🇹 🇩🇪"},
+		{name: "without country codes", options: []Option{WithoutCountryCodes()}, want: "de 💻 🇩🇪"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := append([]Option{WithDictionary(dictionary)}, tt.options...)
+			emojifier, err := NewEmojifier(ReplaceSubstring{}, 2, options...)
+			if err != nil {
+				t.Fatalf("NewEmojifier() error = %v", err)
+			}
+			if got := emojifier.Emojify("de it germany"); got != tt.want {
+				t.Errorf("Emojify() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package goemoji
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/jo-hoe/goemoji/internal/phrase"
+)
+
+// Dictionary is a read-only mapping of keywords to emojis.
+// Implementations must be safe for concurrent use.
+type Dictionary interface {
+	// Lookup returns the emojis of the keyword ordered by relevance.
+	Lookup(keyword string) (emojis []string, ok bool)
+	// ContainsEmoji reports whether the emoji belongs to any keyword.
+	ContainsEmoji(emoji string) bool
+	// Range calls fn for every keyword until fn returns false.
+	Range(fn func(keyword string, emojis []string) bool)
+	// MaxPhraseLength returns the number of words of the longest keyword.
+	MaxPhraseLength() int
+}
+
+// defaultDictionary is backed by the generated tables of dictionary_data.go.
+// It is immutable and shared by all Emojifiers.
+var defaultDictionary Dictionary = &tableDictionary{
+	entries:         defaultEntries,
+	emojis:          defaultEmojis,
+	maxPhraseLength: defaultMaxPhraseLength,
+}
+
+// dictionaryEntry is a keyword with its emojis ordered by descending weight.
+type dictionaryEntry struct {
+	keyword string
+	emojis  []WeightedEmoji
+}
+
+// tableDictionary is a Dictionary backed by static tables.
+// Entries are sorted by keyword and emojis are sorted.
+type tableDictionary struct {
+	entries         []dictionaryEntry
+	emojis          []string
+	maxPhraseLength int
+}
+
+func (t *tableDictionary) Lookup(keyword string) (emojis []string, ok bool) {
+	weighted, ok := t.LookupWeighted(keyword)
+	return EmojiStrings(weighted), ok
+}
+
+func (t *tableDictionary) LookupWeighted(keyword string) (emojis []WeightedEmoji, ok bool) {
+	i, found := slices.BinarySearchFunc(t.entries, keyword, func(entry dictionaryEntry, target string) int {
+		return strings.Compare(entry.keyword, target)
+	})
+	if !found {
+		return nil, false
+	}
+	return slices.Clone(t.entries[i].emojis), true
+}
+
+func (t *tableDictionary) ContainsEmoji(emoji string) bool {
+	_, found := slices.BinarySearch(t.emojis, emoji)
+	return found
+}
+
+func (t *tableDictionary) Range(fn func(keyword string, emojis []string) bool) {
+	for _, entry := range t.entries {
+		if !fn(entry.keyword, EmojiStrings(entry.emojis)) {
+			return
+		}
+	}
+}
+
+func (t *tableDictionary) MaxPhraseLength() int {
+	return t.maxPhraseLength
+}
+
+type mapDictionary struct {
+	emojiTags       map[string][]WeightedEmoji
+	emojiSet        map[string]bool
+	maxPhraseLength int
+}
+
+// NewMapDictionary creates a Dictionary from a map of keywords to emojis.
+// The map is copied, so later changes to it do not affect the Dictionary.
+func NewMapDictionary(emojiTags map[string][]string) Dictionary {
+	weighted := make(map[string][]WeightedEmoji, len(emojiTags))
+	for keyword, emojis := range emojiTags {
+		weighted[keyword] = weightEmojis(emojis, SourceUnknown)
+	}
+	return newMapDictionary(weighted)
+}
+
+// NewWeightedMapDictionary creates a WeightedDictionary from a map of keywords to weighted emojis.
+// The emojis of each keyword are ordered by descending weight. The map is copied,
+// so later changes to it do not affect the Dictionary.
+func NewWeightedMapDictionary(emojiTags map[string][]WeightedEmoji) WeightedDictionary {
+	copied := make(map[string][]WeightedEmoji, len(emojiTags))
+	for keyword, emojis := range emojiTags {
+		copied[keyword] = slices.Clone(emojis)
+		SortByWeight(copied[keyword])
+	}
+	return newMapDictionary(copied)
+}
+
+// ReadJSONDictionary reads a Dictionary from a JSON object which maps keywords to lists
+// of emojis, the format of emoji_map.json. Emojis are either objects with the fields
+// "emoji", "source" and "weight" or plain strings without source.
+func ReadJSONDictionary(reader io.Reader) (WeightedDictionary, error) {
+	var emojiTags map[string][]WeightedEmoji
+	if err := json.NewDecoder(reader).Decode(&emojiTags); err != nil {
+		return nil, fmt.Errorf("failed to decode dictionary: %w", err)
+	}
+	for _, emojis := range emojiTags {
+		SortByWeight(emojis)
+	}
+	return newMapDictionary(emojiTags), nil
+}
+
+// newMapDictionary creates a Dictionary which takes ownership of the map.
+func newMapDictionary(emojiTags map[string][]WeightedEmoji) *mapDictionary {
+	return &mapDictionary{
+		emojiTags:       emojiTags,
+		emojiSet:        createEmojiSet(emojiTags),
+		maxPhraseLength: maxPhraseLength(emojiTags),
+	}
+}
+
+func (m *mapDictionary) Lookup(keyword string) (emojis []string, ok bool) {
+	weighted, ok := m.emojiTags[keyword]
+	return EmojiStrings(weighted), ok
+}
+
+func (m *mapDictionary) LookupWeighted(keyword string) (emojis []WeightedEmoji, ok bool) {
+	emojis, ok = m.emojiTags[keyword]
+	return slices.Clone(emojis), ok
+}
+
+func (m *mapDictionary) ContainsEmoji(emoji string) bool {
+	return m.emojiSet[emoji]
+}
+
+func (m *mapDictionary) Range(fn func(keyword string, emojis []string) bool) {
+	for keyword, emojis := range m.emojiTags {
+		if !fn(keyword, EmojiStrings(emojis)) {
+			return
+		}
+	}
+}
+
+func (m *mapDictionary) MaxPhraseLength() int {
+	return m.maxPhraseLength
+}
+
+func createEmojiSet(emojiTags map[string][]WeightedEmoji) map[string]bool {
+	// Pre-calculate capacity for better performance
+	capacity := 0
+	for _, emojis := range emojiTags {
+		capacity += len(emojis)
+	}
+
+	result := make(map[string]bool, capacity)
+	for _, emojis := range emojiTags {
+		for _, emoji := range emojis {
+			result[emoji.Emoji] = true
+		}
+	}
+	return result
+}
+
+func maxPhraseLength(emojiTags map[string][]WeightedEmoji) int {
+	result := 0
+	for keyword := range emojiTags {
+		result = max(result, phrase.Length(keyword))
+	}
+	return result
+}
+
+// overlayDictionary is an immutable Dictionary which adds and removes keywords of a base Dictionary.
+// Emojis of removed keywords are still reported by ContainsEmoji as they remain valid emojis.
+type overlayDictionary struct {
+	base            Dictionary
+	added           map[string][]WeightedEmoji
+	removed         map[string]bool
+	addedEmojiSet   map[string]bool
+	maxPhraseLength int
+}
+
+func newOverlayDictionary(base Dictionary, added map[string][]WeightedEmoji,
+	removed map[string]bool) *overlayDictionary {
+	if added == nil {
+		added = make(map[string][]WeightedEmoji)
+	}
+	if removed == nil {
+		removed = make(map[string]bool)
+	}
+	return &overlayDictionary{
+		base:            base,
+		added:           added,
+		removed:         removed,
+		addedEmojiSet:   createEmojiSet(added),
+		maxPhraseLength: max(base.MaxPhraseLength(), maxPhraseLength(added)),
+	}
+}
+
+// withKeyword returns a copy of the overlay in which the keyword maps to the emojis.
+// The emojis have SourceCustom, so they outweigh overlapping keywords of the base.
+func (o *overlayDictionary) withKeyword(keyword string, emojis []string) *overlayDictionary {
+	added := maps.Clone(o.added)
+	added[keyword] = weightEmojis(emojis, SourceCustom)
+	removed := maps.Clone(o.removed)
+	delete(removed, keyword)
+	return newOverlayDictionary(o.base, added, removed)
+}
+
+// withoutKeyword returns a copy of the overlay in which the keyword does not exist.
+func (o *overlayDictionary) withoutKeyword(keyword string) *overlayDictionary {
+	added := maps.Clone(o.added)
+	delete(added, keyword)
+	removed := maps.Clone(o.removed)
+	removed[keyword] = true
+	return newOverlayDictionary(o.base, added, removed)
+}
+
+func (o *overlayDictionary) Lookup(keyword string) (emojis []string, ok bool) {
+	weighted, ok := o.LookupWeighted(keyword)
+	return EmojiStrings(weighted), ok
+}
+
+func (o *overlayDictionary) LookupWeighted(keyword string) (emojis []WeightedEmoji, ok bool) {
+	if emojis, ok := o.added[keyword]; ok {
+		return slices.Clone(emojis), true
+	}
+	if o.removed[keyword] {
+		return nil, false
+	}
+	return lookupWeighted(o.base, keyword)
+}
+
+func (o *overlayDictionary) ContainsEmoji(emoji string) bool {
+	return o.addedEmojiSet[emoji] || o.base.ContainsEmoji(emoji)
+}
+
+func (o *overlayDictionary) Range(fn func(keyword string, emojis []string) bool) {
+	for keyword, emojis := range o.added {
+		if !fn(keyword, EmojiStrings(emojis)) {
+			return
+		}
+	}
+	o.base.Range(func(keyword string, emojis []string) bool {
+		if _, ok := o.added[keyword]; ok || o.removed[keyword] {
+			return true
+		}
+		return fn(keyword, emojis)
+	})
+}
+
+func (o *overlayDictionary) MaxPhraseLength() int {
+	return o.maxPhraseLength
+}
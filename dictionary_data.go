@@ -0,0 +1,5073 @@
+// Code generated by internal/main.go; DO NOT EDIT.
+
+package goemoji
+
+// defaultMaxPhraseLength is the number of words of the longest keyword in defaultEntries.
+const defaultMaxPhraseLength = 8
+
+// defaultEntries maps keywords to emojis, sorted by keyword.
+var defaultEntries = []dictionaryEntry{
+	{"+1", []WeightedEmoji{{"👍", SourceUnknown, 15}}},
+	{"-1", []WeightedEmoji{{"👎", SourceUnknown, 15}}},
+	{"100", []WeightedEmoji{{"💯", SourceUnknown, 15}}},
+	{"1234", []WeightedEmoji{{"🔢", SourceUnknown, 15}}},
+	{"1st place medal", []WeightedEmoji{{"🥇", SourceUnknown, 15}}},
+	{"2nd place medal", []WeightedEmoji{{"🥈", SourceUnknown, 15}}},
+	{"3rd place medal", []WeightedEmoji{{"🥉", SourceUnknown, 15}}},
+	{"8ball", []WeightedEmoji{{"🎱", SourceUnknown, 15}}},
+	{"a button (blood type)", []WeightedEmoji{{"🅰️", SourceUnknown, 15}}},
+	{"ab", []WeightedEmoji{{"🆎", SourceUnknown, 15}}},
+	{"ab button (blood type)", []WeightedEmoji{{"🆎", SourceUnknown, 15}}},
+	{"abacus", []WeightedEmoji{{"🧮", SourceUnknown, 15}}},
+	{"abc", []WeightedEmoji{{"🔤", SourceUnknown, 15}}},
+	{"abcd", []WeightedEmoji{{"🔡", SourceUnknown, 15}}},
+	{"accept", []WeightedEmoji{{"🉑", SourceUnknown, 15}}},
+	{"accessibility", []WeightedEmoji{{"♿", SourceUnknown, 15}}},
+	{"accordion", []WeightedEmoji{{"🪗", SourceUnknown, 15}}},
+	{"achoo", []WeightedEmoji{{"🤧", SourceUnknown, 15}}},
+	{"adhesive bandage", []WeightedEmoji{{"🩹", SourceUnknown, 15}}},
+	{"admission tickets", []WeightedEmoji{{"🎟️", SourceUnknown, 15}}},
+	{"adult", []WeightedEmoji{{"🧑", SourceUnknown, 15}}},
+	{"aerial tramway", []WeightedEmoji{{"🚡", SourceUnknown, 15}}},
+	{"afghanistan", []WeightedEmoji{{"🇦🇫", SourceUnknown, 15}}},
+	{"airplane", []WeightedEmoji{{"✈️", SourceUnknown, 15}}},
+	{"airplane arrival", []WeightedEmoji{{"🛬", SourceUnknown, 15}}},
+	{"airplane departure", []WeightedEmoji{{"🛫", SourceUnknown, 15}}},
+	{"airport", []WeightedEmoji{{"🛄", SourceUnknown, 15}}},
+	{"aland islands", []WeightedEmoji{{"🇦🇽", SourceUnknown, 15}}},
+	{"alarm clock", []WeightedEmoji{{"⏰", SourceUnknown, 15}}},
+	{"albania", []WeightedEmoji{{"🇦🇱", SourceUnknown, 15}}},
+	{"alembic", []WeightedEmoji{{"⚗️", SourceUnknown, 15}}},
+	{"algeria", []WeightedEmoji{{"🇩🇿", SourceUnknown, 15}}},
+	{"alien", []WeightedEmoji{{"👽", SourceUnknown, 15}}},
+	{"alien monster", []WeightedEmoji{{"👾", SourceUnknown, 15}}},
+	{"alphabet", []WeightedEmoji{{"🔤", SourceUnknown, 15}}},
+	{"amazed", []WeightedEmoji{{"😲", SourceUnknown, 15}}},
+	{"ambulance", []WeightedEmoji{{"🚑", SourceUnknown, 15}}},
+	{"america", []WeightedEmoji{{"🇺🇸", SourceUnknown, 15}}},
+	{"american football", []WeightedEmoji{{"🏈", SourceUnknown, 15}}},
+	{"american samoa", []WeightedEmoji{{"🇦🇸", SourceUnknown, 15}}},
+	{"amphora", []WeightedEmoji{{"🏺", SourceUnknown, 15}}},
+	{"anatomical heart", []WeightedEmoji{{"🫀", SourceUnknown, 15}}},
+	{"anchor", []WeightedEmoji{{"⚓", SourceUnknown, 15}}},
+	{"andorra", []WeightedEmoji{{"🇦🇩", SourceUnknown, 15}}},
+	{"angel", []WeightedEmoji{{"👼", SourceUnknown, 15}, {"😇", SourceUnknown, 15}}},
+	{"anger", []WeightedEmoji{{"💢", SourceUnknown, 15}}},
+	{"anger symbol", []WeightedEmoji{{"💢", SourceUnknown, 15}}},
+	{"angola", []WeightedEmoji{{"🇦🇴", SourceUnknown, 15}}},
+	{"angry", []WeightedEmoji{{"😠", SourceUnknown, 15}, {"😡", SourceUnknown, 15}, {"👿", SourceUnknown, 15}, {"💢", SourceUnknown, 15}}},
+	{"angry face", []WeightedEmoji{{"😠", SourceUnknown, 15}}},
+	{"angry face with horns", []WeightedEmoji{{"👿", SourceUnknown, 15}}},
+	{"anguilla", []WeightedEmoji{{"🇦🇮", SourceUnknown, 15}}},
+	{"anguished", []WeightedEmoji{{"😧", SourceUnknown, 15}}},
+	{"anguished face", []WeightedEmoji{{"😧", SourceUnknown, 15}}},
+	{"announcement", []WeightedEmoji{{"📢", SourceUnknown, 15}}},
+	{"annoyed", []WeightedEmoji{{"😠", SourceUnknown, 15}}},
+	{"ant", []WeightedEmoji{{"🐜", SourceUnknown, 15}}},
+	{"antarctica", []WeightedEmoji{{"🇦🇶", SourceUnknown, 15}}},
+	{"antenna bars", []WeightedEmoji{{"📶", SourceUnknown, 15}}},
+	{"antigua barbuda", []WeightedEmoji{{"🇦🇬", SourceUnknown, 15}}},
+	{"anxious face with sweat", []WeightedEmoji{{"😰", SourceUnknown, 15}}},
+	{"applause", []WeightedEmoji{{"👏", SourceUnknown, 15}}},
+	{"apple", []WeightedEmoji{{"🍎", SourceUnknown, 15}}},
+	{"approve", []WeightedEmoji{{"👍", SourceUnknown, 15}}},
+	{"aquarius", []WeightedEmoji{{"♒", SourceUnknown, 15}}},
+	{"archery", []WeightedEmoji{{"🏹", SourceUnknown, 15}}},
+	{"argentina", []WeightedEmoji{{"🇦🇷", SourceUnknown, 15}}},
+	{"aries", []WeightedEmoji{{"♈", SourceUnknown, 15}}},
+	{"armenia", []WeightedEmoji{{"🇦🇲", SourceUnknown, 15}}},
+	{"arrow backward", []WeightedEmoji{{"◀️", SourceUnknown, 15}}},
+	{"arrow double down", []WeightedEmoji{{"⏬", SourceUnknown, 15}}},
+	{"arrow double up", []WeightedEmoji{{"⏫", SourceUnknown, 15}}},
+	{"arrow down", []WeightedEmoji{{"⬇️", SourceUnknown, 15}}},
+	{"arrow down small", []WeightedEmoji{{"🔽", SourceUnknown, 15}}},
+	{"arrow forward", []WeightedEmoji{{"▶️", SourceUnknown, 15}}},
+	{"arrow heading down", []WeightedEmoji{{"⤵️", SourceUnknown, 15}}},
+	{"arrow heading up", []WeightedEmoji{{"⤴️", SourceUnknown, 15}}},
+	{"arrow left", []WeightedEmoji{{"⬅️", SourceUnknown, 15}}},
+	{"arrow lower left", []WeightedEmoji{{"↙️", SourceUnknown, 15}}},
+	{"arrow lower right", []WeightedEmoji{{"↘️", SourceUnknown, 15}}},
+	{"arrow right", []WeightedEmoji{{"➡️", SourceUnknown, 15}}},
+	{"arrow right hook", []WeightedEmoji{{"↪️", SourceUnknown, 15}}},
+	{"arrow up", []WeightedEmoji{{"⬆️", SourceUnknown, 15}}},
+	{"arrow up down", []WeightedEmoji{{"↕️", SourceUnknown, 15}}},
+	{"arrow up small", []WeightedEmoji{{"🔼", SourceUnknown, 15}}},
+	{"arrow upper left", []WeightedEmoji{{"↖️", SourceUnknown, 15}}},
+	{"arrow upper right", []WeightedEmoji{{"↗️", SourceUnknown, 15}}},
+	{"arrows clockwise", []WeightedEmoji{{"🔃", SourceUnknown, 15}}},
+	{"arrows counterclockwise", []WeightedEmoji{{"🔄", SourceUnknown, 15}}},
+	{"art", []WeightedEmoji{{"🎨", SourceUnknown, 15}}},
+	{"articulated lorry", []WeightedEmoji{{"🚛", SourceUnknown, 15}}},
+	{"artificial satellite", []WeightedEmoji{{"🛰️", SourceUnknown, 15}}},
+	{"artist", []WeightedEmoji{{"🧑\u200d🎨", SourceUnknown, 15}}},
+	{"artist palette", []WeightedEmoji{{"🎨", SourceUnknown, 15}}},
+	{"aruba", []WeightedEmoji{{"🇦🇼", SourceUnknown, 15}}},
+	{"ascension island", []WeightedEmoji{{"🇦🇨", SourceUnknown, 15}}},
+	{"asterisk", []WeightedEmoji{{"*️⃣", SourceUnknown, 15}}},
+	{"astonished", []WeightedEmoji{{"😲", SourceUnknown, 15}}},
+	{"astonished face", []WeightedEmoji{{"😲", SourceUnknown, 15}}},
+	{"astronaut", []WeightedEmoji{{"🧑\u200d🚀", SourceUnknown, 15}}},
+	{"athletic shoe", []WeightedEmoji{{"👟", SourceUnknown, 15}}},
+	{"atm", []WeightedEmoji{{"🏧", SourceUnknown, 15}}},
+	{"atm sign", []WeightedEmoji{{"🏧", SourceUnknown, 15}}},
+	{"atom symbol", []WeightedEmoji{{"⚛️", SourceUnknown, 15}}},
+	{"attack", []WeightedEmoji{{"👊", SourceUnknown, 15}}},
+	{"aubergine", []WeightedEmoji{{"🍆", SourceUnknown, 15}}},
+	{"australia", []WeightedEmoji{{"🇦🇺", SourceUnknown, 15}}},
+	{"austria", []WeightedEmoji{{"🇦🇹", SourceUnknown, 15}}},
+	{"auto rickshaw", []WeightedEmoji{{"🛺", SourceUnknown, 15}}},
+	{"automobile", []WeightedEmoji{{"🚗", SourceUnknown, 15}}},
+	{"autumn", []WeightedEmoji{{"🍂", SourceUnknown, 15}}},
+	{"avocado", []WeightedEmoji{{"🥑", SourceUnknown, 15}}},
+	{"award", []WeightedEmoji{{"🏆", SourceUnknown, 15}}},
+	{"axe", []WeightedEmoji{{"🪓", SourceUnknown, 15}}},
+	{"azerbaijan", []WeightedEmoji{{"🇦🇿", SourceUnknown, 15}}},
+	{"b button (blood type)", []WeightedEmoji{{"🅱️", SourceUnknown, 15}}},
+	{"baby", []WeightedEmoji{{"👶", SourceUnknown, 15}}},
+	{"baby angel", []WeightedEmoji{{"👼", SourceUnknown, 15}}},
+	{"baby bottle", []WeightedEmoji{{"🍼", SourceUnknown, 15}}},
+	{"baby chick", []WeightedEmoji{{"🐤", SourceUnknown, 15}}},
+	{"baby symbol", []WeightedEmoji{{"🚼", SourceUnknown, 15}}},
+	{"back", []WeightedEmoji{{"🔙", SourceUnknown, 15}}},
+	{"back arrow", []WeightedEmoji{{"🔙", SourceUnknown, 15}}},
+	{"backhand index pointing down", []WeightedEmoji{{"👇", SourceUnknown, 15}}},
+	{"backhand index pointing left", []WeightedEmoji{{"👈", SourceUnknown, 15}}},
+	{"backhand index pointing right", []WeightedEmoji{{"👉", SourceUnknown, 15}}},
+	{"backhand index pointing up", []WeightedEmoji{{"👆", SourceUnknown, 15}}},
+	{"backpack", []WeightedEmoji{{"🎒", SourceUnknown, 15}}},
+	{"bacon", []WeightedEmoji{{"🥓", SourceUnknown, 15}}},
+	{"badger", []WeightedEmoji{{"🦡", SourceUnknown, 15}}},
+	{"badminton", []WeightedEmoji{{"🏸", SourceUnknown, 15}}},
+	{"bag", []WeightedEmoji{{"👜", SourceUnknown, 15}, {"👝", SourceUnknown, 15}}},
+	{"bagel", []WeightedEmoji{{"🥯", SourceUnknown, 15}}},
+	{"baggage claim", []WeightedEmoji{{"🛄", SourceUnknown, 15}}},
+	{"bags", []WeightedEmoji{{"🛍️", SourceUnknown, 15}}},
+	{"baguette bread", []WeightedEmoji{{"🥖", SourceUnknown, 15}}},
+	{"bahamas", []WeightedEmoji{{"🇧🇸", SourceUnknown, 15}}},
+	{"bahrain", []WeightedEmoji{{"🇧🇭", SourceUnknown, 15}}},
+	{"balance scale", []WeightedEmoji{{"⚖️", SourceUnknown, 15}}},
+	{"bald man", []WeightedEmoji{{"👨\u200d🦲", SourceUnknown, 15}}},
+	{"bald woman", []WeightedEmoji{{"👩\u200d🦲", SourceUnknown, 15}}},
+	{"ballet shoes", []WeightedEmoji{{"🩰", SourceUnknown, 15}}},
+	{"balloon", []WeightedEmoji{{"🎈", SourceUnknown, 15}}},
+	{"ballot box", []WeightedEmoji{{"🗳️", SourceUnknown, 15}}},
+	{"ballot box with ballot", []WeightedEmoji{{"🗳️", SourceUnknown, 15}}},
+	{"ballot box with check", []WeightedEmoji{{"☑️", SourceUnknown, 15}}},
+	{"bamboo", []WeightedEmoji{{"🎍", SourceUnknown, 15}}},
+	{"banana", []WeightedEmoji{{"🍌", SourceUnknown, 15}}},
+	{"bang", []WeightedEmoji{{"❗", SourceUnknown, 15}}},
+	{"bangbang", []WeightedEmoji{{"‼️", SourceUnknown, 15}}},
+	{"bangladesh", []WeightedEmoji{{"🇧🇩", SourceUnknown, 15}}},
+	{"banjo", []WeightedEmoji{{"🪕", SourceUnknown, 15}}},
+	{"bank", []WeightedEmoji{{"🏦", SourceUnknown, 15}}},
+	{"bar chart", []WeightedEmoji{{"📊", SourceUnknown, 15}}},
+	{"barbados", []WeightedEmoji{{"🇧🇧", SourceUnknown, 15}}},
+	{"barber", []WeightedEmoji{{"💈", SourceUnknown, 15}}},
+	{"barber pole", []WeightedEmoji{{"💈", SourceUnknown, 15}}},
+	{"barf", []WeightedEmoji{{"🤢", SourceUnknown, 15}, {"🤮", SourceUnknown, 15}}},
+	{"baseball", []WeightedEmoji{{"⚾", SourceUnknown, 15}}},
+	{"basket", []WeightedEmoji{{"🧺", SourceUnknown, 15}}},
+	{"basketball", []WeightedEmoji{{"🏀", SourceUnknown, 15}, {"⛹️", SourceUnknown, 15}}},
+	{"basketball man", []WeightedEmoji{{"⛹️\u200d♂️", SourceUnknown, 15}}},
+	{"basketball woman", []WeightedEmoji{{"⛹️\u200d♀️", SourceUnknown, 15}}},
+	{"bat", []WeightedEmoji{{"🦇", SourceUnknown, 15}}},
+	{"bath", []WeightedEmoji{{"🛀", SourceUnknown, 15}, {"🚿", SourceUnknown, 15}}},
+	{"bathtub", []WeightedEmoji{{"🛁", SourceUnknown, 15}}},
+	{"battery", []WeightedEmoji{{"🔋", SourceUnknown, 15}}},
+	{"bawling", []WeightedEmoji{{"😭", SourceUnknown, 15}}},
+	{"beach", []WeightedEmoji{{"🐚", SourceUnknown, 15}, {"👙", SourceUnknown, 15}}},
+	{"beach umbrella", []WeightedEmoji{{"🏖️", SourceUnknown, 15}, {"⛱️", SourceUnknown, 15}}},
+	{"beach with umbrella", []WeightedEmoji{{"🏖️", SourceUnknown, 15}}},
+	{"beaming face with smiling eyes", []WeightedEmoji{{"😁", SourceUnknown, 15}}},
+	{"beans", []WeightedEmoji{{"🫘", SourceUnknown, 15}}},
+	{"bear", []WeightedEmoji{{"🐻", SourceUnknown, 15}}},
+	{"bearded person", []WeightedEmoji{{"🧔", SourceUnknown, 15}}},
+	{"beating heart", []WeightedEmoji{{"💓", SourceUnknown, 15}}},
+	{"beauty", []WeightedEmoji{{"💅", SourceUnknown, 15}, {"💇", SourceUnknown, 15}}},
+	{"beaver", []WeightedEmoji{{"🦫", SourceUnknown, 15}}},
+	{"bed", []WeightedEmoji{{"🛏️", SourceUnknown, 15}}},
+	{"bee", []WeightedEmoji{{"🐝", SourceUnknown, 15}}},
+	{"beer", []WeightedEmoji{{"🍺", SourceUnknown, 15}}},
+	{"beer mug", []WeightedEmoji{{"🍺", SourceUnknown, 15}}},
+	{"beers", []WeightedEmoji{{"🍻", SourceUnknown, 15}}},
+	{"beetle", []WeightedEmoji{{"🪲", SourceUnknown, 15}}},
+	{"beginner", []WeightedEmoji{{"🔰", SourceUnknown, 15}}},
+	{"belarus", []WeightedEmoji{{"🇧🇾", SourceUnknown, 15}}},
+	{"belgium", []WeightedEmoji{{"🇧🇪", SourceUnknown, 15}}},
+	{"belize", []WeightedEmoji{{"🇧🇿", SourceUnknown, 15}}},
+	{"bell", []WeightedEmoji{{"🔔", SourceUnknown, 15}}},
+	{"bell pepper", []WeightedEmoji{{"🫑", SourceUnknown, 15}}},
+	{"bell with slash", []WeightedEmoji{{"🔕", SourceUnknown, 15}}},
+	{"bellhop bell", []WeightedEmoji{{"🛎️", SourceUnknown, 15}}},
+	{"benin", []WeightedEmoji{{"🇧🇯", SourceUnknown, 15}}},
+	{"bento", []WeightedEmoji{{"🍱", SourceUnknown, 15}}},
+	{"bento box", []WeightedEmoji{{"🍱", SourceUnknown, 15}}},
+	{"bermuda", []WeightedEmoji{{"🇧🇲", SourceUnknown, 15}}},
+	{"beverage box", []WeightedEmoji{{"🧃", SourceUnknown, 15}}},
+	{"bhutan", []WeightedEmoji{{"🇧🇹", SourceUnknown, 15}}},
+	{"bicep", []WeightedEmoji{{"💪", SourceUnknown, 15}}},
+	{"bicycle", []WeightedEmoji{{"🚲", SourceUnknown, 15}}},
+	{"bicyclist", []WeightedEmoji{{"🚴", SourceUnknown, 15}}},
+	{"bike", []WeightedEmoji{{"🚲", SourceUnknown, 15}}},
+	{"biking man", []WeightedEmoji{{"🚴\u200d♂️", SourceUnknown, 15}}},
+	{"biking woman", []WeightedEmoji{{"🚴\u200d♀️", SourceUnknown, 15}}},
+	{"bikini", []WeightedEmoji{{"👙", SourceUnknown, 15}}},
+	{"billed cap", []WeightedEmoji{{"🧢", SourceUnknown, 15}}},
+	{"billiards", []WeightedEmoji{{"🎱", SourceUnknown, 15}}},
+	{"biohazard", []WeightedEmoji{{"☣️", SourceUnknown, 15}}},
+	{"bird", []WeightedEmoji{{"🐦", SourceUnknown, 15}}},
+	{"birthday", []WeightedEmoji{{"🎂", SourceUnknown, 15}, {"🥳", SourceUnknown, 15}, {"🎈", SourceUnknown, 15}, {"🎁", SourceUnknown, 15}}},
+	{"birthday cake", []WeightedEmoji{{"🎂", SourceUnknown, 15}}},
+	{"bison", []WeightedEmoji{{"🦬", SourceUnknown, 15}}},
+	{"biting lip", []WeightedEmoji{{"🫦", SourceUnknown, 15}}},
+	{"black bird", []WeightedEmoji{{"🐦\u200d⬛", SourceUnknown, 15}}},
+	{"black cat", []WeightedEmoji{{"🐈\u200d⬛", SourceUnknown, 15}}},
+	{"black circle", []WeightedEmoji{{"⚫", SourceUnknown, 15}}},
+	{"black flag", []WeightedEmoji{{"🏴", SourceUnknown, 15}}},
+	{"black heart", []WeightedEmoji{{"🖤", SourceUnknown, 15}}},
+	{"black joker", []WeightedEmoji{{"🃏", SourceUnknown, 15}}},
+	{"black large square", []WeightedEmoji{{"⬛", SourceUnknown, 15}}},
+	{"black medium small square", []WeightedEmoji{{"◾", SourceUnknown, 15}}},
+	{"black medium square", []WeightedEmoji{{"◼️", SourceUnknown, 15}}},
+	{"black medium-small square", []WeightedEmoji{{"◾", SourceUnknown, 15}}},
+	{"black nib", []WeightedEmoji{{"✒️", SourceUnknown, 15}}},
+	{"black small square", []WeightedEmoji{{"▪️", SourceUnknown, 15}}},
+	{"black square button", []WeightedEmoji{{"🔲", SourceUnknown, 15}}},
+	{"blind", []WeightedEmoji{{"🙈", SourceUnknown, 15}}},
+	{"block", []WeightedEmoji{{"🚫", SourceUnknown, 15}}},
+	{"blond haired man", []WeightedEmoji{{"👱\u200d♂️", SourceUnknown, 15}}},
+	{"blond haired person", []WeightedEmoji{{"👱", SourceUnknown, 15}}},
+	{"blond haired woman", []WeightedEmoji{{"👱\u200d♀️", SourceUnknown, 15}}},
+	{"blonde woman", []WeightedEmoji{{"👱\u200d♀️", SourceUnknown, 15}}},
+	{"blossom", []WeightedEmoji{{"🌼", SourceUnknown, 15}}},
+	{"blow", []WeightedEmoji{{"💨", SourceUnknown, 15}}},
+	{"blowfish", []WeightedEmoji{{"🐡", SourceUnknown, 15}}},
+	{"blown", []WeightedEmoji{{"🤯", SourceUnknown, 15}}},
+	{"blue book", []WeightedEmoji{{"📘", SourceUnknown, 15}}},
+	{"blue car", []WeightedEmoji{{"🚙", SourceUnknown, 15}}},
+	{"blue circle", []WeightedEmoji{{"🔵", SourceUnknown, 15}}},
+	{"blue heart", []WeightedEmoji{{"💙", SourceUnknown, 15}}},
+	{"blue square", []WeightedEmoji{{"🟦", SourceUnknown, 15}}},
+	{"blueberries", []WeightedEmoji{{"🫐", SourceUnknown, 15}}},
+	{"blush", []WeightedEmoji{{"😊", SourceUnknown, 15}, {"☺️", SourceUnknown, 15}}},
+	{"boar", []WeightedEmoji{{"🐗", SourceUnknown, 15}}},
+	{"boat", []WeightedEmoji{{"⛵", SourceUnknown, 15}}},
+	{"bolivia", []WeightedEmoji{{"🇧🇴", SourceUnknown, 15}}},
+	{"bomb", []WeightedEmoji{{"💣", SourceUnknown, 15}}},
+	{"bone", []WeightedEmoji{{"🦴", SourceUnknown, 15}}},
+	{"book", []WeightedEmoji{{"📖", SourceUnknown, 15}}},
+	{"bookmark", []WeightedEmoji{{"🔖", SourceUnknown, 15}}},
+	{"bookmark tabs", []WeightedEmoji{{"📑", SourceUnknown, 15}}},
+	{"books", []WeightedEmoji{{"📚", SourceUnknown, 15}}},
+	{"boom", []WeightedEmoji{{"💥", SourceUnknown, 15}, {"💣", SourceUnknown, 15}}},
+	{"boomerang", []WeightedEmoji{{"🪃", SourceUnknown, 15}}},
+	{"boot", []WeightedEmoji{{"👢", SourceUnknown, 15}}},
+	{"bosnia herzegovina", []WeightedEmoji{{"🇧🇦", SourceUnknown, 15}}},
+	{"botswana", []WeightedEmoji{{"🇧🇼", SourceUnknown, 15}}},
+	{"bottle", []WeightedEmoji{{"🍾", SourceUnknown, 15}}},
+	{"bottle with popping cork", []WeightedEmoji{{"🍾", SourceUnknown, 15}}},
+	{"bouldering", []WeightedEmoji{{"🧗", SourceUnknown, 15}, {"🧗\u200d♂️", SourceUnknown, 15}, {"🧗\u200d♀️", SourceUnknown, 15}}},
+	{"bouncing ball man", []WeightedEmoji{{"⛹️\u200d♂️", SourceUnknown, 15}}},
+	{"bouncing ball person", []WeightedEmoji{{"⛹️", SourceUnknown, 15}}},
+	{"bouncing ball woman", []WeightedEmoji{{"⛹️\u200d♀️", SourceUnknown, 15}}},
+	{"bouquet", []WeightedEmoji{{"💐", SourceUnknown, 15}}},
+	{"bouvet island", []WeightedEmoji{{"🇧🇻", SourceUnknown, 15}}},
+	{"bow", []WeightedEmoji{{"🙇", SourceUnknown, 15}}},
+	{"bow and arrow", []WeightedEmoji{{"🏹", SourceUnknown, 15}}},
+	{"bowing man", []WeightedEmoji{{"🙇\u200d♂️", SourceUnknown, 15}}},
+	{"bowing woman", []WeightedEmoji{{"🙇\u200d♀️", SourceUnknown, 15}}},
+	{"bowl with spoon", []WeightedEmoji{{"🥣", SourceUnknown, 15}}},
+	{"bowling", []WeightedEmoji{{"🎳", SourceUnknown, 15}}},
+	{"boxing glove", []WeightedEmoji{{"🥊", SourceUnknown, 15}}},
+	{"boy", []WeightedEmoji{{"👦", SourceUnknown, 15}}},
+	{"brain", []WeightedEmoji{{"🧠", SourceUnknown, 15}}},
+	{"brazil", []WeightedEmoji{{"🇧🇷", SourceUnknown, 15}}},
+	{"bread", []WeightedEmoji{{"🍞", SourceUnknown, 15}}},
+	{"breakfast", []WeightedEmoji{{"🍳", SourceUnknown, 15}, {"🍵", SourceUnknown, 15}}},
+	{"breast feeding", []WeightedEmoji{{"🤱", SourceUnknown, 15}}},
+	{"breast-feeding", []WeightedEmoji{{"🤱", SourceUnknown, 15}}},
+	{"brick", []WeightedEmoji{{"🧱", SourceUnknown, 15}}},
+	{"bricks", []WeightedEmoji{{"🧱", SourceUnknown, 15}}},
+	{"bride with veil", []WeightedEmoji{{"👰\u200d♀️", SourceUnknown, 15}}},
+	{"bridge at night", []WeightedEmoji{{"🌉", SourceUnknown, 15}}},
+	{"briefcase", []WeightedEmoji{{"💼", SourceUnknown, 15}}},
+	{"briefs", []WeightedEmoji{{"🩲", SourceUnknown, 15}}},
+	{"bright button", []WeightedEmoji{{"🔆", SourceUnknown, 15}}},
+	{"british", []WeightedEmoji{{"🇬🇧", SourceUnknown, 15}}},
+	{"british indian ocean territory", []WeightedEmoji{{"🇮🇴", SourceUnknown, 15}}},
+	{"british virgin islands", []WeightedEmoji{{"🇻🇬", SourceUnknown, 15}}},
+	{"broccoli", []WeightedEmoji{{"🥦", SourceUnknown, 15}}},
+	{"broken heart", []WeightedEmoji{{"💔", SourceUnknown, 15}}},
+	{"bronze", []WeightedEmoji{{"🥉", SourceUnknown, 15}}},
+	{"broom", []WeightedEmoji{{"🧹", SourceUnknown, 15}}},
+	{"brown circle", []WeightedEmoji{{"🟤", SourceUnknown, 15}}},
+	{"brown heart", []WeightedEmoji{{"🤎", SourceUnknown, 15}}},
+	{"brown square", []WeightedEmoji{{"🟫", SourceUnknown, 15}}},
+	{"brunei", []WeightedEmoji{{"🇧🇳", SourceUnknown, 15}}},
+	{"bubble tea", []WeightedEmoji{{"🧋", SourceUnknown, 15}}},
+	{"bubbles", []WeightedEmoji{{"🫧", SourceUnknown, 15}}},
+	{"bubbly", []WeightedEmoji{{"🍾", SourceUnknown, 15}}},
+	{"bucket", []WeightedEmoji{{"🪣", SourceUnknown, 15}}},
+	{"bug", []WeightedEmoji{{"🐛", SourceUnknown, 15}, {"🐞", SourceUnknown, 15}}},
+	{"building construction", []WeightedEmoji{{"🏗️", SourceUnknown, 15}}},
+	{"bulb", []WeightedEmoji{{"💡", SourceUnknown, 15}}},
+	{"bulgaria", []WeightedEmoji{{"🇧🇬", SourceUnknown, 15}}},
+	{"bullet train", []WeightedEmoji{{"🚅", SourceUnknown, 15}}},
+	{"bullettrain front", []WeightedEmoji{{"🚅", SourceUnknown, 15}}},
+	{"bullettrain side", []WeightedEmoji{{"🚄", SourceUnknown, 15}}},
+	{"bullseye", []WeightedEmoji{{"🎯", SourceUnknown, 15}}},
+	{"bunny", []WeightedEmoji{{"👯", SourceUnknown, 15}, {"👯\u200d♂️", SourceUnknown, 15}, {"👯\u200d♀️", SourceUnknown, 15}, {"🐰", SourceUnknown, 15}}},
+	{"burger", []WeightedEmoji{{"🍔", SourceUnknown, 15}}},
+	{"burkina faso", []WeightedEmoji{{"🇧🇫", SourceUnknown, 15}}},
+	{"burma", []WeightedEmoji{{"🇲🇲", SourceUnknown, 15}}},
+	{"burn", []WeightedEmoji{{"🔥", SourceUnknown, 15}}},
+	{"burrito", []WeightedEmoji{{"🌯", SourceUnknown, 15}}},
+	{"burundi", []WeightedEmoji{{"🇧🇮", SourceUnknown, 15}}},
+	{"bury", []WeightedEmoji{{"👎", SourceUnknown, 15}}},
+	{"bus", []WeightedEmoji{{"🚌", SourceUnknown, 15}}},
+	{"bus stop", []WeightedEmoji{{"🚏", SourceUnknown, 15}}},
+	{"business", []WeightedEmoji{{"👨\u200d💼", SourceUnknown, 15}, {"👩\u200d💼", SourceUnknown, 15}, {"💼", SourceUnknown, 15}}},
+	{"business suit levitating", []WeightedEmoji{{"🕴️", SourceUnknown, 15}}},
+	{"busstop", []WeightedEmoji{{"🚏", SourceUnknown, 15}}},
+	{"bust in silhouette", []WeightedEmoji{{"👤", SourceUnknown, 15}}},
+	{"busts in silhouette", []WeightedEmoji{{"👥", SourceUnknown, 15}}},
+	{"butter", []WeightedEmoji{{"🧈", SourceUnknown, 15}}},
+	{"butterfly", []WeightedEmoji{{"🦋", SourceUnknown, 15}}},
+	{"cactus", []WeightedEmoji{{"🌵", SourceUnknown, 15}}},
+	{"cafe", []WeightedEmoji{{"☕", SourceUnknown, 15}}},
+	{"cake", []WeightedEmoji{{"🍰", SourceUnknown, 15}}},
+	{"calendar", []WeightedEmoji{{"📆", SourceUnknown, 15}, {"📅", SourceUnknown, 15}}},
+	{"call", []WeightedEmoji{{"📲", SourceUnknown, 15}, {"📞", SourceUnknown, 15}}},
+	{"call me hand", []WeightedEmoji{{"🤙", SourceUnknown, 15}}},
+	{"calling", []WeightedEmoji{{"📲", SourceUnknown, 15}}},
+	{"cambodia", []WeightedEmoji{{"🇰🇭", SourceUnknown, 15}}},
+	{"camel", []WeightedEmoji{{"🐫", SourceUnknown, 15}, {"🐪", SourceUnknown, 15}}},
+	{"camera", []WeightedEmoji{{"📷", SourceUnknown, 15}}},
+	{"camera flash", []WeightedEmoji{{"📸", SourceUnknown, 15}}},
+	{"camera with flash", []WeightedEmoji{{"📸", SourceUnknown, 15}}},
+	{"cameroon", []WeightedEmoji{{"🇨🇲", SourceUnknown, 15}}},
+	{"camping", []WeightedEmoji{{"🏕️", SourceUnknown, 15}, {"⛺", SourceUnknown, 15}}},
+	{"canada", []WeightedEmoji{{"🇨🇦", SourceUnknown, 15}, {"🫎", SourceUnknown, 15}, {"🍁", SourceUnknown, 15}}},
+	{"canary islands", []WeightedEmoji{{"🇮🇨", SourceUnknown, 15}}},
+	{"cancer", []WeightedEmoji{{"♋", SourceUnknown, 15}}},
+	{"candle", []WeightedEmoji{{"🕯️", SourceUnknown, 15}}},
+	{"candy", []WeightedEmoji{{"🍬", SourceUnknown, 15}}},
+	{"canned food", []WeightedEmoji{{"🥫", SourceUnknown, 15}}},
+	{"canoe", []WeightedEmoji{{"🛶", SourceUnknown, 15}}},
+	{"cape verde", []WeightedEmoji{{"🇨🇻", SourceUnknown, 15}}},
+	{"capital abcd", []WeightedEmoji{{"🔠", SourceUnknown, 15}}},
+	{"capricorn", []WeightedEmoji{{"♑", SourceUnknown, 15}}},
+	{"car", []WeightedEmoji{{"🚗", SourceUnknown, 15}}},
+	{"card file box", []WeightedEmoji{{"🗃️", SourceUnknown, 15}}},
+	{"card index", []WeightedEmoji{{"📇", SourceUnknown, 15}}},
+	{"card index dividers", []WeightedEmoji{{"🗂️", SourceUnknown, 15}}},
+	{"caribbean netherlands", []WeightedEmoji{{"🇧🇶", SourceUnknown, 15}}},
+	{"carousel horse", []WeightedEmoji{{"🎠", SourceUnknown, 15}}},
+	{"carp streamer", []WeightedEmoji{{"🎏", SourceUnknown, 15}}},
+	{"carpentry saw", []WeightedEmoji{{"🪚", SourceUnknown, 15}}},
+	{"carrot", []WeightedEmoji{{"🥕", SourceUnknown, 15}}},
+	{"cartwheeling", []WeightedEmoji{{"🤸", SourceUnknown, 15}}},
+	{"castle", []WeightedEmoji{{"🏰", SourceUnknown, 15}}},
+	{"cat", []WeightedEmoji{{"🐈", SourceUnknown, 15}, {"🐱", SourceUnknown, 15}}},
+	{"cat face", []WeightedEmoji{{"🐱", SourceUnknown, 15}}},
+	{"cat with tears of joy", []WeightedEmoji{{"😹", SourceUnknown, 15}}},
+	{"cat with wry smile", []WeightedEmoji{{"😼", SourceUnknown, 15}}},
+	{"cat2", []WeightedEmoji{{"🐈", SourceUnknown, 15}}},
+	{"cayman islands", []WeightedEmoji{{"🇰🇾", SourceUnknown, 15}}},
+	{"cd", []WeightedEmoji{{"💿", SourceUnknown, 15}}},
+	{"celebration", []WeightedEmoji{{"🥳", SourceUnknown, 15}, {"🍾", SourceUnknown, 15}, {"🎆", SourceUnknown, 15}}},
+	{"central african republic", []WeightedEmoji{{"🇨🇫", SourceUnknown, 15}}},
+	{"ceuta melilla", []WeightedEmoji{{"🇪🇦", SourceUnknown, 15}}},
+	{"chad", []WeightedEmoji{{"🇹🇩", SourceUnknown, 15}}},
+	{"chains", []WeightedEmoji{{"⛓️", SourceUnknown, 15}}},
+	{"chair", []WeightedEmoji{{"🪑", SourceUnknown, 15}}},
+	{"champagne", []WeightedEmoji{{"🍾", SourceUnknown, 15}}},
+	{"chart", []WeightedEmoji{{"💹", SourceUnknown, 15}}},
+	{"chart decreasing", []WeightedEmoji{{"📉", SourceUnknown, 15}}},
+	{"chart increasing", []WeightedEmoji{{"📈", SourceUnknown, 15}}},
+	{"chart increasing with yen", []WeightedEmoji{{"💹", SourceUnknown, 15}}},
+	{"chart with downwards trend", []WeightedEmoji{{"📉", SourceUnknown, 15}}},
+	{"chart with upwards trend", []WeightedEmoji{{"📈", SourceUnknown, 15}}},
+	{"check box with check", []WeightedEmoji{{"☑️", SourceUnknown, 15}}},
+	{"check mark", []WeightedEmoji{{"✔️", SourceUnknown, 15}}},
+	{"check mark button", []WeightedEmoji{{"✅", SourceUnknown, 15}}},
+	{"checkered flag", []WeightedEmoji{{"🏁", SourceUnknown, 15}}},
+	{"cheers", []WeightedEmoji{{"🥂", SourceUnknown, 15}}},
+	{"cheese", []WeightedEmoji{{"🧀", SourceUnknown, 15}}},
+	{"cheese wedge", []WeightedEmoji{{"🧀", SourceUnknown, 15}}},
+	{"chef", []WeightedEmoji{{"👨\u200d🍳", SourceUnknown, 15}, {"👩\u200d🍳", SourceUnknown, 15}}},
+	{"chequered flag", []WeightedEmoji{{"🏁", SourceUnknown, 15}}},
+	{"cherries", []WeightedEmoji{{"🍒", SourceUnknown, 15}}},
+	{"cherry blossom", []WeightedEmoji{{"🌸", SourceUnknown, 15}}},
+	{"chess pawn", []WeightedEmoji{{"♟️", SourceUnknown, 15}}},
+	{"chestnut", []WeightedEmoji{{"🌰", SourceUnknown, 15}}},
+	{"chicken", []WeightedEmoji{{"🐔", SourceUnknown, 15}, {"🍗", SourceUnknown, 15}}},
+	{"child", []WeightedEmoji{{"🧒", SourceUnknown, 15}, {"👶", SourceUnknown, 15}, {"👦", SourceUnknown, 15}, {"👧", SourceUnknown, 15}, {"👪", SourceUnknown, 15}}},
+	{"children crossing", []WeightedEmoji{{"🚸", SourceUnknown, 15}}},
+	{"chile", []WeightedEmoji{{"🇨🇱", SourceUnknown, 15}}},
+	{"china", []WeightedEmoji{{"🇨🇳", SourceUnknown, 15}}},
+	{"chipmunk", []WeightedEmoji{{"🐿️", SourceUnknown, 15}}},
+	{"chocolate bar", []WeightedEmoji{{"🍫", SourceUnknown, 15}}},
+	{"chocolates", []WeightedEmoji{{"💝", SourceUnknown, 15}}},
+	{"chop", []WeightedEmoji{{"🔪", SourceUnknown, 15}}},
+	{"chopsticks", []WeightedEmoji{{"🥢", SourceUnknown, 15}}},
+	{"christmas", []WeightedEmoji{{"🎅", SourceUnknown, 15}, {"☃️", SourceUnknown, 15}, {"🎁", SourceUnknown, 15}}},
+	{"christmas island", []WeightedEmoji{{"🇨🇽", SourceUnknown, 15}}},
+	{"christmas tree", []WeightedEmoji{{"🎄", SourceUnknown, 15}}},
+	{"church", []WeightedEmoji{{"⛪", SourceUnknown, 15}}},
+	{"cigarette", []WeightedEmoji{{"🚬", SourceUnknown, 15}}},
+	{"cinema", []WeightedEmoji{{"🎦", SourceUnknown, 15}}},
+	{"circled m", []WeightedEmoji{{"Ⓜ️", SourceUnknown, 15}}},
+	{"circus tent", []WeightedEmoji{{"🎪", SourceUnknown, 15}}},
+	{"city sunrise", []WeightedEmoji{{"🌇", SourceUnknown, 15}}},
+	{"city sunset", []WeightedEmoji{{"🌆", SourceUnknown, 15}}},
+	{"cityscape", []WeightedEmoji{{"🏙️", SourceUnknown, 15}}},
+	{"cityscape at dusk", []WeightedEmoji{{"🌆", SourceUnknown, 15}}},
+	{"cl", []WeightedEmoji{{"🆑", SourceUnknown, 15}}},
+	{"cl button", []WeightedEmoji{{"🆑", SourceUnknown, 15}}},
+	{"clamp", []WeightedEmoji{{"🗜️", SourceUnknown, 15}}},
+	{"clap", []WeightedEmoji{{"👏", SourceUnknown, 15}}},
+	{"clapper", []WeightedEmoji{{"🎬", SourceUnknown, 15}}},
+	{"clapper board", []WeightedEmoji{{"🎬", SourceUnknown, 15}}},
+	{"clapping hands", []WeightedEmoji{{"👏", SourceUnknown, 15}}},
+	{"classical building", []WeightedEmoji{{"🏛️", SourceUnknown, 15}}},
+	{"classy", []WeightedEmoji{{"🎩", SourceUnknown, 15}}},
+	{"climbing", []WeightedEmoji{{"🧗", SourceUnknown, 15}}},
+	{"climbing man", []WeightedEmoji{{"🧗\u200d♂️", SourceUnknown, 15}}},
+	{"climbing woman", []WeightedEmoji{{"🧗\u200d♀️", SourceUnknown, 15}}},
+	{"clinking beer mugs", []WeightedEmoji{{"🍻", SourceUnknown, 15}}},
+	{"clinking glasses", []WeightedEmoji{{"🥂", SourceUnknown, 15}}},
+	{"clipboard", []WeightedEmoji{{"📋", SourceUnknown, 15}}},
+	{"clipperton island", []WeightedEmoji{{"🇨🇵", SourceUnknown, 15}}},
+	{"clock1", []WeightedEmoji{{"🕐", SourceUnknown, 15}}},
+	{"clock10", []WeightedEmoji{{"🕙", SourceUnknown, 15}}},
+	{"clock1030", []WeightedEmoji{{"🕥", SourceUnknown, 15}}},
+	{"clock11", []WeightedEmoji{{"🕚", SourceUnknown, 15}}},
+	{"clock1130", []WeightedEmoji{{"🕦", SourceUnknown, 15}}},
+	{"clock12", []WeightedEmoji{{"🕛", SourceUnknown, 15}}},
+	{"clock1230", []WeightedEmoji{{"🕧", SourceUnknown, 15}}},
+	{"clock130", []WeightedEmoji{{"🕜", SourceUnknown, 15}}},
+	{"clock2", []WeightedEmoji{{"🕑", SourceUnknown, 15}}},
+	{"clock230", []WeightedEmoji{{"🕝", SourceUnknown, 15}}},
+	{"clock3", []WeightedEmoji{{"🕒", SourceUnknown, 15}}},
+	{"clock330", []WeightedEmoji{{"🕞", SourceUnknown, 15}}},
+	{"clock4", []WeightedEmoji{{"🕓", SourceUnknown, 15}}},
+	{"clock430", []WeightedEmoji{{"🕟", SourceUnknown, 15}}},
+	{"clock5", []WeightedEmoji{{"🕔", SourceUnknown, 15}}},
+	{"clock530", []WeightedEmoji{{"🕠", SourceUnknown, 15}}},
+	{"clock6", []WeightedEmoji{{"🕕", SourceUnknown, 15}}},
+	{"clock630", []WeightedEmoji{{"🕡", SourceUnknown, 15}}},
+	{"clock7", []WeightedEmoji{{"🕖", SourceUnknown, 15}}},
+	{"clock730", []WeightedEmoji{{"🕢", SourceUnknown, 15}}},
+	{"clock8", []WeightedEmoji{{"🕗", SourceUnknown, 15}}},
+	{"clock830", []WeightedEmoji{{"🕣", SourceUnknown, 15}}},
+	{"clock9", []WeightedEmoji{{"🕘", SourceUnknown, 15}}},
+	{"clock930", []WeightedEmoji{{"🕤", SourceUnknown, 15}}},
+	{"clockwise vertical arrows", []WeightedEmoji{{"🔃", SourceUnknown, 15}}},
+	{"closed book", []WeightedEmoji{{"📕", SourceUnknown, 15}}},
+	{"closed lock with key", []WeightedEmoji{{"🔐", SourceUnknown, 15}}},
+	{"closed mailbox with lowered flag", []WeightedEmoji{{"📪", SourceUnknown, 15}}},
+	{"closed mailbox with raised flag", []WeightedEmoji{{"📫", SourceUnknown, 15}}},
+	{"closed umbrella", []WeightedEmoji{{"🌂", SourceUnknown, 15}}},
+	{"cloud", []WeightedEmoji{{"☁️", SourceUnknown, 15}, {"⛅", SourceUnknown, 15}}},
+	{"cloud with lightning", []WeightedEmoji{{"🌩️", SourceUnknown, 15}}},
+	{"cloud with lightning and rain", []WeightedEmoji{{"⛈️", SourceUnknown, 15}}},
+	{"cloud with rain", []WeightedEmoji{{"🌧️", SourceUnknown, 15}}},
+	{"cloud with snow", []WeightedEmoji{{"🌨️", SourceUnknown, 15}}},
+	{"clown face", []WeightedEmoji{{"🤡", SourceUnknown, 15}}},
+	{"club suit", []WeightedEmoji{{"♣️", SourceUnknown, 15}}},
+	{"clubs", []WeightedEmoji{{"♣️", SourceUnknown, 15}}},
+	{"clutch bag", []WeightedEmoji{{"👝", SourceUnknown, 15}}},
+	{"cn", []WeightedEmoji{{"🇨🇳", SourceUnknown, 15}}},
+	{"coat", []WeightedEmoji{{"🧥", SourceUnknown, 15}}},
+	{"cockroach", []WeightedEmoji{{"🪳", SourceUnknown, 15}}},
+	{"cocktail", []WeightedEmoji{{"🍸", SourceUnknown, 15}}},
+	{"cocktail glass", []WeightedEmoji{{"🍸", SourceUnknown, 15}}},
+	{"coconut", []WeightedEmoji{{"🥥", SourceUnknown, 15}}},
+	{"cocos islands", []WeightedEmoji{{"🇨🇨", SourceUnknown, 15}}},
+	{"coder", []WeightedEmoji{{"👨\u200d💻", SourceUnknown, 15}, {"👩\u200d💻", SourceUnknown, 15}}},
+	{"coffee", []WeightedEmoji{{"☕", SourceUnknown, 15}}},
+	{"coffin", []WeightedEmoji{{"⚰️", SourceUnknown, 15}}},
+	{"coin", []WeightedEmoji{{"🪙", SourceUnknown, 15}}},
+	{"cold", []WeightedEmoji{{"❄️", SourceUnknown, 15}}},
+	{"cold face", []WeightedEmoji{{"🥶", SourceUnknown, 15}}},
+	{"cold sweat", []WeightedEmoji{{"😰", SourceUnknown, 15}}},
+	{"college", []WeightedEmoji{{"🎓", SourceUnknown, 15}}},
+	{"collision", []WeightedEmoji{{"💥", SourceUnknown, 15}}},
+	{"colombia", []WeightedEmoji{{"🇨🇴", SourceUnknown, 15}}},
+	{"comet", []WeightedEmoji{{"☄️", SourceUnknown, 15}}},
+	{"comment", []WeightedEmoji{{"💬", SourceUnknown, 15}}},
+	{"comoros", []WeightedEmoji{{"🇰🇲", SourceUnknown, 15}}},
+	{"compass", []WeightedEmoji{{"🧭", SourceUnknown, 15}}},
+	{"computer", []WeightedEmoji{{"💻", SourceUnknown, 15}}},
+	{"computer disk", []WeightedEmoji{{"💽", SourceUnknown, 15}}},
+	{"computer mouse", []WeightedEmoji{{"🖱️", SourceUnknown, 15}}},
+	{"confetti ball", []WeightedEmoji{{"🎊", SourceUnknown, 15}}},
+	{"confounded", []WeightedEmoji{{"😖", SourceUnknown, 15}}},
+	{"confounded face", []WeightedEmoji{{"😖", SourceUnknown, 15}}},
+	{"confused", []WeightedEmoji{{"😕", SourceUnknown, 15}, {"🫤", SourceUnknown, 15}, {"❓", SourceUnknown, 15}}},
+	{"confused face", []WeightedEmoji{{"😕", SourceUnknown, 15}}},
+	{"congo brazzaville", []WeightedEmoji{{"🇨🇬", SourceUnknown, 15}}},
+	{"congo kinshasa", []WeightedEmoji{{"🇨🇩", SourceUnknown, 15}}},
+	{"congrats", []WeightedEmoji{{"🎉", SourceCustom, 40}}},
+	{"congratulations", []WeightedEmoji{{"🎉", SourceCustom, 40}, {"㊗️", SourceUnknown, 15}}},
+	{"console", []WeightedEmoji{{"🎮", SourceUnknown, 15}}},
+	{"construction", []WeightedEmoji{{"🚧", SourceUnknown, 15}}},
+	{"construction worker", []WeightedEmoji{{"👷", SourceUnknown, 15}}},
+	{"construction worker man", []WeightedEmoji{{"👷\u200d♂️", SourceUnknown, 15}}},
+	{"construction worker woman", []WeightedEmoji{{"👷\u200d♀️", SourceUnknown, 15}}},
+	{"contest", []WeightedEmoji{{"🏆", SourceUnknown, 15}}},
+	{"control knobs", []WeightedEmoji{{"🎛️", SourceUnknown, 15}}},
+	{"controller", []WeightedEmoji{{"🎮", SourceUnknown, 15}}},
+	{"convenience store", []WeightedEmoji{{"🏪", SourceUnknown, 15}}},
+	{"cook", []WeightedEmoji{{"🧑\u200d🍳", SourceUnknown, 15}}},
+	{"cook islands", []WeightedEmoji{{"🇨🇰", SourceUnknown, 15}}},
+	{"cooked rice", []WeightedEmoji{{"🍚", SourceUnknown, 15}}},
+	{"cookie", []WeightedEmoji{{"🍪", SourceUnknown, 15}}},
+	{"cooking", []WeightedEmoji{{"🍳", SourceUnknown, 15}}},
+	{"cool", []WeightedEmoji{{"😎", SourceCustom, 40}, {"🆒", SourceUnknown, 15}}},
+	{"cool button", []WeightedEmoji{{"🆒", SourceUnknown, 15}}},
+	{"cop", []WeightedEmoji{{"👮", SourceUnknown, 15}, {"👮\u200d♂️", SourceUnknown, 15}, {"👮\u200d♀️", SourceUnknown, 15}}},
+	{"copyright", []WeightedEmoji{{"©️", SourceUnknown, 15}}},
+	{"coral", []WeightedEmoji{{"🪸", SourceUnknown, 15}}},
+	{"corn", []WeightedEmoji{{"🌽", SourceUnknown, 15}}},
+	{"costa rica", []WeightedEmoji{{"🇨🇷", SourceUnknown, 15}}},
+	{"cote divoire", []WeightedEmoji{{"🇨🇮", SourceUnknown, 15}}},
+	{"couch and lamp", []WeightedEmoji{{"🛋️", SourceUnknown, 15}}},
+	{"counterclockwise arrows button", []WeightedEmoji{{"🔄", SourceUnknown, 15}}},
+	{"couple", []WeightedEmoji{{"👫", SourceUnknown, 15}, {"🧑\u200d🤝\u200d🧑", SourceUnknown, 15}, {"👭", SourceUnknown, 15}, {"👬", SourceUnknown, 15}}},
+	{"couple with heart", []WeightedEmoji{{"💑", SourceUnknown, 15}}},
+	{"couple with heart man man", []WeightedEmoji{{"👨\u200d❤️\u200d👨", SourceUnknown, 15}}},
+	{"couple with heart woman man", []WeightedEmoji{{"👩\u200d❤️\u200d👨", SourceUnknown, 15}}},
+	{"couple with heart woman woman", []WeightedEmoji{{"👩\u200d❤️\u200d👩", SourceUnknown, 15}}},
+	{"couple with heart: man, man", []WeightedEmoji{{"👨\u200d❤️\u200d👨", SourceUnknown, 15}}},
+	{"couple with heart: woman, man", []WeightedEmoji{{"👩\u200d❤️\u200d👨", SourceUnknown, 15}}},
+	{"couple with heart: woman, woman", []WeightedEmoji{{"👩\u200d❤️\u200d👩", SourceUnknown, 15}}},
+	{"couplekiss", []WeightedEmoji{{"💏", SourceUnknown, 15}}},
+	{"couplekiss man man", []WeightedEmoji{{"👨\u200d❤️\u200d💋\u200d👨", SourceUnknown, 15}}},
+	{"couplekiss man woman", []WeightedEmoji{{"👩\u200d❤️\u200d💋\u200d👨", SourceUnknown, 15}}},
+	{"couplekiss woman woman", []WeightedEmoji{{"👩\u200d❤️\u200d💋\u200d👩", SourceUnknown, 15}}},
+	{"cow", []WeightedEmoji{{"🐄", SourceUnknown, 15}, {"🐮", SourceUnknown, 15}}},
+	{"cow face", []WeightedEmoji{{"🐮", SourceUnknown, 15}}},
+	{"cow2", []WeightedEmoji{{"🐄", SourceUnknown, 15}}},
+	{"cowboy hat face", []WeightedEmoji{{"🤠", SourceUnknown, 15}}},
+	{"crab", []WeightedEmoji{{"🦀", SourceUnknown, 15}}},
+	{"crap", []WeightedEmoji{{"💩", SourceUnknown, 15}}},
+	{"crayon", []WeightedEmoji{{"🖍️", SourceUnknown, 15}}},
+	{"cream", []WeightedEmoji{{"💰", SourceUnknown, 15}}},
+	{"credit card", []WeightedEmoji{{"💳", SourceUnknown, 15}}},
+	{"crescent moon", []WeightedEmoji{{"🌙", SourceUnknown, 15}}},
+	{"cricket", []WeightedEmoji{{"🦗", SourceUnknown, 15}}},
+	{"cricket game", []WeightedEmoji{{"🏏", SourceUnknown, 15}}},
+	{"croatia", []WeightedEmoji{{"🇭🇷", SourceUnknown, 15}}},
+	{"crocodile", []WeightedEmoji{{"🐊", SourceUnknown, 15}}},
+	{"croissant", []WeightedEmoji{{"🥐", SourceUnknown, 15}}},
+	{"cross mark", []WeightedEmoji{{"❌", SourceUnknown, 15}}},
+	{"cross mark button", []WeightedEmoji{{"❎", SourceUnknown, 15}}},
+	{"crossed fingers", []WeightedEmoji{{"🤞", SourceUnknown, 15}}},
+	{"crossed flags", []WeightedEmoji{{"🎌", SourceUnknown, 15}}},
+	{"crossed swords", []WeightedEmoji{{"⚔️", SourceUnknown, 15}}},
+	{"crown", []WeightedEmoji{{"👑", SourceUnknown, 15}, {"🤴", SourceUnknown, 15}, {"👸", SourceUnknown, 15}}},
+	{"cruise", []WeightedEmoji{{"🛳️", SourceUnknown, 15}}},
+	{"crush", []WeightedEmoji{{"😍", SourceUnknown, 15}}},
+	{"crutch", []WeightedEmoji{{"🩼", SourceUnknown, 15}}},
+	{"cry", []WeightedEmoji{{"😢", SourceUnknown, 15}, {"😭", SourceUnknown, 15}}},
+	{"crying cat", []WeightedEmoji{{"😿", SourceUnknown, 15}}},
+	{"crying cat face", []WeightedEmoji{{"😿", SourceUnknown, 15}}},
+	{"crying face", []WeightedEmoji{{"😢", SourceUnknown, 15}}},
+	{"crystal ball", []WeightedEmoji{{"🔮", SourceUnknown, 15}}},
+	{"cuba", []WeightedEmoji{{"🇨🇺", SourceUnknown, 15}}},
+	{"cucumber", []WeightedEmoji{{"🥒", SourceUnknown, 15}}},
+	{"cup with straw", []WeightedEmoji{{"🥤", SourceUnknown, 15}}},
+	{"cupcake", []WeightedEmoji{{"🧁", SourceUnknown, 15}}},
+	{"cupid", []WeightedEmoji{{"💘", SourceUnknown, 15}}},
+	{"curacao", []WeightedEmoji{{"🇨🇼", SourceUnknown, 15}}},
+	{"curling stone", []WeightedEmoji{{"🥌", SourceUnknown, 15}}},
+	{"curly haired man", []WeightedEmoji{{"👨\u200d🦱", SourceUnknown, 15}}},
+	{"curly haired woman", []WeightedEmoji{{"👩\u200d🦱", SourceUnknown, 15}}},
+	{"curly loop", []WeightedEmoji{{"➰", SourceUnknown, 15}}},
+	{"currency exchange", []WeightedEmoji{{"💱", SourceUnknown, 15}}},
+	{"curry", []WeightedEmoji{{"🍛", SourceUnknown, 15}, {"🥘", SourceUnknown, 15}}},
+	{"curry rice", []WeightedEmoji{{"🍛", SourceUnknown, 15}}},
+	{"cursing face", []WeightedEmoji{{"🤬", SourceUnknown, 15}}},
+	{"custard", []WeightedEmoji{{"🍮", SourceUnknown, 15}}},
+	{"customs", []WeightedEmoji{{"🛃", SourceUnknown, 15}}},
+	{"cut", []WeightedEmoji{{"🔪", SourceUnknown, 15}, {"✂️", SourceUnknown, 15}}},
+	{"cut of meat", []WeightedEmoji{{"🥩", SourceUnknown, 15}}},
+	{"cutlery", []WeightedEmoji{{"🍴", SourceUnknown, 15}}},
+	{"cyclone", []WeightedEmoji{{"🌀", SourceUnknown, 15}}},
+	{"cyprus", []WeightedEmoji{{"🇨🇾", SourceUnknown, 15}}},
+	{"czech republic", []WeightedEmoji{{"🇨🇿", SourceUnknown, 15}}},
+	{"dad", []WeightedEmoji{{"👨", SourceUnknown, 15}}},
+	{"dagger", []WeightedEmoji{{"🗡️", SourceUnknown, 15}}},
+	{"dancer", []WeightedEmoji{{"💃", SourceUnknown, 15}, {"🕺", SourceUnknown, 15}}},
+	{"dancers", []WeightedEmoji{{"👯", SourceUnknown, 15}}},
+	{"dancing men", []WeightedEmoji{{"👯\u200d♂️", SourceUnknown, 15}}},
+	{"dancing women", []WeightedEmoji{{"👯\u200d♀️", SourceUnknown, 15}}},
+	{"danger", []WeightedEmoji{{"💀", SourceUnknown, 15}, {"☠️", SourceUnknown, 15}}},
+	{"dango", []WeightedEmoji{{"🍡", SourceUnknown, 15}}},
+	{"dark sunglasses", []WeightedEmoji{{"🕶️", SourceUnknown, 15}}},
+	{"dart", []WeightedEmoji{{"🎯", SourceUnknown, 15}}},
+	{"dash", []WeightedEmoji{{"💨", SourceUnknown, 15}}},
+	{"dashing away", []WeightedEmoji{{"💨", SourceUnknown, 15}}},
+	{"date", []WeightedEmoji{{"📅", SourceUnknown, 15}, {"🧑\u200d🤝\u200d🧑", SourceUnknown, 15}, {"👭", SourceUnknown, 15}, {"👫", SourceUnknown, 15}, {"👬", SourceUnknown, 15}}},
+	{"de", []WeightedEmoji{{"🇩🇪", SourceUnknown, 15}}},
+	{"dead", []WeightedEmoji{{"💀", SourceUnknown, 15}}},
+	{"deaf", []WeightedEmoji{{"🙉", SourceUnknown, 15}}},
+	{"deaf man", []WeightedEmoji{{"🧏\u200d♂️", SourceUnknown, 15}}},
+	{"deaf person", []WeightedEmoji{{"🧏", SourceUnknown, 15}}},
+	{"deaf woman", []WeightedEmoji{{"🧏\u200d♀️", SourceUnknown, 15}}},
+	{"deal", []WeightedEmoji{{"🤝", SourceUnknown, 15}}},
+	{"deciduous tree", []WeightedEmoji{{"🌳", SourceUnknown, 15}}},
+	{"deer", []WeightedEmoji{{"🦌", SourceUnknown, 15}}},
+	{"delivery truck", []WeightedEmoji{{"🚚", SourceUnknown, 15}}},
+	{"denied", []WeightedEmoji{{"🙅", SourceUnknown, 15}, {"🙅\u200d♂️", SourceUnknown, 15}, {"🙅\u200d♀️", SourceUnknown, 15}}},
+	{"denmark", []WeightedEmoji{{"🇩🇰", SourceUnknown, 15}}},
+	{"department store", []WeightedEmoji{{"🏬", SourceUnknown, 15}}},
+	{"derelict house", []WeightedEmoji{{"🏚️", SourceUnknown, 15}}},
+	{"desert", []WeightedEmoji{{"🏜️", SourceUnknown, 15}, {"🐪", SourceUnknown, 15}}},
+	{"desert island", []WeightedEmoji{{"🏝️", SourceUnknown, 15}}},
+	{"design", []WeightedEmoji{{"🎨", SourceUnknown, 15}}},
+	{"desktop", []WeightedEmoji{{"💻", SourceUnknown, 15}}},
+	{"desktop computer", []WeightedEmoji{{"🖥️", SourceUnknown, 15}}},
+	{"dessert", []WeightedEmoji{{"🍰", SourceUnknown, 15}}},
+	{"detective", []WeightedEmoji{{"🕵️", SourceUnknown, 15}}},
+	{"devil", []WeightedEmoji{{"😈", SourceUnknown, 15}, {"👿", SourceUnknown, 15}}},
+	{"diamond", []WeightedEmoji{{"💎", SourceUnknown, 15}}},
+	{"diamond shape with a dot inside", []WeightedEmoji{{"💠", SourceUnknown, 15}}},
+	{"diamond suit", []WeightedEmoji{{"♦️", SourceUnknown, 15}}},
+	{"diamond with a dot", []WeightedEmoji{{"💠", SourceUnknown, 15}}},
+	{"diamonds", []WeightedEmoji{{"♦️", SourceUnknown, 15}}},
+	{"dice", []WeightedEmoji{{"🎲", SourceUnknown, 15}}},
+	{"diego garcia", []WeightedEmoji{{"🇩🇬", SourceUnknown, 15}}},
+	{"dim button", []WeightedEmoji{{"🔅", SourceUnknown, 15}}},
+	{"dining", []WeightedEmoji{{"🍽️", SourceUnknown, 15}}},
+	{"dinner", []WeightedEmoji{{"🍽️", SourceUnknown, 15}}},
+	{"dinosaur", []WeightedEmoji{{"🦕", SourceUnknown, 15}, {"🦖", SourceUnknown, 15}}},
+	{"directory", []WeightedEmoji{{"📁", SourceUnknown, 15}}},
+	{"disappointed", []WeightedEmoji{{"😞", SourceUnknown, 15}}},
+	{"disappointed face", []WeightedEmoji{{"😞", SourceUnknown, 15}}},
+	{"disappointed relieved", []WeightedEmoji{{"😥", SourceUnknown, 15}}},
+	{"disapprove", []WeightedEmoji{{"👎", SourceUnknown, 15}}},
+	{"disco", []WeightedEmoji{{"🪩", SourceUnknown, 15}}},
+	{"disguised face", []WeightedEmoji{{"🥸", SourceUnknown, 15}}},
+	{"disgusted", []WeightedEmoji{{"🤢", SourceUnknown, 15}}},
+	{"divide", []WeightedEmoji{{"➗", SourceUnknown, 15}}},
+	{"diving mask", []WeightedEmoji{{"🤿", SourceUnknown, 15}}},
+	{"diya lamp", []WeightedEmoji{{"🪔", SourceUnknown, 15}}},
+	{"dizzy", []WeightedEmoji{{"💫", SourceUnknown, 15}}},
+	{"dizzy face", []WeightedEmoji{{"😵", SourceUnknown, 15}}},
+	{"djibouti", []WeightedEmoji{{"🇩🇯", SourceUnknown, 15}}},
+	{"dna", []WeightedEmoji{{"🧬", SourceUnknown, 15}}},
+	{"do not litter", []WeightedEmoji{{"🚯", SourceUnknown, 15}}},
+	{"doctor", []WeightedEmoji{{"👨\u200d⚕️", SourceUnknown, 15}, {"👩\u200d⚕️", SourceUnknown, 15}}},
+	{"document", []WeightedEmoji{{"📜", SourceUnknown, 15}, {"📄", SourceUnknown, 15}, {"📝", SourceUnknown, 15}}},
+	{"dodo", []WeightedEmoji{{"🦤", SourceUnknown, 15}}},
+	{"dog", []WeightedEmoji{{"🐕", SourceUnknown, 15}, {"🐶", SourceUnknown, 15}, {"🐩", SourceUnknown, 15}}},
+	{"dog face", []WeightedEmoji{{"🐶", SourceUnknown, 15}}},
+	{"dog2", []WeightedEmoji{{"🐕", SourceUnknown, 15}}},
+	{"dollar", []WeightedEmoji{{"💵", SourceUnknown, 15}, {"💰", SourceUnknown, 15}, {"💸", SourceUnknown, 15}}},
+	{"dollar banknote", []WeightedEmoji{{"💵", SourceUnknown, 15}}},
+	{"dolls", []WeightedEmoji{{"🎎", SourceUnknown, 15}}},
+	{"dolphin", []WeightedEmoji{{"🐬", SourceUnknown, 15}}},
+	{"dominica", []WeightedEmoji{{"🇩🇲", SourceUnknown, 15}}},
+	{"dominican republic", []WeightedEmoji{{"🇩🇴", SourceUnknown, 15}}},
+	{"donkey", []WeightedEmoji{{"🫏", SourceUnknown, 15}}},
+	{"door", []WeightedEmoji{{"🚪", SourceUnknown, 15}}},
+	{"dotted line face", []WeightedEmoji{{"🫥", SourceUnknown, 15}}},
+	{"dotted six-pointed star", []WeightedEmoji{{"🔯", SourceUnknown, 15}}},
+	{"double curly loop", []WeightedEmoji{{"➿", SourceUnknown, 15}}},
+	{"double exclamation mark", []WeightedEmoji{{"‼️", SourceUnknown, 15}}},
+	{"doughnut", []WeightedEmoji{{"🍩", SourceUnknown, 15}}},
+	{"dove", []WeightedEmoji{{"🕊️", SourceUnknown, 15}}},
+	{"down arrow", []WeightedEmoji{{"⬇️", SourceUnknown, 15}}},
+	{"down-left arrow", []WeightedEmoji{{"↙️", SourceUnknown, 15}}},
+	{"down-right arrow", []WeightedEmoji{{"↘️", SourceUnknown, 15}}},
+	{"downcast face with sweat", []WeightedEmoji{{"😓", SourceUnknown, 15}}},
+	{"downwards button", []WeightedEmoji{{"🔽", SourceUnknown, 15}}},
+	{"dragon", []WeightedEmoji{{"🐉", SourceUnknown, 15}}},
+	{"dragon face", []WeightedEmoji{{"🐲", SourceUnknown, 15}}},
+	{"drama", []WeightedEmoji{{"🎭", SourceUnknown, 15}}},
+	{"dread", []WeightedEmoji{{"🫠", SourceUnknown, 15}}},
+	{"dress", []WeightedEmoji{{"👗", SourceUnknown, 15}, {"💃", SourceUnknown, 15}}},
+	{"drink", []WeightedEmoji{{"🍸", SourceUnknown, 15}, {"🍺", SourceUnknown, 15}}},
+	{"drinks", []WeightedEmoji{{"🍻", SourceUnknown, 15}}},
+	{"dromedary camel", []WeightedEmoji{{"🐪", SourceUnknown, 15}}},
+	{"drooling face", []WeightedEmoji{{"🤤", SourceUnknown, 15}}},
+	{"drop of blood", []WeightedEmoji{{"🩸", SourceUnknown, 15}}},
+	{"droplet", []WeightedEmoji{{"💧", SourceUnknown, 15}}},
+	{"drum", []WeightedEmoji{{"🥁", SourceUnknown, 15}}},
+	{"duck", []WeightedEmoji{{"🦆", SourceUnknown, 15}}},
+	{"dumpling", []WeightedEmoji{{"🥟", SourceUnknown, 15}}},
+	{"dvd", []WeightedEmoji{{"📀", SourceUnknown, 15}}},
+	{"e-mail", []WeightedEmoji{{"📧", SourceUnknown, 15}}},
+	{"eagle", []WeightedEmoji{{"🦅", SourceUnknown, 15}}},
+	{"ear", []WeightedEmoji{{"👂", SourceUnknown, 15}}},
+	{"ear of corn", []WeightedEmoji{{"🌽", SourceUnknown, 15}}},
+	{"ear of rice", []WeightedEmoji{{"🌾", SourceUnknown, 15}}},
+	{"ear with hearing aid", []WeightedEmoji{{"🦻", SourceUnknown, 15}}},
+	{"earphones", []WeightedEmoji{{"🎧", SourceUnknown, 15}}},
+	{"earth africa", []WeightedEmoji{{"🌍", SourceUnknown, 15}}},
+	{"earth americas", []WeightedEmoji{{"🌎", SourceUnknown, 15}}},
+	{"earth asia", []WeightedEmoji{{"🌏", SourceUnknown, 15}}},
+	{"ecuador", []WeightedEmoji{{"🇪🇨", SourceUnknown, 15}}},
+	{"education", []WeightedEmoji{{"🎓", SourceUnknown, 15}}},
+	{"egg", []WeightedEmoji{{"🥚", SourceUnknown, 15}}},
+	{"eggplant", []WeightedEmoji{{"🍆", SourceUnknown, 15}}},
+	{"egypt", []WeightedEmoji{{"🇪🇬", SourceUnknown, 15}}},
+	{"eight", []WeightedEmoji{{"8️⃣", SourceUnknown, 15}}},
+	{"eight o’clock", []WeightedEmoji{{"🕗", SourceUnknown, 15}}},
+	{"eight pointed black star", []WeightedEmoji{{"✴️", SourceUnknown, 15}}},
+	{"eight spoked asterisk", []WeightedEmoji{{"✳️", SourceUnknown, 15}}},
+	{"eight-pointed star", []WeightedEmoji{{"✴️", SourceUnknown, 15}}},
+	{"eight-spoked asterisk", []WeightedEmoji{{"✳️", SourceUnknown, 15}}},
+	{"eight-thirty", []WeightedEmoji{{"🕣", SourceUnknown, 15}}},
+	{"eject button", []WeightedEmoji{{"⏏️", SourceUnknown, 15}}},
+	{"el salvador", []WeightedEmoji{{"🇸🇻", SourceUnknown, 15}}},
+	{"electric plug", []WeightedEmoji{{"🔌", SourceUnknown, 15}}},
+	{"elephant", []WeightedEmoji{{"🐘", SourceUnknown, 15}}},
+	{"elevator", []WeightedEmoji{{"🛗", SourceUnknown, 15}}},
+	{"eleven o’clock", []WeightedEmoji{{"🕚", SourceUnknown, 15}}},
+	{"eleven-thirty", []WeightedEmoji{{"🕦", SourceUnknown, 15}}},
+	{"elf", []WeightedEmoji{{"🧝", SourceUnknown, 15}}},
+	{"elf man", []WeightedEmoji{{"🧝\u200d♂️", SourceUnknown, 15}}},
+	{"elf woman", []WeightedEmoji{{"🧝\u200d♀️", SourceUnknown, 15}}},
+	{"email", []WeightedEmoji{{"📧", SourceUnknown, 15}, {"💌", SourceUnknown, 15}, {"✉️", SourceUnknown, 15}}},
+	{"emergency", []WeightedEmoji{{"🚨", SourceUnknown, 15}, {"🆘", SourceUnknown, 15}}},
+	{"empty nest", []WeightedEmoji{{"🪹", SourceUnknown, 15}}},
+	{"end", []WeightedEmoji{{"🔚", SourceUnknown, 15}}},
+	{"end arrow", []WeightedEmoji{{"🔚", SourceUnknown, 15}}},
+	{"engaged", []WeightedEmoji{{"💍", SourceUnknown, 15}}},
+	{"england", []WeightedEmoji{{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", SourceUnknown, 15}}},
+	{"enraged face", []WeightedEmoji{{"😡", SourceUnknown, 15}}},
+	{"envelope", []WeightedEmoji{{"✉️", SourceUnknown, 15}, {"💌", SourceUnknown, 15}}},
+	{"envelope with arrow", []WeightedEmoji{{"📩", SourceUnknown, 15}}},
+	{"environment", []WeightedEmoji{{"♻️", SourceUnknown, 15}}},
+	{"equatorial guinea", []WeightedEmoji{{"🇬🇶", SourceUnknown, 15}}},
+	{"eritrea", []WeightedEmoji{{"🇪🇷", SourceUnknown, 15}}},
+	{"es", []WeightedEmoji{{"🇪🇸", SourceUnknown, 15}}},
+	{"espresso", []WeightedEmoji{{"☕", SourceUnknown, 15}}},
+	{"estonia", []WeightedEmoji{{"🇪🇪", SourceUnknown, 15}}},
+	{"ethiopia", []WeightedEmoji{{"🇪🇹", SourceUnknown, 15}}},
+	{"eu", []WeightedEmoji{{"🇪🇺", SourceUnknown, 15}}},
+	{"euro", []WeightedEmoji{{"💶", SourceUnknown, 15}}},
+	{"euro banknote", []WeightedEmoji{{"💶", SourceUnknown, 15}}},
+	{"european castle", []WeightedEmoji{{"🏰", SourceUnknown, 15}}},
+	{"european post office", []WeightedEmoji{{"🏤", SourceUnknown, 15}}},
+	{"european union", []WeightedEmoji{{"🇪🇺", SourceUnknown, 15}}},
+	{"evergreen tree", []WeightedEmoji{{"🌲", SourceUnknown, 15}}},
+	{"evil", []WeightedEmoji{{"😈", SourceUnknown, 15}, {"👿", SourceUnknown, 15}}},
+	{"ewe", []WeightedEmoji{{"🐑", SourceUnknown, 15}}},
+	{"exclamation", []WeightedEmoji{{"❗", SourceUnknown, 15}}},
+	{"exclamation question mark", []WeightedEmoji{{"⁉️", SourceUnknown, 15}}},
+	{"exercise", []WeightedEmoji{{"🏃", SourceUnknown, 15}, {"🏃\u200d♂️", SourceUnknown, 15}, {"🏃\u200d♀️", SourceUnknown, 15}}},
+	{"explode", []WeightedEmoji{{"💥", SourceUnknown, 15}}},
+	{"exploding head", []WeightedEmoji{{"🤯", SourceUnknown, 15}}},
+	{"expressionless", []WeightedEmoji{{"😑", SourceUnknown, 15}}},
+	{"expressionless face", []WeightedEmoji{{"😑", SourceUnknown, 15}}},
+	{"eye", []WeightedEmoji{{"👁️", SourceUnknown, 15}}},
+	{"eye in speech bubble", []WeightedEmoji{{"👁️\u200d🗨️", SourceUnknown, 15}}},
+	{"eye speech bubble", []WeightedEmoji{{"👁️\u200d🗨️", SourceUnknown, 15}}},
+	{"eyeglasses", []WeightedEmoji{{"👓", SourceUnknown, 15}}},
+	{"eyes", []WeightedEmoji{{"👀", SourceUnknown, 15}, {"🤩", SourceUnknown, 15}, {"🥺", SourceUnknown, 15}}},
+	{"face blowing a kiss", []WeightedEmoji{{"😘", SourceUnknown, 15}}},
+	{"face exhaling", []WeightedEmoji{{"😮\u200d💨", SourceUnknown, 15}}},
+	{"face holding back tears", []WeightedEmoji{{"🥹", SourceUnknown, 15}}},
+	{"face in clouds", []WeightedEmoji{{"😶\u200d🌫️", SourceUnknown, 15}}},
+	{"face savoring food", []WeightedEmoji{{"😋", SourceUnknown, 15}}},
+	{"face screaming in fear", []WeightedEmoji{{"😱", SourceUnknown, 15}}},
+	{"face vomiting", []WeightedEmoji{{"🤮", SourceUnknown, 15}}},
+	{"face with crossed-out eyes", []WeightedEmoji{{"😵", SourceUnknown, 15}}},
+	{"face with diagonal mouth", []WeightedEmoji{{"🫤", SourceUnknown, 15}}},
+	{"face with hand over mouth", []WeightedEmoji{{"🤭", SourceUnknown, 15}}},
+	{"face with head bandage", []WeightedEmoji{{"🤕", SourceUnknown, 15}}},
+	{"face with head-bandage", []WeightedEmoji{{"🤕", SourceUnknown, 15}}},
+	{"face with medical mask", []WeightedEmoji{{"😷", SourceUnknown, 15}}},
+	{"face with monocle", []WeightedEmoji{{"🧐", SourceUnknown, 15}}},
+	{"face with open eyes and hand over mouth", []WeightedEmoji{{"🫢", SourceUnknown, 15}}},
+	{"face with open mouth", []WeightedEmoji{{"😮", SourceUnknown, 15}}},
+	{"face with peeking eye", []WeightedEmoji{{"🫣", SourceUnknown, 15}}},
+	{"face with raised eyebrow", []WeightedEmoji{{"🤨", SourceUnknown, 15}}},
+	{"face with rolling eyes", []WeightedEmoji{{"🙄", SourceUnknown, 15}}},
+	{"face with spiral eyes", []WeightedEmoji{{"😵\u200d💫", SourceUnknown, 15}}},
+	{"face with steam from nose", []WeightedEmoji{{"😤", SourceUnknown, 15}}},
+	{"face with symbols on mouth", []WeightedEmoji{{"🤬", SourceUnknown, 15}}},
+	{"face with tears of joy", []WeightedEmoji{{"😂", SourceUnknown, 15}}},
+	{"face with thermometer", []WeightedEmoji{{"🤒", SourceUnknown, 15}}},
+	{"face with tongue", []WeightedEmoji{{"😛", SourceUnknown, 15}}},
+	{"face without mouth", []WeightedEmoji{{"😶", SourceUnknown, 15}}},
+	{"facepalm", []WeightedEmoji{{"🤦", SourceUnknown, 15}}},
+	{"facepunch", []WeightedEmoji{{"👊", SourceUnknown, 15}}},
+	{"factory", []WeightedEmoji{{"🏭", SourceUnknown, 15}}},
+	{"factory worker", []WeightedEmoji{{"🧑\u200d🏭", SourceUnknown, 15}}},
+	{"fairy", []WeightedEmoji{{"🧚", SourceUnknown, 15}}},
+	{"fairy man", []WeightedEmoji{{"🧚\u200d♂️", SourceUnknown, 15}}},
+	{"fairy woman", []WeightedEmoji{{"🧚\u200d♀️", SourceUnknown, 15}}},
+	{"falafel", []WeightedEmoji{{"🧆", SourceUnknown, 15}}},
+	{"falkland islands", []WeightedEmoji{{"🇫🇰", SourceUnknown, 15}}},
+	{"fallen leaf", []WeightedEmoji{{"🍂", SourceUnknown, 15}}},
+	{"family", []WeightedEmoji{{"👪", SourceUnknown, 15}}},
+	{"family man boy", []WeightedEmoji{{"👨\u200d👦", SourceUnknown, 15}}},
+	{"family man boy boy", []WeightedEmoji{{"👨\u200d👦\u200d👦", SourceUnknown, 15}}},
+	{"family man girl", []WeightedEmoji{{"👨\u200d👧", SourceUnknown, 15}}},
+	{"family man girl boy", []WeightedEmoji{{"👨\u200d👧\u200d👦", SourceUnknown, 15}}},
+	{"family man girl girl", []WeightedEmoji{{"👨\u200d👧\u200d👧", SourceUnknown, 15}}},
+	{"family man man boy", []WeightedEmoji{{"👨\u200d👨\u200d👦", SourceUnknown, 15}}},
+	{"family man man boy boy", []WeightedEmoji{{"👨\u200d👨\u200d👦\u200d👦", SourceUnknown, 15}}},
+	{"family man man girl", []WeightedEmoji{{"👨\u200d👨\u200d👧", SourceUnknown, 15}}},
+	{"family man man girl boy", []WeightedEmoji{{"👨\u200d👨\u200d👧\u200d👦", SourceUnknown, 15}}},
+	{"family man man girl girl", []WeightedEmoji{{"👨\u200d👨\u200d👧\u200d👧", SourceUnknown, 15}}},
+	{"family man woman boy", []WeightedEmoji{{"👨\u200d👩\u200d👦", SourceUnknown, 15}}},
+	{"family man woman boy boy", []WeightedEmoji{{"👨\u200d👩\u200d👦\u200d👦", SourceUnknown, 15}}},
+	{"family man woman girl", []WeightedEmoji{{"👨\u200d👩\u200d👧", SourceUnknown, 15}}},
+	{"family man woman girl boy", []WeightedEmoji{{"👨\u200d👩\u200d👧\u200d👦", SourceUnknown, 15}}},
+	{"family man woman girl girl", []WeightedEmoji{{"👨\u200d👩\u200d👧\u200d👧", SourceUnknown, 15}}},
+	{"family woman boy", []WeightedEmoji{{"👩\u200d👦", SourceUnknown, 15}}},
+	{"family woman boy boy", []WeightedEmoji{{"👩\u200d👦\u200d👦", SourceUnknown, 15}}},
+	{"family woman girl", []WeightedEmoji{{"👩\u200d👧", SourceUnknown, 15}}},
+	{"family woman girl boy", []WeightedEmoji{{"👩\u200d👧\u200d👦", SourceUnknown, 15}}},
+	{"family woman girl girl", []WeightedEmoji{{"👩\u200d👧\u200d👧", SourceUnknown, 15}}},
+	{"family woman woman boy", []WeightedEmoji{{"👩\u200d👩\u200d👦", SourceUnknown, 15}}},
+	{"family woman woman boy boy", []WeightedEmoji{{"👩\u200d👩\u200d👦\u200d👦", SourceUnknown, 15}}},
+	{"family woman woman girl", []WeightedEmoji{{"👩\u200d👩\u200d👧", SourceUnknown, 15}}},
+	{"family woman woman girl boy", []WeightedEmoji{{"👩\u200d👩\u200d👧\u200d👦", SourceUnknown, 15}}},
+	{"family woman woman girl girl", []WeightedEmoji{{"👩\u200d👩\u200d👧\u200d👧", SourceUnknown, 15}}},
+	{"family: man, boy", []WeightedEmoji{{"👨\u200d👦", SourceUnknown, 15}}},
+	{"family: man, boy, boy", []WeightedEmoji{{"👨\u200d👦\u200d👦", SourceUnknown, 15}}},
+	{"family: man, girl", []WeightedEmoji{{"👨\u200d👧", SourceUnknown, 15}}},
+	{"family: man, girl, boy", []WeightedEmoji{{"👨\u200d👧\u200d👦", SourceUnknown, 15}}},
+	{"family: man, girl, girl", []WeightedEmoji{{"👨\u200d👧\u200d👧", SourceUnknown, 15}}},
+	{"family: man, man, boy", []WeightedEmoji{{"👨\u200d👨\u200d👦", SourceUnknown, 15}}},
+	{"family: man, man, boy, boy", []WeightedEmoji{{"👨\u200d👨\u200d👦\u200d👦", SourceUnknown, 15}}},
+	{"family: man, man, girl", []WeightedEmoji{{"👨\u200d👨\u200d👧", SourceUnknown, 15}}},
+	{"family: man, man, girl, boy", []WeightedEmoji{{"👨\u200d👨\u200d👧\u200d👦", SourceUnknown, 15}}},
+	{"family: man, man, girl, girl", []WeightedEmoji{{"👨\u200d👨\u200d👧\u200d👧", SourceUnknown, 15}}},
+	{"family: man, woman, boy", []WeightedEmoji{{"👨\u200d👩\u200d👦", SourceUnknown, 15}}},
+	{"family: man, woman, boy, boy", []WeightedEmoji{{"👨\u200d👩\u200d👦\u200d👦", SourceUnknown, 15}}},
+	{"family: man, woman, girl", []WeightedEmoji{{"👨\u200d👩\u200d👧", SourceUnknown, 15}}},
+	{"family: man, woman, girl, boy", []WeightedEmoji{{"👨\u200d👩\u200d👧\u200d👦", SourceUnknown, 15}}},
+	{"family: man, woman, girl, girl", []WeightedEmoji{{"👨\u200d👩\u200d👧\u200d👧", SourceUnknown, 15}}},
+	{"family: woman, boy", []WeightedEmoji{{"👩\u200d👦", SourceUnknown, 15}}},
+	{"family: woman, boy, boy", []WeightedEmoji{{"👩\u200d👦\u200d👦", SourceUnknown, 15}}},
+	{"family: woman, girl", []WeightedEmoji{{"👩\u200d👧", SourceUnknown, 15}}},
+	{"family: woman, girl, boy", []WeightedEmoji{{"👩\u200d👧\u200d👦", SourceUnknown, 15}}},
+	{"family: woman, girl, girl", []WeightedEmoji{{"👩\u200d👧\u200d👧", SourceUnknown, 15}}},
+	{"family: woman, woman, boy", []WeightedEmoji{{"👩\u200d👩\u200d👦", SourceUnknown, 15}}},
+	{"family: woman, woman, boy, boy", []WeightedEmoji{{"👩\u200d👩\u200d👦\u200d👦", SourceUnknown, 15}}},
+	{"family: woman, woman, girl", []WeightedEmoji{{"👩\u200d👩\u200d👧", SourceUnknown, 15}}},
+	{"family: woman, woman, girl, boy", []WeightedEmoji{{"👩\u200d👩\u200d👧\u200d👦", SourceUnknown, 15}}},
+	{"family: woman, woman, girl, girl", []WeightedEmoji{{"👩\u200d👩\u200d👧\u200d👧", SourceUnknown, 15}}},
+	{"farmer", []WeightedEmoji{{"🧑\u200d🌾", SourceUnknown, 15}}},
+	{"faroe islands", []WeightedEmoji{{"🇫🇴", SourceUnknown, 15}}},
+	{"fast", []WeightedEmoji{{"💨", SourceUnknown, 15}}},
+	{"fast down button", []WeightedEmoji{{"⏬", SourceUnknown, 15}}},
+	{"fast forward", []WeightedEmoji{{"⏩", SourceUnknown, 15}}},
+	{"fast reverse button", []WeightedEmoji{{"⏪", SourceUnknown, 15}}},
+	{"fast up button", []WeightedEmoji{{"⏫", SourceUnknown, 15}}},
+	{"fast-forward button", []WeightedEmoji{{"⏩", SourceUnknown, 15}}},
+	{"father", []WeightedEmoji{{"👨", SourceUnknown, 15}}},
+	{"fax", []WeightedEmoji{{"📠", SourceUnknown, 15}}},
+	{"fax machine", []WeightedEmoji{{"📠", SourceUnknown, 15}}},
+	{"fearful", []WeightedEmoji{{"😨", SourceUnknown, 15}}},
+	{"fearful face", []WeightedEmoji{{"😨", SourceUnknown, 15}}},
+	{"feather", []WeightedEmoji{{"🪶", SourceUnknown, 15}}},
+	{"feet", []WeightedEmoji{{"🐾", SourceUnknown, 15}, {"👣", SourceUnknown, 15}}},
+	{"female detective", []WeightedEmoji{{"🕵️\u200d♀️", SourceUnknown, 15}}},
+	{"female sign", []WeightedEmoji{{"♀️", SourceUnknown, 15}}},
+	{"ferris wheel", []WeightedEmoji{{"🎡", SourceUnknown, 15}}},
+	{"ferry", []WeightedEmoji{{"⛴️", SourceUnknown, 15}}},
+	{"festival", []WeightedEmoji{{"🎆", SourceUnknown, 15}}},
+	{"field hockey", []WeightedEmoji{{"🏑", SourceUnknown, 15}}},
+	{"fiji", []WeightedEmoji{{"🇫🇯", SourceUnknown, 15}}},
+	{"file cabinet", []WeightedEmoji{{"🗄️", SourceUnknown, 15}}},
+	{"file folder", []WeightedEmoji{{"📁", SourceUnknown, 15}}},
+	{"film", []WeightedEmoji{{"🎥", SourceUnknown, 15}, {"🎬", SourceUnknown, 15}, {"🎦", SourceUnknown, 15}}},
+	{"film frames", []WeightedEmoji{{"🎞️", SourceUnknown, 15}}},
+	{"film projector", []WeightedEmoji{{"📽️", SourceUnknown, 15}}},
+	{"film strip", []WeightedEmoji{{"🎞️", SourceUnknown, 15}}},
+	{"finish", []WeightedEmoji{{"🏁", SourceUnknown, 15}}},
+	{"finland", []WeightedEmoji{{"🇫🇮", SourceUnknown, 15}}},
+	{"fire", []WeightedEmoji{{"🔥", SourceUnknown, 15}}},
+	{"fire engine", []WeightedEmoji{{"🚒", SourceUnknown, 15}}},
+	{"fire extinguisher", []WeightedEmoji{{"🧯", SourceUnknown, 15}}},
+	{"firecracker", []WeightedEmoji{{"🧨", SourceUnknown, 15}}},
+	{"firefighter", []WeightedEmoji{{"🧑\u200d🚒", SourceUnknown, 15}}},
+	{"fireworks", []WeightedEmoji{{"🎆", SourceUnknown, 15}}},
+	{"first quarter moon", []WeightedEmoji{{"🌓", SourceUnknown, 15}}},
+	{"first quarter moon face", []WeightedEmoji{{"🌛", SourceUnknown, 15}}},
+	{"first quarter moon with face", []WeightedEmoji{{"🌛", SourceUnknown, 15}}},
+	{"fish", []WeightedEmoji{{"🐟", SourceUnknown, 15}}},
+	{"fish cake", []WeightedEmoji{{"🍥", SourceUnknown, 15}}},
+	{"fish cake with swirl", []WeightedEmoji{{"🍥", SourceUnknown, 15}}},
+	{"fishing pole", []WeightedEmoji{{"🎣", SourceUnknown, 15}}},
+	{"fishing pole and fish", []WeightedEmoji{{"🎣", SourceUnknown, 15}}},
+	{"fist", []WeightedEmoji{{"✊", SourceUnknown, 15}}},
+	{"fist left", []WeightedEmoji{{"🤛", SourceUnknown, 15}}},
+	{"fist oncoming", []WeightedEmoji{{"👊", SourceUnknown, 15}}},
+	{"fist raised", []WeightedEmoji{{"✊", SourceUnknown, 15}}},
+	{"fist right", []WeightedEmoji{{"🤜", SourceUnknown, 15}}},
+	{"five", []WeightedEmoji{{"5️⃣", SourceUnknown, 15}}},
+	{"five o’clock", []WeightedEmoji{{"🕔", SourceUnknown, 15}}},
+	{"five-thirty", []WeightedEmoji{{"🕠", SourceUnknown, 15}}},
+	{"flag", []WeightedEmoji{{"🇩🇪", SourceUnknown, 15}, {"🇬🇧", SourceUnknown, 15}, {"🇺🇸", SourceUnknown, 15}}},
+	{"flag in hole", []WeightedEmoji{{"⛳", SourceUnknown, 15}}},
+	{"flag: afghanistan", []WeightedEmoji{{"🇦🇫", SourceUnknown, 15}}},
+	{"flag: albania", []WeightedEmoji{{"🇦🇱", SourceUnknown, 15}}},
+	{"flag: algeria", []WeightedEmoji{{"🇩🇿", SourceUnknown, 15}}},
+	{"flag: american samoa", []WeightedEmoji{{"🇦🇸", SourceUnknown, 15}}},
+	{"flag: andorra", []WeightedEmoji{{"🇦🇩", SourceUnknown, 15}}},
+	{"flag: angola", []WeightedEmoji{{"🇦🇴", SourceUnknown, 15}}},
+	{"flag: anguilla", []WeightedEmoji{{"🇦🇮", SourceUnknown, 15}}},
+	{"flag: antarctica", []WeightedEmoji{{"🇦🇶", SourceUnknown, 15}}},
+	{"flag: antigua & barbuda", []WeightedEmoji{{"🇦🇬", SourceUnknown, 15}}},
+	{"flag: argentina", []WeightedEmoji{{"🇦🇷", SourceUnknown, 15}}},
+	{"flag: armenia", []WeightedEmoji{{"🇦🇲", SourceUnknown, 15}}},
+	{"flag: aruba", []WeightedEmoji{{"🇦🇼", SourceUnknown, 15}}},
+	{"flag: ascension island", []WeightedEmoji{{"🇦🇨", SourceUnknown, 15}}},
+	{"flag: australia", []WeightedEmoji{{"🇦🇺", SourceUnknown, 15}}},
+	{"flag: austria", []WeightedEmoji{{"🇦🇹", SourceUnknown, 15}}},
+	{"flag: azerbaijan", []WeightedEmoji{{"🇦🇿", SourceUnknown, 15}}},
+	{"flag: bahamas", []WeightedEmoji{{"🇧🇸", SourceUnknown, 15}}},
+	{"flag: bahrain", []WeightedEmoji{{"🇧🇭", SourceUnknown, 15}}},
+	{"flag: bangladesh", []WeightedEmoji{{"🇧🇩", SourceUnknown, 15}}},
+	{"flag: barbados", []WeightedEmoji{{"🇧🇧", SourceUnknown, 15}}},
+	{"flag: belarus", []WeightedEmoji{{"🇧🇾", SourceUnknown, 15}}},
+	{"flag: belgium", []WeightedEmoji{{"🇧🇪", SourceUnknown, 15}}},
+	{"flag: belize", []WeightedEmoji{{"🇧🇿", SourceUnknown, 15}}},
+	{"flag: benin", []WeightedEmoji{{"🇧🇯", SourceUnknown, 15}}},
+	{"flag: bermuda", []WeightedEmoji{{"🇧🇲", SourceUnknown, 15}}},
+	{"flag: bhutan", []WeightedEmoji{{"🇧🇹", SourceUnknown, 15}}},
+	{"flag: bolivia", []WeightedEmoji{{"🇧🇴", SourceUnknown, 15}}},
+	{"flag: bosnia & herzegovina", []WeightedEmoji{{"🇧🇦", SourceUnknown, 15}}},
+	{"flag: botswana", []WeightedEmoji{{"🇧🇼", SourceUnknown, 15}}},
+	{"flag: bouvet island", []WeightedEmoji{{"🇧🇻", SourceUnknown, 15}}},
+	{"flag: brazil", []WeightedEmoji{{"🇧🇷", SourceUnknown, 15}}},
+	{"flag: british indian ocean territory", []WeightedEmoji{{"🇮🇴", SourceUnknown, 15}}},
+	{"flag: british virgin islands", []WeightedEmoji{{"🇻🇬", SourceUnknown, 15}}},
+	{"flag: brunei", []WeightedEmoji{{"🇧🇳", SourceUnknown, 15}}},
+	{"flag: bulgaria", []WeightedEmoji{{"🇧🇬", SourceUnknown, 15}}},
+	{"flag: burkina faso", []WeightedEmoji{{"🇧🇫", SourceUnknown, 15}}},
+	{"flag: burundi", []WeightedEmoji{{"🇧🇮", SourceUnknown, 15}}},
+	{"flag: cambodia", []WeightedEmoji{{"🇰🇭", SourceUnknown, 15}}},
+	{"flag: cameroon", []WeightedEmoji{{"🇨🇲", SourceUnknown, 15}}},
+	{"flag: canada", []WeightedEmoji{{"🇨🇦", SourceUnknown, 15}}},
+	{"flag: canary islands", []WeightedEmoji{{"🇮🇨", SourceUnknown, 15}}},
+	{"flag: cape verde", []WeightedEmoji{{"🇨🇻", SourceUnknown, 15}}},
+	{"flag: caribbean netherlands", []WeightedEmoji{{"🇧🇶", SourceUnknown, 15}}},
+	{"flag: cayman islands", []WeightedEmoji{{"🇰🇾", SourceUnknown, 15}}},
+	{"flag: central african republic", []WeightedEmoji{{"🇨🇫", SourceUnknown, 15}}},
+	{"flag: ceuta & melilla", []WeightedEmoji{{"🇪🇦", SourceUnknown, 15}}},
+	{"flag: chad", []WeightedEmoji{{"🇹🇩", SourceUnknown, 15}}},
+	{"flag: chile", []WeightedEmoji{{"🇨🇱", SourceUnknown, 15}}},
+	{"flag: china", []WeightedEmoji{{"🇨🇳", SourceUnknown, 15}}},
+	{"flag: christmas island", []WeightedEmoji{{"🇨🇽", SourceUnknown, 15}}},
+	{"flag: clipperton island", []WeightedEmoji{{"🇨🇵", SourceUnknown, 15}}},
+	{"flag: cocos (keeling) islands", []WeightedEmoji{{"🇨🇨", SourceUnknown, 15}}},
+	{"flag: colombia", []WeightedEmoji{{"🇨🇴", SourceUnknown, 15}}},
+	{"flag: comoros", []WeightedEmoji{{"🇰🇲", SourceUnknown, 15}}},
+	{"flag: congo - brazzaville", []WeightedEmoji{{"🇨🇬", SourceUnknown, 15}}},
+	{"flag: congo - kinshasa", []WeightedEmoji{{"🇨🇩", SourceUnknown, 15}}},
+	{"flag: cook islands", []WeightedEmoji{{"🇨🇰", SourceUnknown, 15}}},
+	{"flag: costa rica", []WeightedEmoji{{"🇨🇷", SourceUnknown, 15}}},
+	{"flag: croatia", []WeightedEmoji{{"🇭🇷", SourceUnknown, 15}}},
+	{"flag: cuba", []WeightedEmoji{{"🇨🇺", SourceUnknown, 15}}},
+	{"flag: curaçao", []WeightedEmoji{{"🇨🇼", SourceUnknown, 15}}},
+	{"flag: cyprus", []WeightedEmoji{{"🇨🇾", SourceUnknown, 15}}},
+	{"flag: czechia", []WeightedEmoji{{"🇨🇿", SourceUnknown, 15}}},
+	{"flag: côte d’ivoire", []WeightedEmoji{{"🇨🇮", SourceUnknown, 15}}},
+	{"flag: denmark", []WeightedEmoji{{"🇩🇰", SourceUnknown, 15}}},
+	{"flag: diego garcia", []WeightedEmoji{{"🇩🇬", SourceUnknown, 15}}},
+	{"flag: djibouti", []WeightedEmoji{{"🇩🇯", SourceUnknown, 15}}},
+	{"flag: dominica", []WeightedEmoji{{"🇩🇲", SourceUnknown, 15}}},
+	{"flag: dominican republic", []WeightedEmoji{{"🇩🇴", SourceUnknown, 15}}},
+	{"flag: ecuador", []WeightedEmoji{{"🇪🇨", SourceUnknown, 15}}},
+	{"flag: egypt", []WeightedEmoji{{"🇪🇬", SourceUnknown, 15}}},
+	{"flag: el salvador", []WeightedEmoji{{"🇸🇻", SourceUnknown, 15}}},
+	{"flag: england", []WeightedEmoji{{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", SourceUnknown, 15}}},
+	{"flag: equatorial guinea", []WeightedEmoji{{"🇬🇶", SourceUnknown, 15}}},
+	{"flag: eritrea", []WeightedEmoji{{"🇪🇷", SourceUnknown, 15}}},
+	{"flag: estonia", []WeightedEmoji{{"🇪🇪", SourceUnknown, 15}}},
+	{"flag: eswatini", []WeightedEmoji{{"🇸🇿", SourceUnknown, 15}}},
+	{"flag: ethiopia", []WeightedEmoji{{"🇪🇹", SourceUnknown, 15}}},
+	{"flag: european union", []WeightedEmoji{{"🇪🇺", SourceUnknown, 15}}},
+	{"flag: falkland islands", []WeightedEmoji{{"🇫🇰", SourceUnknown, 15}}},
+	{"flag: faroe islands", []WeightedEmoji{{"🇫🇴", SourceUnknown, 15}}},
+	{"flag: fiji", []WeightedEmoji{{"🇫🇯", SourceUnknown, 15}}},
+	{"flag: finland", []WeightedEmoji{{"🇫🇮", SourceUnknown, 15}}},
+	{"flag: france", []WeightedEmoji{{"🇫🇷", SourceUnknown, 15}}},
+	{"flag: french guiana", []WeightedEmoji{{"🇬🇫", SourceUnknown, 15}}},
+	{"flag: french polynesia", []WeightedEmoji{{"🇵🇫", SourceUnknown, 15}}},
+	{"flag: french southern territories", []WeightedEmoji{{"🇹🇫", SourceUnknown, 15}}},
+	{"flag: gabon", []WeightedEmoji{{"🇬🇦", SourceUnknown, 15}}},
+	{"flag: gambia", []WeightedEmoji{{"🇬🇲", SourceUnknown, 15}}},
+	{"flag: georgia", []WeightedEmoji{{"🇬🇪", SourceUnknown, 15}}},
+	{"flag: germany", []WeightedEmoji{{"🇩🇪", SourceUnknown, 15}}},
+	{"flag: ghana", []WeightedEmoji{{"🇬🇭", SourceUnknown, 15}}},
+	{"flag: gibraltar", []WeightedEmoji{{"🇬🇮", SourceUnknown, 15}}},
+	{"flag: greece", []WeightedEmoji{{"🇬🇷", SourceUnknown, 15}}},
+	{"flag: greenland", []WeightedEmoji{{"🇬🇱", SourceUnknown, 15}}},
+	{"flag: grenada", []WeightedEmoji{{"🇬🇩", SourceUnknown, 15}}},
+	{"flag: guadeloupe", []WeightedEmoji{{"🇬🇵", SourceUnknown, 15}}},
+	{"flag: guam", []WeightedEmoji{{"🇬🇺", SourceUnknown, 15}}},
+	{"flag: guatemala", []WeightedEmoji{{"🇬🇹", SourceUnknown, 15}}},
+	{"flag: guernsey", []WeightedEmoji{{"🇬🇬", SourceUnknown, 15}}},
+	{"flag: guinea", []WeightedEmoji{{"🇬🇳", SourceUnknown, 15}}},
+	{"flag: guinea-bissau", []WeightedEmoji{{"🇬🇼", SourceUnknown, 15}}},
+	{"flag: guyana", []WeightedEmoji{{"🇬🇾", SourceUnknown, 15}}},
+	{"flag: haiti", []WeightedEmoji{{"🇭🇹", SourceUnknown, 15}}},
+	{"flag: heard & mcdonald islands", []WeightedEmoji{{"🇭🇲", SourceUnknown, 15}}},
+	{"flag: honduras", []WeightedEmoji{{"🇭🇳", SourceUnknown, 15}}},
+	{"flag: hong kong sar china", []WeightedEmoji{{"🇭🇰", SourceUnknown, 15}}},
+	{"flag: hungary", []WeightedEmoji{{"🇭🇺", SourceUnknown, 15}}},
+	{"flag: iceland", []WeightedEmoji{{"🇮🇸", SourceUnknown, 15}}},
+	{"flag: india", []WeightedEmoji{{"🇮🇳", SourceUnknown, 15}}},
+	{"flag: indonesia", []WeightedEmoji{{"🇮🇩", SourceUnknown, 15}}},
+	{"flag: iran", []WeightedEmoji{{"🇮🇷", SourceUnknown, 15}}},
+	{"flag: iraq", []WeightedEmoji{{"🇮🇶", SourceUnknown, 15}}},
+	{"flag: ireland", []WeightedEmoji{{"🇮🇪", SourceUnknown, 15}}},
+	{"flag: isle of man", []WeightedEmoji{{"🇮🇲", SourceUnknown, 15}}},
+	{"flag: israel", []WeightedEmoji{{"🇮🇱", SourceUnknown, 15}}},
+	{"flag: italy", []WeightedEmoji{{"🇮🇹", SourceUnknown, 15}}},
+	{"flag: jamaica", []WeightedEmoji{{"🇯🇲", SourceUnknown, 15}}},
+	{"flag: japan", []WeightedEmoji{{"🇯🇵", SourceUnknown, 15}}},
+	{"flag: jersey", []WeightedEmoji{{"🇯🇪", SourceUnknown, 15}}},
+	{"flag: jordan", []WeightedEmoji{{"🇯🇴", SourceUnknown, 15}}},
+	{"flag: kazakhstan", []WeightedEmoji{{"🇰🇿", SourceUnknown, 15}}},
+	{"flag: kenya", []WeightedEmoji{{"🇰🇪", SourceUnknown, 15}}},
+	{"flag: kiribati", []WeightedEmoji{{"🇰🇮", SourceUnknown, 15}}},
+	{"flag: kosovo", []WeightedEmoji{{"🇽🇰", SourceUnknown, 15}}},
+	{"flag: kuwait", []WeightedEmoji{{"🇰🇼", SourceUnknown, 15}}},
+	{"flag: kyrgyzstan", []WeightedEmoji{{"🇰🇬", SourceUnknown, 15}}},
+	{"flag: laos", []WeightedEmoji{{"🇱🇦", SourceUnknown, 15}}},
+	{"flag: latvia", []WeightedEmoji{{"🇱🇻", SourceUnknown, 15}}},
+	{"flag: lebanon", []WeightedEmoji{{"🇱🇧", SourceUnknown, 15}}},
+	{"flag: lesotho", []WeightedEmoji{{"🇱🇸", SourceUnknown, 15}}},
+	{"flag: liberia", []WeightedEmoji{{"🇱🇷", SourceUnknown, 15}}},
+	{"flag: libya", []WeightedEmoji{{"🇱🇾", SourceUnknown, 15}}},
+	{"flag: liechtenstein", []WeightedEmoji{{"🇱🇮", SourceUnknown, 15}}},
+	{"flag: lithuania", []WeightedEmoji{{"🇱🇹", SourceUnknown, 15}}},
+	{"flag: luxembourg", []WeightedEmoji{{"🇱🇺", SourceUnknown, 15}}},
+	{"flag: macao sar china", []WeightedEmoji{{"🇲🇴", SourceUnknown, 15}}},
+	{"flag: madagascar", []WeightedEmoji{{"🇲🇬", SourceUnknown, 15}}},
+	{"flag: malawi", []WeightedEmoji{{"🇲🇼", SourceUnknown, 15}}},
+	{"flag: malaysia", []WeightedEmoji{{"🇲🇾", SourceUnknown, 15}}},
+	{"flag: maldives", []WeightedEmoji{{"🇲🇻", SourceUnknown, 15}}},
+	{"flag: mali", []WeightedEmoji{{"🇲🇱", SourceUnknown, 15}}},
+	{"flag: malta", []WeightedEmoji{{"🇲🇹", SourceUnknown, 15}}},
+	{"flag: marshall islands", []WeightedEmoji{{"🇲🇭", SourceUnknown, 15}}},
+	{"flag: martinique", []WeightedEmoji{{"🇲🇶", SourceUnknown, 15}}},
+	{"flag: mauritania", []WeightedEmoji{{"🇲🇷", SourceUnknown, 15}}},
+	{"flag: mauritius", []WeightedEmoji{{"🇲🇺", SourceUnknown, 15}}},
+	{"flag: mayotte", []WeightedEmoji{{"🇾🇹", SourceUnknown, 15}}},
+	{"flag: mexico", []WeightedEmoji{{"🇲🇽", SourceUnknown, 15}}},
+	{"flag: micronesia", []WeightedEmoji{{"🇫🇲", SourceUnknown, 15}}},
+	{"flag: moldova", []WeightedEmoji{{"🇲🇩", SourceUnknown, 15}}},
+	{"flag: monaco", []WeightedEmoji{{"🇲🇨", SourceUnknown, 15}}},
+	{"flag: mongolia", []WeightedEmoji{{"🇲🇳", SourceUnknown, 15}}},
+	{"flag: montenegro", []WeightedEmoji{{"🇲🇪", SourceUnknown, 15}}},
+	{"flag: montserrat", []WeightedEmoji{{"🇲🇸", SourceUnknown, 15}}},
+	{"flag: morocco", []WeightedEmoji{{"🇲🇦", SourceUnknown, 15}}},
+	{"flag: mozambique", []WeightedEmoji{{"🇲🇿", SourceUnknown, 15}}},
+	{"flag: myanmar (burma)", []WeightedEmoji{{"🇲🇲", SourceUnknown, 15}}},
+	{"flag: namibia", []WeightedEmoji{{"🇳🇦", SourceUnknown, 15}}},
+	{"flag: nauru", []WeightedEmoji{{"🇳🇷", SourceUnknown, 15}}},
+	{"flag: nepal", []WeightedEmoji{{"🇳🇵", SourceUnknown, 15}}},
+	{"flag: netherlands", []WeightedEmoji{{"🇳🇱", SourceUnknown, 15}}},
+	{"flag: new caledonia", []WeightedEmoji{{"🇳🇨", SourceUnknown, 15}}},
+	{"flag: new zealand", []WeightedEmoji{{"🇳🇿", SourceUnknown, 15}}},
+	{"flag: nicaragua", []WeightedEmoji{{"🇳🇮", SourceUnknown, 15}}},
+	{"flag: niger", []WeightedEmoji{{"🇳🇪", SourceUnknown, 15}}},
+	{"flag: nigeria", []WeightedEmoji{{"🇳🇬", SourceUnknown, 15}}},
+	{"flag: niue", []WeightedEmoji{{"🇳🇺", SourceUnknown, 15}}},
+	{"flag: norfolk island", []WeightedEmoji{{"🇳🇫", SourceUnknown, 15}}},
+	{"flag: north korea", []WeightedEmoji{{"🇰🇵", SourceUnknown, 15}}},
+	{"flag: north macedonia", []WeightedEmoji{{"🇲🇰", SourceUnknown, 15}}},
+	{"flag: northern mariana islands", []WeightedEmoji{{"🇲🇵", SourceUnknown, 15}}},
+	{"flag: norway", []WeightedEmoji{{"🇳🇴", SourceUnknown, 15}}},
+	{"flag: oman", []WeightedEmoji{{"🇴🇲", SourceUnknown, 15}}},
+	{"flag: pakistan", []WeightedEmoji{{"🇵🇰", SourceUnknown, 15}}},
+	{"flag: palau", []WeightedEmoji{{"🇵🇼", SourceUnknown, 15}}},
+	{"flag: palestinian territories", []WeightedEmoji{{"🇵🇸", SourceUnknown, 15}}},
+	{"flag: panama", []WeightedEmoji{{"🇵🇦", SourceUnknown, 15}}},
+	{"flag: papua new guinea", []WeightedEmoji{{"🇵🇬", SourceUnknown, 15}}},
+	{"flag: paraguay", []WeightedEmoji{{"🇵🇾", SourceUnknown, 15}}},
+	{"flag: peru", []WeightedEmoji{{"🇵🇪", SourceUnknown, 15}}},
+	{"flag: philippines", []WeightedEmoji{{"🇵🇭", SourceUnknown, 15}}},
+	{"flag: pitcairn islands", []WeightedEmoji{{"🇵🇳", SourceUnknown, 15}}},
+	{"flag: poland", []WeightedEmoji{{"🇵🇱", SourceUnknown, 15}}},
+	{"flag: portugal", []WeightedEmoji{{"🇵🇹", SourceUnknown, 15}}},
+	{"flag: puerto rico", []WeightedEmoji{{"🇵🇷", SourceUnknown, 15}}},
+	{"flag: qatar", []WeightedEmoji{{"🇶🇦", SourceUnknown, 15}}},
+	{"flag: romania", []WeightedEmoji{{"🇷🇴", SourceUnknown, 15}}},
+	{"flag: russia", []WeightedEmoji{{"🇷🇺", SourceUnknown, 15}}},
+	{"flag: rwanda", []WeightedEmoji{{"🇷🇼", SourceUnknown, 15}}},
+	{"flag: réunion", []WeightedEmoji{{"🇷🇪", SourceUnknown, 15}}},
+	{"flag: samoa", []WeightedEmoji{{"🇼🇸", SourceUnknown, 15}}},
+	{"flag: san marino", []WeightedEmoji{{"🇸🇲", SourceUnknown, 15}}},
+	{"flag: saudi arabia", []WeightedEmoji{{"🇸🇦", SourceUnknown, 15}}},
+	{"flag: scotland", []WeightedEmoji{{"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", SourceUnknown, 15}}},
+	{"flag: senegal", []WeightedEmoji{{"🇸🇳", SourceUnknown, 15}}},
+	{"flag: serbia", []WeightedEmoji{{"🇷🇸", SourceUnknown, 15}}},
+	{"flag: seychelles", []WeightedEmoji{{"🇸🇨", SourceUnknown, 15}}},
+	{"flag: sierra leone", []WeightedEmoji{{"🇸🇱", SourceUnknown, 15}}},
+	{"flag: singapore", []WeightedEmoji{{"🇸🇬", SourceUnknown, 15}}},
+	{"flag: sint maarten", []WeightedEmoji{{"🇸🇽", SourceUnknown, 15}}},
+	{"flag: slovakia", []WeightedEmoji{{"🇸🇰", SourceUnknown, 15}}},
+	{"flag: slovenia", []WeightedEmoji{{"🇸🇮", SourceUnknown, 15}}},
+	{"flag: solomon islands", []WeightedEmoji{{"🇸🇧", SourceUnknown, 15}}},
+	{"flag: somalia", []WeightedEmoji{{"🇸🇴", SourceUnknown, 15}}},
+	{"flag: south africa", []WeightedEmoji{{"🇿🇦", SourceUnknown, 15}}},
+	{"flag: south georgia & south sandwich islands", []WeightedEmoji{{"🇬🇸", SourceUnknown, 15}}},
+	{"flag: south korea", []WeightedEmoji{{"🇰🇷", SourceUnknown, 15}}},
+	{"flag: south sudan", []WeightedEmoji{{"🇸🇸", SourceUnknown, 15}}},
+	{"flag: spain", []WeightedEmoji{{"🇪🇸", SourceUnknown, 15}}},
+	{"flag: sri lanka", []WeightedEmoji{{"🇱🇰", SourceUnknown, 15}}},
+	{"flag: st. barthélemy", []WeightedEmoji{{"🇧🇱", SourceUnknown, 15}}},
+	{"flag: st. helena", []WeightedEmoji{{"🇸🇭", SourceUnknown, 15}}},
+	{"flag: st. kitts & nevis", []WeightedEmoji{{"🇰🇳", SourceUnknown, 15}}},
+	{"flag: st. lucia", []WeightedEmoji{{"🇱🇨", SourceUnknown, 15}}},
+	{"flag: st. martin", []WeightedEmoji{{"🇲🇫", SourceUnknown, 15}}},
+	{"flag: st. pierre & miquelon", []WeightedEmoji{{"🇵🇲", SourceUnknown, 15}}},
+	{"flag: st. vincent & grenadines", []WeightedEmoji{{"🇻🇨", SourceUnknown, 15}}},
+	{"flag: sudan", []WeightedEmoji{{"🇸🇩", SourceUnknown, 15}}},
+	{"flag: suriname", []WeightedEmoji{{"🇸🇷", SourceUnknown, 15}}},
+	{"flag: svalbard & jan mayen", []WeightedEmoji{{"🇸🇯", SourceUnknown, 15}}},
+	{"flag: sweden", []WeightedEmoji{{"🇸🇪", SourceUnknown, 15}}},
+	{"flag: switzerland", []WeightedEmoji{{"🇨🇭", SourceUnknown, 15}}},
+	{"flag: syria", []WeightedEmoji{{"🇸🇾", SourceUnknown, 15}}},
+	{"flag: são tomé & príncipe", []WeightedEmoji{{"🇸🇹", SourceUnknown, 15}}},
+	{"flag: taiwan", []WeightedEmoji{{"🇹🇼", SourceUnknown, 15}}},
+	{"flag: tajikistan", []WeightedEmoji{{"🇹🇯", SourceUnknown, 15}}},
+	{"flag: tanzania", []WeightedEmoji{{"🇹🇿", SourceUnknown, 15}}},
+	{"flag: thailand", []WeightedEmoji{{"🇹🇭", SourceUnknown, 15}}},
+	{"flag: timor-leste", []WeightedEmoji{{"🇹🇱", SourceUnknown, 15}}},
+	{"flag: togo", []WeightedEmoji{{"🇹🇬", SourceUnknown, 15}}},
+	{"flag: tokelau", []WeightedEmoji{{"🇹🇰", SourceUnknown, 15}}},
+	{"flag: tonga", []WeightedEmoji{{"🇹🇴", SourceUnknown, 15}}},
+	{"flag: trinidad & tobago", []WeightedEmoji{{"🇹🇹", SourceUnknown, 15}}},
+	{"flag: tristan da cunha", []WeightedEmoji{{"🇹🇦", SourceUnknown, 15}}},
+	{"flag: tunisia", []WeightedEmoji{{"🇹🇳", SourceUnknown, 15}}},
+	{"flag: turkey", []WeightedEmoji{{"🇹🇷", SourceUnknown, 15}}},
+	{"flag: turkmenistan", []WeightedEmoji{{"🇹🇲", SourceUnknown, 15}}},
+	{"flag: turks & caicos islands", []WeightedEmoji{{"🇹🇨", SourceUnknown, 15}}},
+	{"flag: tuvalu", []WeightedEmoji{{"🇹🇻", SourceUnknown, 15}}},
+	{"flag: u.s. outlying islands", []WeightedEmoji{{"🇺🇲", SourceUnknown, 15}}},
+	{"flag: u.s. virgin islands", []WeightedEmoji{{"🇻🇮", SourceUnknown, 15}}},
+	{"flag: uganda", []WeightedEmoji{{"🇺🇬", SourceUnknown, 15}}},
+	{"flag: ukraine", []WeightedEmoji{{"🇺🇦", SourceUnknown, 15}}},
+	{"flag: united arab emirates", []WeightedEmoji{{"🇦🇪", SourceUnknown, 15}}},
+	{"flag: united kingdom", []WeightedEmoji{{"🇬🇧", SourceUnknown, 15}}},
+	{"flag: united nations", []WeightedEmoji{{"🇺🇳", SourceUnknown, 15}}},
+	{"flag: united states", []WeightedEmoji{{"🇺🇸", SourceUnknown, 15}}},
+	{"flag: uruguay", []WeightedEmoji{{"🇺🇾", SourceUnknown, 15}}},
+	{"flag: uzbekistan", []WeightedEmoji{{"🇺🇿", SourceUnknown, 15}}},
+	{"flag: vanuatu", []WeightedEmoji{{"🇻🇺", SourceUnknown, 15}}},
+	{"flag: vatican city", []WeightedEmoji{{"🇻🇦", SourceUnknown, 15}}},
+	{"flag: venezuela", []WeightedEmoji{{"🇻🇪", SourceUnknown, 15}}},
+	{"flag: vietnam", []WeightedEmoji{{"🇻🇳", SourceUnknown, 15}}},
+	{"flag: wales", []WeightedEmoji{{"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f", SourceUnknown, 15}}},
+	{"flag: wallis & futuna", []WeightedEmoji{{"🇼🇫", SourceUnknown, 15}}},
+	{"flag: western sahara", []WeightedEmoji{{"🇪🇭", SourceUnknown, 15}}},
+	{"flag: yemen", []WeightedEmoji{{"🇾🇪", SourceUnknown, 15}}},
+	{"flag: zambia", []WeightedEmoji{{"🇿🇲", SourceUnknown, 15}}},
+	{"flag: zimbabwe", []WeightedEmoji{{"🇿🇼", SourceUnknown, 15}}},
+	{"flag: åland islands", []WeightedEmoji{{"🇦🇽", SourceUnknown, 15}}},
+	{"flags", []WeightedEmoji{{"🎏", SourceUnknown, 15}}},
+	{"flamingo", []WeightedEmoji{{"🦩", SourceUnknown, 15}}},
+	{"flashlight", []WeightedEmoji{{"🔦", SourceUnknown, 15}}},
+	{"flat shoe", []WeightedEmoji{{"🥿", SourceUnknown, 15}}},
+	{"flatbread", []WeightedEmoji{{"🫓", SourceUnknown, 15}}},
+	{"fleur de lis", []WeightedEmoji{{"⚜️", SourceUnknown, 15}}},
+	{"fleur-de-lis", []WeightedEmoji{{"⚜️", SourceUnknown, 15}}},
+	{"flex", []WeightedEmoji{{"💪", SourceUnknown, 15}}},
+	{"flexed biceps", []WeightedEmoji{{"💪", SourceUnknown, 15}}},
+	{"flight", []WeightedEmoji{{"✈️", SourceUnknown, 15}, {"🛩️", SourceUnknown, 15}}},
+	{"flight arrival", []WeightedEmoji{{"🛬", SourceUnknown, 15}}},
+	{"flight departure", []WeightedEmoji{{"🛫", SourceUnknown, 15}}},
+	{"flipper", []WeightedEmoji{{"🐬", SourceUnknown, 15}}},
+	{"flirt", []WeightedEmoji{{"😉", SourceUnknown, 15}, {"😘", SourceUnknown, 15}}},
+	{"floppy disk", []WeightedEmoji{{"💾", SourceUnknown, 15}}},
+	{"flower", []WeightedEmoji{{"🌸", SourceUnknown, 15}, {"🌹", SourceUnknown, 15}, {"🌷", SourceUnknown, 15}}},
+	{"flower playing cards", []WeightedEmoji{{"🎴", SourceUnknown, 15}}},
+	{"flowers", []WeightedEmoji{{"💐", SourceUnknown, 15}}},
+	{"flushed", []WeightedEmoji{{"😳", SourceUnknown, 15}}},
+	{"flushed face", []WeightedEmoji{{"😳", SourceUnknown, 15}}},
+	{"flute", []WeightedEmoji{{"🪈", SourceUnknown, 15}}},
+	{"fly", []WeightedEmoji{{"🪰", SourceUnknown, 15}, {"🪽", SourceUnknown, 15}}},
+	{"flying disc", []WeightedEmoji{{"🥏", SourceUnknown, 15}}},
+	{"flying saucer", []WeightedEmoji{{"🛸", SourceUnknown, 15}}},
+	{"fog", []WeightedEmoji{{"🌫️", SourceUnknown, 15}}},
+	{"foggy", []WeightedEmoji{{"🌁", SourceUnknown, 15}}},
+	{"folded hands", []WeightedEmoji{{"🙏", SourceUnknown, 15}}},
+	{"folding hand fan", []WeightedEmoji{{"🪭", SourceUnknown, 15}}},
+	{"fondue", []WeightedEmoji{{"🫕", SourceUnknown, 15}}},
+	{"foot", []WeightedEmoji{{"🦶", SourceUnknown, 15}}},
+	{"football", []WeightedEmoji{{"🏈", SourceUnknown, 15}}},
+	{"footprints", []WeightedEmoji{{"👣", SourceUnknown, 15}}},
+	{"forbidden", []WeightedEmoji{{"🚫", SourceUnknown, 15}}},
+	{"fork and knife", []WeightedEmoji{{"🍴", SourceUnknown, 15}}},
+	{"fork and knife with plate", []WeightedEmoji{{"🍽️", SourceUnknown, 15}}},
+	{"formal", []WeightedEmoji{{"👔", SourceUnknown, 15}}},
+	{"fortune", []WeightedEmoji{{"🔮", SourceUnknown, 15}}},
+	{"fortune cookie", []WeightedEmoji{{"🥠", SourceUnknown, 15}}},
+	{"foul", []WeightedEmoji{{"🤬", SourceUnknown, 15}}},
+	{"fountain", []WeightedEmoji{{"⛲", SourceUnknown, 15}}},
+	{"fountain pen", []WeightedEmoji{{"🖋️", SourceUnknown, 15}}},
+	{"four", []WeightedEmoji{{"4️⃣", SourceUnknown, 15}}},
+	{"four leaf clover", []WeightedEmoji{{"🍀", SourceUnknown, 15}}},
+	{"four o’clock", []WeightedEmoji{{"🕓", SourceUnknown, 15}}},
+	{"four-thirty", []WeightedEmoji{{"🕟", SourceUnknown, 15}}},
+	{"fox", []WeightedEmoji{{"🦊", SourceUnknown, 15}}},
+	{"fox face", []WeightedEmoji{{"🦊", SourceUnknown, 15}}},
+	{"fr", []WeightedEmoji{{"🇫🇷", SourceUnknown, 15}}},
+	{"framed picture", []WeightedEmoji{{"🖼️", SourceUnknown, 15}}},
+	{"france", []WeightedEmoji{{"🇫🇷", SourceUnknown, 15}}},
+	{"free", []WeightedEmoji{{"🆓", SourceUnknown, 15}}},
+	{"free button", []WeightedEmoji{{"🆓", SourceUnknown, 15}}},
+	{"freezing", []WeightedEmoji{{"🥶", SourceUnknown, 15}}},
+	{"french", []WeightedEmoji{{"🇫🇷", SourceUnknown, 15}}},
+	{"french fries", []WeightedEmoji{{"🍟", SourceUnknown, 15}}},
+	{"french guiana", []WeightedEmoji{{"🇬🇫", SourceUnknown, 15}}},
+	{"french polynesia", []WeightedEmoji{{"🇵🇫", SourceUnknown, 15}}},
+	{"french southern territories", []WeightedEmoji{{"🇹🇫", SourceUnknown, 15}}},
+	{"fresh", []WeightedEmoji{{"🆕", SourceUnknown, 15}}},
+	{"fried egg", []WeightedEmoji{{"🍳", SourceUnknown, 15}}},
+	{"fried shrimp", []WeightedEmoji{{"🍤", SourceUnknown, 15}}},
+	{"fries", []WeightedEmoji{{"🍟", SourceUnknown, 15}}},
+	{"frog", []WeightedEmoji{{"🐸", SourceUnknown, 15}}},
+	{"front-facing baby chick", []WeightedEmoji{{"🐥", SourceUnknown, 15}}},
+	{"frowning", []WeightedEmoji{{"😦", SourceUnknown, 15}}},
+	{"frowning face", []WeightedEmoji{{"☹️", SourceUnknown, 15}}},
+	{"frowning face with open mouth", []WeightedEmoji{{"😦", SourceUnknown, 15}}},
+	{"frowning man", []WeightedEmoji{{"🙍\u200d♂️", SourceUnknown, 15}}},
+	{"frowning person", []WeightedEmoji{{"🙍", SourceUnknown, 15}}},
+	{"frowning woman", []WeightedEmoji{{"🙍\u200d♀️", SourceUnknown, 15}}},
+	{"fruit", []WeightedEmoji{{"🍌", SourceUnknown, 15}, {"🍏", SourceUnknown, 15}, {"🍒", SourceUnknown, 15}, {"🍓", SourceUnknown, 15}}},
+	{"fu", []WeightedEmoji{{"🖕", SourceUnknown, 15}}},
+	{"fuel pump", []WeightedEmoji{{"⛽", SourceUnknown, 15}}},
+	{"fuelpump", []WeightedEmoji{{"⛽", SourceUnknown, 15}}},
+	{"full moon", []WeightedEmoji{{"🌕", SourceUnknown, 15}}},
+	{"full moon face", []WeightedEmoji{{"🌝", SourceUnknown, 15}}},
+	{"full moon with face", []WeightedEmoji{{"🌝", SourceUnknown, 15}}},
+	{"funeral", []WeightedEmoji{{"⚰️", SourceUnknown, 15}}},
+	{"funeral urn", []WeightedEmoji{{"⚱️", SourceUnknown, 15}}},
+	{"fungus", []WeightedEmoji{{"🍄", SourceUnknown, 15}}},
+	{"gabon", []WeightedEmoji{{"🇬🇦", SourceUnknown, 15}}},
+	{"gambia", []WeightedEmoji{{"🇬🇲", SourceUnknown, 15}}},
+	{"gambling", []WeightedEmoji{{"🎲", SourceUnknown, 15}}},
+	{"game", []WeightedEmoji{{"👾", SourceUnknown, 15}}},
+	{"game die", []WeightedEmoji{{"🎲", SourceUnknown, 15}}},
+	{"garlic", []WeightedEmoji{{"🧄", SourceUnknown, 15}}},
+	{"gasp", []WeightedEmoji{{"🫢", SourceUnknown, 15}, {"😲", SourceUnknown, 15}}},
+	{"gb", []WeightedEmoji{{"🇬🇧", SourceUnknown, 15}}},
+	{"gear", []WeightedEmoji{{"⚙️", SourceUnknown, 15}}},
+	{"geek", []WeightedEmoji{{"🤓", SourceUnknown, 15}}},
+	{"gem", []WeightedEmoji{{"💎", SourceUnknown, 15}}},
+	{"gem stone", []WeightedEmoji{{"💎", SourceUnknown, 15}}},
+	{"gemini", []WeightedEmoji{{"♊", SourceUnknown, 15}}},
+	{"genie", []WeightedEmoji{{"🧞", SourceUnknown, 15}}},
+	{"genie man", []WeightedEmoji{{"🧞\u200d♂️", SourceUnknown, 15}}},
+	{"genie woman", []WeightedEmoji{{"🧞\u200d♀️", SourceUnknown, 15}}},
+	{"georgia", []WeightedEmoji{{"🇬🇪", SourceUnknown, 15}}},
+	{"germ", []WeightedEmoji{{"🦠", SourceUnknown, 15}}},
+	{"germany", []WeightedEmoji{{"🇩🇪", SourceUnknown, 15}}},
+	{"ghana", []WeightedEmoji{{"🇬🇭", SourceUnknown, 15}}},
+	{"ghost", []WeightedEmoji{{"👻", SourceUnknown, 15}}},
+	{"gibraltar", []WeightedEmoji{{"🇬🇮", SourceUnknown, 15}}},
+	{"gift", []WeightedEmoji{{"🎁", SourceUnknown, 15}}},
+	{"gift heart", []WeightedEmoji{{"💝", SourceUnknown, 15}}},
+	{"ginger root", []WeightedEmoji{{"🫚", SourceUnknown, 15}}},
+	{"giraffe", []WeightedEmoji{{"🦒", SourceUnknown, 15}}},
+	{"girl", []WeightedEmoji{{"👧", SourceUnknown, 15}}},
+	{"girls", []WeightedEmoji{{"👩", SourceUnknown, 15}}},
+	{"glass of milk", []WeightedEmoji{{"🥛", SourceUnknown, 15}}},
+	{"glasses", []WeightedEmoji{{"👓", SourceUnknown, 15}, {"🤓", SourceUnknown, 15}}},
+	{"global", []WeightedEmoji{{"🌐", SourceUnknown, 15}}},
+	{"globe", []WeightedEmoji{{"🌍", SourceUnknown, 15}, {"🌎", SourceUnknown, 15}, {"🌏", SourceUnknown, 15}}},
+	{"globe showing americas", []WeightedEmoji{{"🌎", SourceUnknown, 15}}},
+	{"globe showing asia-australia", []WeightedEmoji{{"🌏", SourceUnknown, 15}}},
+	{"globe showing europe-africa", []WeightedEmoji{{"🌍", SourceUnknown, 15}}},
+	{"globe with meridians", []WeightedEmoji{{"🌐", SourceUnknown, 15}}},
+	{"gloves", []WeightedEmoji{{"🧤", SourceUnknown, 15}}},
+	{"glowing star", []WeightedEmoji{{"🌟", SourceUnknown, 15}}},
+	{"goal net", []WeightedEmoji{{"🥅", SourceUnknown, 15}}},
+	{"goat", []WeightedEmoji{{"🐐", SourceUnknown, 15}}},
+	{"goblin", []WeightedEmoji{{"👺", SourceUnknown, 15}}},
+	{"goggles", []WeightedEmoji{{"🥽", SourceUnknown, 15}}},
+	{"gold", []WeightedEmoji{{"🏅", SourceUnknown, 15}, {"🥇", SourceUnknown, 15}}},
+	{"golf", []WeightedEmoji{{"⛳", SourceUnknown, 15}}},
+	{"golfing", []WeightedEmoji{{"🏌️", SourceUnknown, 15}}},
+	{"golfing man", []WeightedEmoji{{"🏌️\u200d♂️", SourceUnknown, 15}}},
+	{"golfing woman", []WeightedEmoji{{"🏌️\u200d♀️", SourceUnknown, 15}}},
+	{"goodbye", []WeightedEmoji{{"👋", SourceUnknown, 15}}},
+	{"goofy", []WeightedEmoji{{"🤪", SourceUnknown, 15}}},
+	{"goose", []WeightedEmoji{{"🪿", SourceUnknown, 15}}},
+	{"gorilla", []WeightedEmoji{{"🦍", SourceUnknown, 15}}},
+	{"graduation", []WeightedEmoji{{"👨\u200d🎓", SourceUnknown, 15}, {"👩\u200d🎓", SourceUnknown, 15}, {"🎓", SourceUnknown, 15}}},
+	{"graduation cap", []WeightedEmoji{{"🎓", SourceUnknown, 15}}},
+	{"grapes", []WeightedEmoji{{"🍇", SourceUnknown, 15}}},
+	{"graph", []WeightedEmoji{{"📈", SourceUnknown, 15}, {"📉", SourceUnknown, 15}}},
+	{"gratitude", []WeightedEmoji{{"🥹", SourceUnknown, 15}}},
+	{"greece", []WeightedEmoji{{"🇬🇷", SourceUnknown, 15}}},
+	{"green", []WeightedEmoji{{"🍵", SourceUnknown, 15}, {"♻️", SourceUnknown, 15}}},
+	{"green apple", []WeightedEmoji{{"🍏", SourceUnknown, 15}}},
+	{"green book", []WeightedEmoji{{"📗", SourceUnknown, 15}}},
+	{"green circle", []WeightedEmoji{{"🟢", SourceUnknown, 15}}},
+	{"green heart", []WeightedEmoji{{"💚", SourceUnknown, 15}}},
+	{"green salad", []WeightedEmoji{{"🥗", SourceUnknown, 15}}},
+	{"green square", []WeightedEmoji{{"🟩", SourceUnknown, 15}}},
+	{"greenland", []WeightedEmoji{{"🇬🇱", SourceUnknown, 15}}},
+	{"grenada", []WeightedEmoji{{"🇬🇩", SourceUnknown, 15}}},
+	{"grey exclamation", []WeightedEmoji{{"❕", SourceUnknown, 15}}},
+	{"grey heart", []WeightedEmoji{{"🩶", SourceUnknown, 15}}},
+	{"grey question", []WeightedEmoji{{"❔", SourceUnknown, 15}}},
+	{"grimacing", []WeightedEmoji{{"😬", SourceUnknown, 15}}},
+	{"grimacing face", []WeightedEmoji{{"😬", SourceUnknown, 15}}},
+	{"grin", []WeightedEmoji{{"😁", SourceUnknown, 15}}},
+	{"grinning", []WeightedEmoji{{"😀", SourceUnknown, 15}}},
+	{"grinning cat", []WeightedEmoji{{"😺", SourceUnknown, 15}}},
+	{"grinning cat with smiling eyes", []WeightedEmoji{{"😸", SourceUnknown, 15}}},
+	{"grinning face", []WeightedEmoji{{"😀", SourceUnknown, 15}}},
+	{"grinning face with big eyes", []WeightedEmoji{{"😃", SourceUnknown, 15}}},
+	{"grinning face with smiling eyes", []WeightedEmoji{{"😄", SourceUnknown, 15}}},
+	{"grinning face with sweat", []WeightedEmoji{{"😅", SourceUnknown, 15}}},
+	{"grinning squinting face", []WeightedEmoji{{"😆", SourceUnknown, 15}}},
+	{"groggy", []WeightedEmoji{{"🥴", SourceUnknown, 15}}},
+	{"groom", []WeightedEmoji{{"🤵", SourceUnknown, 15}}},
+	{"group", []WeightedEmoji{{"👥", SourceUnknown, 15}}},
+	{"growing heart", []WeightedEmoji{{"💗", SourceUnknown, 15}}},
+	{"guadeloupe", []WeightedEmoji{{"🇬🇵", SourceUnknown, 15}}},
+	{"guam", []WeightedEmoji{{"🇬🇺", SourceUnknown, 15}}},
+	{"guard", []WeightedEmoji{{"💂", SourceUnknown, 15}}},
+	{"guardsman", []WeightedEmoji{{"💂\u200d♂️", SourceUnknown, 15}}},
+	{"guardswoman", []WeightedEmoji{{"💂\u200d♀️", SourceUnknown, 15}}},
+	{"guatemala", []WeightedEmoji{{"🇬🇹", SourceUnknown, 15}}},
+	{"guernsey", []WeightedEmoji{{"🇬🇬", SourceUnknown, 15}}},
+	{"guide dog", []WeightedEmoji{{"🦮", SourceUnknown, 15}}},
+	{"guinea", []WeightedEmoji{{"🇬🇳", SourceUnknown, 15}}},
+	{"guinea bissau", []WeightedEmoji{{"🇬🇼", SourceUnknown, 15}}},
+	{"guitar", []WeightedEmoji{{"🎸", SourceUnknown, 15}}},
+	{"gun", []WeightedEmoji{{"🔫", SourceUnknown, 15}}},
+	{"guyana", []WeightedEmoji{{"🇬🇾", SourceUnknown, 15}}},
+	{"gym", []WeightedEmoji{{"🏋️", SourceUnknown, 15}, {"🏋️\u200d♂️", SourceUnknown, 15}, {"🏋️\u200d♀️", SourceUnknown, 15}}},
+	{"haha", []WeightedEmoji{{"😃", SourceUnknown, 15}, {"😆", SourceUnknown, 15}}},
+	{"hair pick", []WeightedEmoji{{"🪮", SourceUnknown, 15}}},
+	{"haircut", []WeightedEmoji{{"💇", SourceUnknown, 15}}},
+	{"haircut man", []WeightedEmoji{{"💇\u200d♂️", SourceUnknown, 15}}},
+	{"haircut woman", []WeightedEmoji{{"💇\u200d♀️", SourceUnknown, 15}}},
+	{"haiti", []WeightedEmoji{{"🇭🇹", SourceUnknown, 15}}},
+	{"halloween", []WeightedEmoji{{"👻", SourceUnknown, 15}, {"🎃", SourceUnknown, 15}}},
+	{"halt", []WeightedEmoji{{"🙅", SourceUnknown, 15}, {"🙅\u200d♂️", SourceUnknown, 15}, {"🙅\u200d♀️", SourceUnknown, 15}}},
+	{"hamburger", []WeightedEmoji{{"🍔", SourceUnknown, 15}}},
+	{"hammer", []WeightedEmoji{{"🔨", SourceUnknown, 15}}},
+	{"hammer and pick", []WeightedEmoji{{"⚒️", SourceUnknown, 15}}},
+	{"hammer and wrench", []WeightedEmoji{{"🛠️", SourceUnknown, 15}}},
+	{"hamsa", []WeightedEmoji{{"🪬", SourceUnknown, 15}}},
+	{"hamster", []WeightedEmoji{{"🐹", SourceUnknown, 15}}},
+	{"hand", []WeightedEmoji{{"✋", SourceUnknown, 15}}},
+	{"hand over mouth", []WeightedEmoji{{"🤭", SourceUnknown, 15}}},
+	{"hand with fingers splayed", []WeightedEmoji{{"🖐️", SourceUnknown, 15}}},
+	{"hand with index finger and thumb crossed", []WeightedEmoji{{"🫰", SourceUnknown, 15}}},
+	{"handbag", []WeightedEmoji{{"👜", SourceUnknown, 15}}},
+	{"handball person", []WeightedEmoji{{"🤾", SourceUnknown, 15}}},
+	{"handshake", []WeightedEmoji{{"🤝", SourceUnknown, 15}}},
+	{"hankey", []WeightedEmoji{{"💩", SourceUnknown, 15}}},
+	{"happy", []WeightedEmoji{{"😀", SourceUnknown, 15}, {"😃", SourceUnknown, 15}, {"😄", SourceUnknown, 15}, {"😆", SourceUnknown, 15}}},
+	{"hash", []WeightedEmoji{{"#️⃣", SourceUnknown, 15}}},
+	{"hat", []WeightedEmoji{{"🎩", SourceUnknown, 15}}},
+	{"hatched chick", []WeightedEmoji{{"🐥", SourceUnknown, 15}}},
+	{"hatching chick", []WeightedEmoji{{"🐣", SourceUnknown, 15}}},
+	{"headphone", []WeightedEmoji{{"🎧", SourceUnknown, 15}}},
+	{"headphones", []WeightedEmoji{{"🎧", SourceUnknown, 15}}},
+	{"headstone", []WeightedEmoji{{"🪦", SourceUnknown, 15}}},
+	{"health", []WeightedEmoji{{"💉", SourceUnknown, 15}, {"💊", SourceUnknown, 15}}},
+	{"health worker", []WeightedEmoji{{"🧑\u200d⚕️", SourceUnknown, 15}}},
+	{"hear", []WeightedEmoji{{"👂", SourceUnknown, 15}}},
+	{"hear no evil", []WeightedEmoji{{"🙉", SourceUnknown, 15}}},
+	{"hear-no-evil monkey", []WeightedEmoji{{"🙉", SourceUnknown, 15}}},
+	{"heard mcdonald islands", []WeightedEmoji{{"🇭🇲", SourceUnknown, 15}}},
+	{"heart", []WeightedEmoji{{"❤️", SourceUnknown, 15}, {"💘", SourceUnknown, 15}}},
+	{"heart decoration", []WeightedEmoji{{"💟", SourceUnknown, 15}}},
+	{"heart exclamation", []WeightedEmoji{{"❣️", SourceUnknown, 15}}},
+	{"heart eyes", []WeightedEmoji{{"😍", SourceUnknown, 15}}},
+	{"heart eyes cat", []WeightedEmoji{{"😻", SourceUnknown, 15}}},
+	{"heart hands", []WeightedEmoji{{"🫶", SourceUnknown, 15}}},
+	{"heart on fire", []WeightedEmoji{{"❤️\u200d🔥", SourceUnknown, 15}}},
+	{"heart suit", []WeightedEmoji{{"♥️", SourceUnknown, 15}}},
+	{"heart with arrow", []WeightedEmoji{{"💘", SourceUnknown, 15}}},
+	{"heart with ribbon", []WeightedEmoji{{"💝", SourceUnknown, 15}}},
+	{"heartbeat", []WeightedEmoji{{"💓", SourceUnknown, 15}}},
+	{"heartpulse", []WeightedEmoji{{"💗", SourceUnknown, 15}}},
+	{"hearts", []WeightedEmoji{{"♥️", SourceUnknown, 15}}},
+	{"heat", []WeightedEmoji{{"🥵", SourceUnknown, 15}}},
+	{"heavy check mark", []WeightedEmoji{{"✔️", SourceUnknown, 15}}},
+	{"heavy division sign", []WeightedEmoji{{"➗", SourceUnknown, 15}}},
+	{"heavy dollar sign", []WeightedEmoji{{"💲", SourceUnknown, 15}}},
+	{"heavy equals sign", []WeightedEmoji{{"🟰", SourceUnknown, 15}}},
+	{"heavy exclamation mark", []WeightedEmoji{{"❗", SourceUnknown, 15}}},
+	{"heavy heart exclamation", []WeightedEmoji{{"❣️", SourceUnknown, 15}}},
+	{"heavy minus sign", []WeightedEmoji{{"➖", SourceUnknown, 15}}},
+	{"heavy multiplication x", []WeightedEmoji{{"✖️", SourceUnknown, 15}}},
+	{"heavy plus sign", []WeightedEmoji{{"➕", SourceUnknown, 15}}},
+	{"hedgehog", []WeightedEmoji{{"🦔", SourceUnknown, 15}}},
+	{"helicopter", []WeightedEmoji{{"🚁", SourceUnknown, 15}}},
+	{"helmet", []WeightedEmoji{{"👷", SourceUnknown, 15}, {"👷\u200d♂️", SourceUnknown, 15}, {"👷\u200d♀️", SourceUnknown, 15}}},
+	{"help", []WeightedEmoji{{"🆘", SourceUnknown, 15}}},
+	{"herb", []WeightedEmoji{{"🌿", SourceUnknown, 15}}},
+	{"hibiscus", []WeightedEmoji{{"🌺", SourceUnknown, 15}}},
+	{"high brightness", []WeightedEmoji{{"🔆", SourceUnknown, 15}}},
+	{"high heel", []WeightedEmoji{{"👠", SourceUnknown, 15}}},
+	{"high voltage", []WeightedEmoji{{"⚡", SourceUnknown, 15}}},
+	{"high-heeled shoe", []WeightedEmoji{{"👠", SourceUnknown, 15}}},
+	{"high-speed train", []WeightedEmoji{{"🚄", SourceUnknown, 15}}},
+	{"highfive", []WeightedEmoji{{"✋", SourceUnknown, 15}}},
+	{"hijab", []WeightedEmoji{{"🧕", SourceUnknown, 15}}},
+	{"hiking boot", []WeightedEmoji{{"🥾", SourceUnknown, 15}}},
+	{"hindu temple", []WeightedEmoji{{"🛕", SourceUnknown, 15}}},
+	{"hippopotamus", []WeightedEmoji{{"🦛", SourceUnknown, 15}}},
+	{"hocho", []WeightedEmoji{{"🔪", SourceUnknown, 15}}},
+	{"hole", []WeightedEmoji{{"🕳️", SourceUnknown, 15}}},
+	{"hollow red circle", []WeightedEmoji{{"⭕", SourceUnknown, 15}}},
+	{"home", []WeightedEmoji{{"👪", SourceUnknown, 15}}},
+	{"honduras", []WeightedEmoji{{"🇭🇳", SourceUnknown, 15}}},
+	{"honey pot", []WeightedEmoji{{"🍯", SourceUnknown, 15}}},
+	{"honeybee", []WeightedEmoji{{"🐝", SourceUnknown, 15}}},
+	{"hong kong", []WeightedEmoji{{"🇭🇰", SourceUnknown, 15}}},
+	{"honk", []WeightedEmoji{{"🪿", SourceUnknown, 15}}},
+	{"hook", []WeightedEmoji{{"🪝", SourceUnknown, 15}}},
+	{"hooray", []WeightedEmoji{{"🙌", SourceUnknown, 15}, {"🎉", SourceUnknown, 15}}},
+	{"hope", []WeightedEmoji{{"🙏", SourceUnknown, 15}}},
+	{"hopeful", []WeightedEmoji{{"🤞", SourceUnknown, 15}}},
+	{"horizontal traffic light", []WeightedEmoji{{"🚥", SourceUnknown, 15}}},
+	{"horns", []WeightedEmoji{{"😈", SourceUnknown, 15}, {"👿", SourceUnknown, 15}}},
+	{"horror", []WeightedEmoji{{"😱", SourceUnknown, 15}, {"🙀", SourceUnknown, 15}}},
+	{"horse", []WeightedEmoji{{"🐎", SourceUnknown, 15}, {"🐴", SourceUnknown, 15}}},
+	{"horse face", []WeightedEmoji{{"🐴", SourceUnknown, 15}}},
+	{"horse racing", []WeightedEmoji{{"🏇", SourceUnknown, 15}}},
+	{"hospital", []WeightedEmoji{{"🏥", SourceUnknown, 15}, {"💉", SourceUnknown, 15}}},
+	{"hot", []WeightedEmoji{{"🥵", SourceCustom, 40}, {"😅", SourceUnknown, 15}}},
+	{"hot beverage", []WeightedEmoji{{"☕", SourceUnknown, 15}}},
+	{"hot dog", []WeightedEmoji{{"🌭", SourceUnknown, 15}}},
+	{"hot face", []WeightedEmoji{{"🥵", SourceUnknown, 15}}},
+	{"hot pepper", []WeightedEmoji{{"🌶️", SourceUnknown, 15}}},
+	{"hot springs", []WeightedEmoji{{"♨️", SourceUnknown, 15}}},
+	{"hotdog", []WeightedEmoji{{"🌭", SourceUnknown, 15}}},
+	{"hotel", []WeightedEmoji{{"🏨", SourceUnknown, 15}}},
+	{"hotsprings", []WeightedEmoji{{"♨️", SourceUnknown, 15}}},
+	{"hourglass", []WeightedEmoji{{"⌛", SourceUnknown, 15}}},
+	{"hourglass done", []WeightedEmoji{{"⌛", SourceUnknown, 15}}},
+	{"hourglass flowing sand", []WeightedEmoji{{"⏳", SourceUnknown, 15}}},
+	{"hourglass not done", []WeightedEmoji{{"⏳", SourceUnknown, 15}}},
+	{"house", []WeightedEmoji{{"🏠", SourceUnknown, 15}}},
+	{"house with garden", []WeightedEmoji{{"🏡", SourceUnknown, 15}}},
+	{"houses", []WeightedEmoji{{"🏘️", SourceUnknown, 15}}},
+	{"hugs", []WeightedEmoji{{"🤗", SourceUnknown, 15}}},
+	{"hundred points", []WeightedEmoji{{"💯", SourceUnknown, 15}}},
+	{"hungary", []WeightedEmoji{{"🇭🇺", SourceUnknown, 15}}},
+	{"hurt", []WeightedEmoji{{"🤕", SourceUnknown, 15}}},
+	{"hush", []WeightedEmoji{{"🤐", SourceUnknown, 15}, {"🙊", SourceUnknown, 15}}},
+	{"hushed", []WeightedEmoji{{"😯", SourceUnknown, 15}}},
+	{"hushed face", []WeightedEmoji{{"😯", SourceUnknown, 15}}},
+	{"hut", []WeightedEmoji{{"🛖", SourceUnknown, 15}}},
+	{"hyacinth", []WeightedEmoji{{"🪻", SourceUnknown, 15}}},
+	{"ice", []WeightedEmoji{{"🧊", SourceUnknown, 15}, {"🥶", SourceUnknown, 15}}},
+	{"ice cream", []WeightedEmoji{{"🍨", SourceUnknown, 15}}},
+	{"ice cube", []WeightedEmoji{{"🧊", SourceUnknown, 15}}},
+	{"ice hockey", []WeightedEmoji{{"🏒", SourceUnknown, 15}}},
+	{"ice skate", []WeightedEmoji{{"⛸️", SourceUnknown, 15}}},
+	{"icecream", []WeightedEmoji{{"🍦", SourceUnknown, 15}}},
+	{"iceland", []WeightedEmoji{{"🇮🇸", SourceUnknown, 15}}},
+	{"id", []WeightedEmoji{{"🆔", SourceUnknown, 15}}},
+	{"id button", []WeightedEmoji{{"🆔", SourceUnknown, 15}}},
+	{"idea", []WeightedEmoji{{"💡", SourceUnknown, 15}}},
+	{"identification card", []WeightedEmoji{{"🪪", SourceUnknown, 15}}},
+	{"ideograph advantage", []WeightedEmoji{{"🉐", SourceUnknown, 15}}},
+	{"ignore", []WeightedEmoji{{"🙈", SourceUnknown, 15}}},
+	{"ill", []WeightedEmoji{{"😷", SourceUnknown, 15}}},
+	{"imp", []WeightedEmoji{{"👿", SourceUnknown, 15}}},
+	{"impressed", []WeightedEmoji{{"😮", SourceUnknown, 15}}},
+	{"inbox tray", []WeightedEmoji{{"📥", SourceUnknown, 15}}},
+	{"incoming", []WeightedEmoji{{"📲", SourceUnknown, 15}}},
+	{"incoming envelope", []WeightedEmoji{{"📨", SourceUnknown, 15}}},
+	{"index pointing at the viewer", []WeightedEmoji{{"🫵", SourceUnknown, 15}}},
+	{"index pointing up", []WeightedEmoji{{"☝️", SourceUnknown, 15}}},
+	{"india", []WeightedEmoji{{"🇮🇳", SourceUnknown, 15}}},
+	{"indonesia", []WeightedEmoji{{"🇮🇩", SourceUnknown, 15}}},
+	{"infinity", []WeightedEmoji{{"♾️", SourceUnknown, 15}}},
+	{"information", []WeightedEmoji{{"ℹ️", SourceUnknown, 15}, {"💁\u200d♂️", SourceUnknown, 15}, {"💁\u200d♀️", SourceUnknown, 15}}},
+	{"information desk person", []WeightedEmoji{{"💁", SourceUnknown, 15}}},
+	{"information source", []WeightedEmoji{{"ℹ️", SourceUnknown, 15}}},
+	{"innocent", []WeightedEmoji{{"😇", SourceUnknown, 15}}},
+	{"input latin letters", []WeightedEmoji{{"🔤", SourceUnknown, 15}}},
+	{"input latin lowercase", []WeightedEmoji{{"🔡", SourceUnknown, 15}}},
+	{"input latin uppercase", []WeightedEmoji{{"🔠", SourceUnknown, 15}}},
+	{"input numbers", []WeightedEmoji{{"🔢", SourceUnknown, 15}}},
+	{"input symbols", []WeightedEmoji{{"🔣", SourceUnknown, 15}}},
+	{"international", []WeightedEmoji{{"🌍", SourceUnknown, 15}, {"🌎", SourceUnknown, 15}, {"🌏", SourceUnknown, 15}, {"🌐", SourceUnknown, 15}}},
+	{"interrobang", []WeightedEmoji{{"⁉️", SourceUnknown, 15}}},
+	{"investigate", []WeightedEmoji{{"🔬", SourceUnknown, 15}}},
+	{"invisible", []WeightedEmoji{{"🫥", SourceUnknown, 15}}},
+	{"iphone", []WeightedEmoji{{"📱", SourceUnknown, 15}}},
+	{"iran", []WeightedEmoji{{"🇮🇷", SourceUnknown, 15}}},
+	{"iraq", []WeightedEmoji{{"🇮🇶", SourceUnknown, 15}}},
+	{"ireland", []WeightedEmoji{{"🇮🇪", SourceUnknown, 15}}},
+	{"isle of man", []WeightedEmoji{{"🇮🇲", SourceUnknown, 15}}},
+	{"israel", []WeightedEmoji{{"🇮🇱", SourceUnknown, 15}}},
+	{"it", []WeightedEmoji{{"🇮🇹", SourceUnknown, 15}}},
+	{"italy", []WeightedEmoji{{"🇮🇹", SourceUnknown, 15}}},
+	{"ivory", []WeightedEmoji{{"🇨🇮", SourceUnknown, 15}}},
+	{"izakaya lantern", []WeightedEmoji{{"🏮", SourceUnknown, 15}}},
+	{"jack o lantern", []WeightedEmoji{{"🎃", SourceUnknown, 15}}},
+	{"jack-o-lantern", []WeightedEmoji{{"🎃", SourceUnknown, 15}}},
+	{"jamaica", []WeightedEmoji{{"🇯🇲", SourceUnknown, 15}}},
+	{"japan", []WeightedEmoji{{"🗾", SourceUnknown, 15}, {"🇯🇵", SourceUnknown, 15}}},
+	{"japanese castle", []WeightedEmoji{{"🏯", SourceUnknown, 15}}},
+	{"japanese dolls", []WeightedEmoji{{"🎎", SourceUnknown, 15}}},
+	{"japanese goblin", []WeightedEmoji{{"👺", SourceUnknown, 15}}},
+	{"japanese ogre", []WeightedEmoji{{"👹", SourceUnknown, 15}}},
+	{"japanese post office", []WeightedEmoji{{"🏣", SourceUnknown, 15}}},
+	{"japanese symbol for beginner", []WeightedEmoji{{"🔰", SourceUnknown, 15}}},
+	{"japanese “acceptable” button", []WeightedEmoji{{"🉑", SourceUnknown, 15}}},
+	{"japanese “application” button", []WeightedEmoji{{"🈸", SourceUnknown, 15}}},
+	{"japanese “bargain” button", []WeightedEmoji{{"🉐", SourceUnknown, 15}}},
+	{"japanese “congratulations” button", []WeightedEmoji{{"㊗️", SourceUnknown, 15}}},
+	{"japanese “discount” button", []WeightedEmoji{{"🈹", SourceUnknown, 15}}},
+	{"japanese “free of charge” button", []WeightedEmoji{{"🈚", SourceUnknown, 15}}},
+	{"japanese “here” button", []WeightedEmoji{{"🈁", SourceUnknown, 15}}},
+	{"japanese “monthly amount” button", []WeightedEmoji{{"🈷️", SourceUnknown, 15}}},
+	{"japanese “no vacancy” button", []WeightedEmoji{{"🈵", SourceUnknown, 15}}},
+	{"japanese “not free of charge” button", []WeightedEmoji{{"🈶", SourceUnknown, 15}}},
+	{"japanese “open for business” button", []WeightedEmoji{{"🈺", SourceUnknown, 15}}},
+	{"japanese “passing grade” button", []WeightedEmoji{{"🈴", SourceUnknown, 15}}},
+	{"japanese “prohibited” button", []WeightedEmoji{{"🈲", SourceUnknown, 15}}},
+	{"japanese “reserved” button", []WeightedEmoji{{"🈯", SourceUnknown, 15}}},
+	{"japanese “secret” button", []WeightedEmoji{{"㊙️", SourceUnknown, 15}}},
+	{"japanese “service charge” button", []WeightedEmoji{{"🈂️", SourceUnknown, 15}}},
+	{"japanese “vacancy” button", []WeightedEmoji{{"🈳", SourceUnknown, 15}}},
+	{"jar", []WeightedEmoji{{"🫙", SourceUnknown, 15}}},
+	{"jeans", []WeightedEmoji{{"👖", SourceUnknown, 15}}},
+	{"jellyfish", []WeightedEmoji{{"🪼", SourceUnknown, 15}}},
+	{"jersey", []WeightedEmoji{{"🇯🇪", SourceUnknown, 15}}},
+	{"jigsaw", []WeightedEmoji{{"🧩", SourceUnknown, 15}}},
+	{"joker", []WeightedEmoji{{"🃏", SourceUnknown, 15}}},
+	{"jordan", []WeightedEmoji{{"🇯🇴", SourceUnknown, 15}}},
+	{"joy", []WeightedEmoji{{"😂", SourceUnknown, 15}, {"😃", SourceUnknown, 15}, {"😄", SourceUnknown, 15}}},
+	{"joy cat", []WeightedEmoji{{"😹", SourceUnknown, 15}}},
+	{"joystick", []WeightedEmoji{{"🕹️", SourceUnknown, 15}}},
+	{"jp", []WeightedEmoji{{"🇯🇵", SourceUnknown, 15}}},
+	{"judge", []WeightedEmoji{{"🧑\u200d⚖️", SourceUnknown, 15}}},
+	{"juggling person", []WeightedEmoji{{"🤹", SourceUnknown, 15}}},
+	{"justice", []WeightedEmoji{{"👨\u200d⚖️", SourceUnknown, 15}, {"👩\u200d⚖️", SourceUnknown, 15}}},
+	{"kaaba", []WeightedEmoji{{"🕋", SourceUnknown, 15}}},
+	{"kangaroo", []WeightedEmoji{{"🦘", SourceUnknown, 15}}},
+	{"karl", []WeightedEmoji{{"🌁", SourceUnknown, 15}}},
+	{"kazakhstan", []WeightedEmoji{{"🇰🇿", SourceUnknown, 15}}},
+	{"keeling", []WeightedEmoji{{"🇨🇨", SourceUnknown, 15}}},
+	{"kenya", []WeightedEmoji{{"🇰🇪", SourceUnknown, 15}}},
+	{"key", []WeightedEmoji{{"🔑", SourceUnknown, 15}}},
+	{"keyboard", []WeightedEmoji{{"⌨️", SourceUnknown, 15}}},
+	{"keycap ten", []WeightedEmoji{{"🔟", SourceUnknown, 15}}},
+	{"keycap: #", []WeightedEmoji{{"#️⃣", SourceUnknown, 15}}},
+	{"keycap: *", []WeightedEmoji{{"*️⃣", SourceUnknown, 15}}},
+	{"keycap: 0", []WeightedEmoji{{"0️⃣", SourceUnknown, 15}}},
+	{"keycap: 1", []WeightedEmoji{{"1️⃣", SourceUnknown, 15}}},
+	{"keycap: 10", []WeightedEmoji{{"🔟", SourceUnknown, 15}}},
+	{"keycap: 2", []WeightedEmoji{{"2️⃣", SourceUnknown, 15}}},
+	{"keycap: 3", []WeightedEmoji{{"3️⃣", SourceUnknown, 15}}},
+	{"keycap: 4", []WeightedEmoji{{"4️⃣", SourceUnknown, 15}}},
+	{"keycap: 5", []WeightedEmoji{{"5️⃣", SourceUnknown, 15}}},
+	{"keycap: 6", []WeightedEmoji{{"6️⃣", SourceUnknown, 15}}},
+	{"keycap: 7", []WeightedEmoji{{"7️⃣", SourceUnknown, 15}}},
+	{"keycap: 8", []WeightedEmoji{{"8️⃣", SourceUnknown, 15}}},
+	{"keycap: 9", []WeightedEmoji{{"9️⃣", SourceUnknown, 15}}},
+	{"khanda", []WeightedEmoji{{"🪯", SourceUnknown, 15}}},
+	{"kick scooter", []WeightedEmoji{{"🛴", SourceUnknown, 15}}},
+	{"kimono", []WeightedEmoji{{"👘", SourceUnknown, 15}}},
+	{"king", []WeightedEmoji{{"👑", SourceUnknown, 15}}},
+	{"kiribati", []WeightedEmoji{{"🇰🇮", SourceUnknown, 15}}},
+	{"kiss", []WeightedEmoji{{"💏", SourceUnknown, 15}, {"💋", SourceUnknown, 15}, {"👄", SourceUnknown, 15}}},
+	{"kiss mark", []WeightedEmoji{{"💋", SourceUnknown, 15}}},
+	{"kiss: man, man", []WeightedEmoji{{"👨\u200d❤️\u200d💋\u200d👨", SourceUnknown, 15}}},
+	{"kiss: woman, man", []WeightedEmoji{{"👩\u200d❤️\u200d💋\u200d👨", SourceUnknown, 15}}},
+	{"kiss: woman, woman", []WeightedEmoji{{"👩\u200d❤️\u200d💋\u200d👩", SourceUnknown, 15}}},
+	{"kissing", []WeightedEmoji{{"😗", SourceUnknown, 15}}},
+	{"kissing cat", []WeightedEmoji{{"😽", SourceUnknown, 15}}},
+	{"kissing closed eyes", []WeightedEmoji{{"😚", SourceUnknown, 15}}},
+	{"kissing face", []WeightedEmoji{{"😗", SourceUnknown, 15}}},
+	{"kissing face with closed eyes", []WeightedEmoji{{"😚", SourceUnknown, 15}}},
+	{"kissing face with smiling eyes", []WeightedEmoji{{"😙", SourceUnknown, 15}}},
+	{"kissing heart", []WeightedEmoji{{"😘", SourceUnknown, 15}}},
+	{"kissing smiling eyes", []WeightedEmoji{{"😙", SourceUnknown, 15}}},
+	{"kitchen knife", []WeightedEmoji{{"🔪", SourceUnknown, 15}}},
+	{"kite", []WeightedEmoji{{"🪁", SourceUnknown, 15}}},
+	{"kiwi fruit", []WeightedEmoji{{"🥝", SourceUnknown, 15}}},
+	{"kneeling man", []WeightedEmoji{{"🧎\u200d♂️", SourceUnknown, 15}}},
+	{"kneeling person", []WeightedEmoji{{"🧎", SourceUnknown, 15}}},
+	{"kneeling woman", []WeightedEmoji{{"🧎\u200d♀️", SourceUnknown, 15}}},
+	{"knife", []WeightedEmoji{{"🔪", SourceUnknown, 15}}},
+	{"knot", []WeightedEmoji{{"🪢", SourceUnknown, 15}}},
+	{"koala", []WeightedEmoji{{"🐨", SourceUnknown, 15}}},
+	{"koko", []WeightedEmoji{{"🈁", SourceUnknown, 15}}},
+	{"korea", []WeightedEmoji{{"🇰🇷", SourceUnknown, 15}}},
+	{"kosovo", []WeightedEmoji{{"🇽🇰", SourceUnknown, 15}}},
+	{"kr", []WeightedEmoji{{"🇰🇷", SourceUnknown, 15}}},
+	{"kuwait", []WeightedEmoji{{"🇰🇼", SourceUnknown, 15}}},
+	{"kyrgyzstan", []WeightedEmoji{{"🇰🇬", SourceUnknown, 15}}},
+	{"lab coat", []WeightedEmoji{{"🥼", SourceUnknown, 15}}},
+	{"label", []WeightedEmoji{{"🏷️", SourceUnknown, 15}}},
+	{"laboratory", []WeightedEmoji{{"🔬", SourceUnknown, 15}}},
+	{"lacrosse", []WeightedEmoji{{"🥍", SourceUnknown, 15}}},
+	{"ladder", []WeightedEmoji{{"🪜", SourceUnknown, 15}}},
+	{"lady beetle", []WeightedEmoji{{"🐞", SourceUnknown, 15}}},
+	{"lantern", []WeightedEmoji{{"🏮", SourceUnknown, 15}}},
+	{"laos", []WeightedEmoji{{"🇱🇦", SourceUnknown, 15}}},
+	{"laptop", []WeightedEmoji{{"💻", SourceUnknown, 15}}},
+	{"large blue circle", []WeightedEmoji{{"🔵", SourceUnknown, 15}}},
+	{"large blue diamond", []WeightedEmoji{{"🔷", SourceUnknown, 15}}},
+	{"large orange diamond", []WeightedEmoji{{"🔶", SourceUnknown, 15}}},
+	{"last quarter moon", []WeightedEmoji{{"🌗", SourceUnknown, 15}}},
+	{"last quarter moon face", []WeightedEmoji{{"🌜", SourceUnknown, 15}}},
+	{"last quarter moon with face", []WeightedEmoji{{"🌜", SourceUnknown, 15}}},
+	{"last track button", []WeightedEmoji{{"⏮️", SourceUnknown, 15}}},
+	{"latin cross", []WeightedEmoji{{"✝️", SourceUnknown, 15}}},
+	{"latvia", []WeightedEmoji{{"🇱🇻", SourceUnknown, 15}}},
+	{"laugh", []WeightedEmoji{{"😄", SourceUnknown, 15}}},
+	{"laughing", []WeightedEmoji{{"😆", SourceUnknown, 15}, {"🤣", SourceUnknown, 15}}},
+	{"launch", []WeightedEmoji{{"🚀", SourceUnknown, 15}}},
+	{"law", []WeightedEmoji{{"👮", SourceUnknown, 15}, {"👮\u200d♂️", SourceUnknown, 15}, {"👮\u200d♀️", SourceUnknown, 15}}},
+	{"leaf", []WeightedEmoji{{"🍃", SourceUnknown, 15}}},
+	{"leaf fluttering in wind", []WeightedEmoji{{"🍃", SourceUnknown, 15}}},
+	{"leafy green", []WeightedEmoji{{"🥬", SourceUnknown, 15}}},
+	{"leaves", []WeightedEmoji{{"🍃", SourceUnknown, 15}}},
+	{"lebanon", []WeightedEmoji{{"🇱🇧", SourceUnknown, 15}}},
+	{"ledger", []WeightedEmoji{{"📒", SourceUnknown, 15}}},
+	{"left arrow", []WeightedEmoji{{"⬅️", SourceUnknown, 15}}},
+	{"left arrow curving right", []WeightedEmoji{{"↪️", SourceUnknown, 15}}},
+	{"left luggage", []WeightedEmoji{{"🛅", SourceUnknown, 15}}},
+	{"left right arrow", []WeightedEmoji{{"↔️", SourceUnknown, 15}}},
+	{"left speech bubble", []WeightedEmoji{{"🗨️", SourceUnknown, 15}}},
+	{"left-facing fist", []WeightedEmoji{{"🤛", SourceUnknown, 15}}},
+	{"left-right arrow", []WeightedEmoji{{"↔️", SourceUnknown, 15}}},
+	{"leftwards arrow with hook", []WeightedEmoji{{"↩️", SourceUnknown, 15}}},
+	{"leftwards hand", []WeightedEmoji{{"🫲", SourceUnknown, 15}}},
+	{"leftwards pushing hand", []WeightedEmoji{{"🫷", SourceUnknown, 15}}},
+	{"leg", []WeightedEmoji{{"🦵", SourceUnknown, 15}}},
+	{"lemon", []WeightedEmoji{{"🍋", SourceUnknown, 15}}},
+	{"leo", []WeightedEmoji{{"♌", SourceUnknown, 15}}},
+	{"leopard", []WeightedEmoji{{"🐆", SourceUnknown, 15}}},
+	{"lesotho", []WeightedEmoji{{"🇱🇸", SourceUnknown, 15}}},
+	{"letter", []WeightedEmoji{{"✉️", SourceUnknown, 15}}},
+	{"letters", []WeightedEmoji{{"🔠", SourceUnknown, 15}}},
+	{"level slider", []WeightedEmoji{{"🎚️", SourceUnknown, 15}}},
+	{"liar", []WeightedEmoji{{"🤥", SourceUnknown, 15}}},
+	{"liberia", []WeightedEmoji{{"🇱🇷", SourceUnknown, 15}}},
+	{"libra", []WeightedEmoji{{"♎", SourceUnknown, 15}}},
+	{"library", []WeightedEmoji{{"📚", SourceUnknown, 15}}},
+	{"libya", []WeightedEmoji{{"🇱🇾", SourceUnknown, 15}}},
+	{"lick", []WeightedEmoji{{"😋", SourceUnknown, 15}}},
+	{"liechtenstein", []WeightedEmoji{{"🇱🇮", SourceUnknown, 15}}},
+	{"life preserver", []WeightedEmoji{{"🛟", SourceUnknown, 15}}},
+	{"light", []WeightedEmoji{{"💡", SourceUnknown, 15}}},
+	{"light blue heart", []WeightedEmoji{{"🩵", SourceUnknown, 15}}},
+	{"light bulb", []WeightedEmoji{{"💡", SourceUnknown, 15}}},
+	{"light rail", []WeightedEmoji{{"🚈", SourceUnknown, 15}}},
+	{"lightning", []WeightedEmoji{{"⚡", SourceUnknown, 15}}},
+	{"limit", []WeightedEmoji{{"⛔", SourceUnknown, 15}}},
+	{"link", []WeightedEmoji{{"🔗", SourceUnknown, 15}}},
+	{"linked paperclips", []WeightedEmoji{{"🖇️", SourceUnknown, 15}}},
+	{"lion", []WeightedEmoji{{"🦁", SourceUnknown, 15}}},
+	{"lips", []WeightedEmoji{{"👄", SourceUnknown, 15}}},
+	{"lipstick", []WeightedEmoji{{"💄", SourceUnknown, 15}, {"💋", SourceUnknown, 15}}},
+	{"listen", []WeightedEmoji{{"👂", SourceUnknown, 15}}},
+	{"lithuania", []WeightedEmoji{{"🇱🇹", SourceUnknown, 15}}},
+	{"litter in bin sign", []WeightedEmoji{{"🚮", SourceUnknown, 15}}},
+	{"lizard", []WeightedEmoji{{"🦎", SourceUnknown, 15}}},
+	{"llama", []WeightedEmoji{{"🦙", SourceUnknown, 15}}},
+	{"lobster", []WeightedEmoji{{"🦞", SourceUnknown, 15}}},
+	{"location", []WeightedEmoji{{"📌", SourceUnknown, 15}, {"📍", SourceUnknown, 15}}},
+	{"lock", []WeightedEmoji{{"🔒", SourceUnknown, 15}, {"🔑", SourceUnknown, 15}}},
+	{"lock with ink pen", []WeightedEmoji{{"🔏", SourceUnknown, 15}}},
+	{"locked", []WeightedEmoji{{"🔒", SourceUnknown, 15}}},
+	{"locked with key", []WeightedEmoji{{"🔐", SourceUnknown, 15}}},
+	{"locked with pen", []WeightedEmoji{{"🔏", SourceUnknown, 15}}},
+	{"locomotive", []WeightedEmoji{{"🚂", SourceUnknown, 15}}},
+	{"lol", []WeightedEmoji{{"🤣", SourceUnknown, 15}}},
+	{"lollipop", []WeightedEmoji{{"🍭", SourceUnknown, 15}}},
+	{"long drum", []WeightedEmoji{{"🪘", SourceUnknown, 15}}},
+	{"look", []WeightedEmoji{{"👀", SourceUnknown, 15}}},
+	{"loop", []WeightedEmoji{{"➿", SourceUnknown, 15}, {"🔁", SourceUnknown, 15}}},
+	{"lotion bottle", []WeightedEmoji{{"🧴", SourceUnknown, 15}}},
+	{"lotus", []WeightedEmoji{{"🪷", SourceUnknown, 15}}},
+	{"lotus position", []WeightedEmoji{{"🧘", SourceUnknown, 15}}},
+	{"lotus position man", []WeightedEmoji{{"🧘\u200d♂️", SourceUnknown, 15}}},
+	{"lotus position woman", []WeightedEmoji{{"🧘\u200d♀️", SourceUnknown, 15}}},
+	{"loud sound", []WeightedEmoji{{"🔊", SourceUnknown, 15}}},
+	{"loudly crying face", []WeightedEmoji{{"😭", SourceUnknown, 15}}},
+	{"loudspeaker", []WeightedEmoji{{"📢", SourceUnknown, 15}}},
+	{"love", []WeightedEmoji{{"❤️", SourceCustom, 40}, {"🥰", SourceUnknown, 15}, {"😍", SourceUnknown, 15}, {"💘", SourceUnknown, 15}, {"🫶", SourceUnknown, 15}}},
+	{"love hotel", []WeightedEmoji{{"🏩", SourceUnknown, 15}}},
+	{"love letter", []WeightedEmoji{{"💌", SourceUnknown, 15}}},
+	{"love you gesture", []WeightedEmoji{{"🤟", SourceUnknown, 15}}},
+	{"love-you gesture", []WeightedEmoji{{"🤟", SourceUnknown, 15}}},
+	{"low battery", []WeightedEmoji{{"🪫", SourceUnknown, 15}}},
+	{"low brightness", []WeightedEmoji{{"🔅", SourceUnknown, 15}}},
+	{"luck", []WeightedEmoji{{"🤞", SourceUnknown, 15}, {"🍀", SourceUnknown, 15}}},
+	{"luggage", []WeightedEmoji{{"🧳", SourceUnknown, 15}}},
+	{"lungs", []WeightedEmoji{{"🫁", SourceUnknown, 15}}},
+	{"luxembourg", []WeightedEmoji{{"🇱🇺", SourceUnknown, 15}}},
+	{"lying face", []WeightedEmoji{{"🤥", SourceUnknown, 15}}},
+	{"m", []WeightedEmoji{{"Ⓜ️", SourceUnknown, 15}}},
+	{"macau", []WeightedEmoji{{"🇲🇴", SourceUnknown, 15}}},
+	{"macedonia", []WeightedEmoji{{"🇲🇰", SourceUnknown, 15}}},
+	{"mad", []WeightedEmoji{{"😠", SourceUnknown, 15}}},
+	{"madagascar", []WeightedEmoji{{"🇲🇬", SourceUnknown, 15}}},
+	{"mag", []WeightedEmoji{{"🔍", SourceUnknown, 15}}},
+	{"mag right", []WeightedEmoji{{"🔎", SourceUnknown, 15}}},
+	{"mage", []WeightedEmoji{{"🧙", SourceUnknown, 15}}},
+	{"mage man", []WeightedEmoji{{"🧙\u200d♂️", SourceUnknown, 15}}},
+	{"mage woman", []WeightedEmoji{{"🧙\u200d♀️", SourceUnknown, 15}}},
+	{"magic wand", []WeightedEmoji{{"🪄", SourceUnknown, 15}}},
+	{"magnet", []WeightedEmoji{{"🧲", SourceUnknown, 15}}},
+	{"magnifying glass tilted left", []WeightedEmoji{{"🔍", SourceUnknown, 15}}},
+	{"magnifying glass tilted right", []WeightedEmoji{{"🔎", SourceUnknown, 15}}},
+	{"mahjong", []WeightedEmoji{{"🀄", SourceUnknown, 15}}},
+	{"mahjong red dragon", []WeightedEmoji{{"🀄", SourceUnknown, 15}}},
+	{"mailbox", []WeightedEmoji{{"📫", SourceUnknown, 15}}},
+	{"mailbox closed", []WeightedEmoji{{"📪", SourceUnknown, 15}}},
+	{"mailbox with mail", []WeightedEmoji{{"📬", SourceUnknown, 15}}},
+	{"mailbox with no mail", []WeightedEmoji{{"📭", SourceUnknown, 15}}},
+	{"makeup", []WeightedEmoji{{"💄", SourceUnknown, 15}}},
+	{"malawi", []WeightedEmoji{{"🇲🇼", SourceUnknown, 15}}},
+	{"malaysia", []WeightedEmoji{{"🇲🇾", SourceUnknown, 15}}},
+	{"maldives", []WeightedEmoji{{"🇲🇻", SourceUnknown, 15}}},
+	{"male detective", []WeightedEmoji{{"🕵️\u200d♂️", SourceUnknown, 15}}},
+	{"male sign", []WeightedEmoji{{"♂️", SourceUnknown, 15}}},
+	{"mali", []WeightedEmoji{{"🇲🇱", SourceUnknown, 15}}},
+	{"malta", []WeightedEmoji{{"🇲🇹", SourceUnknown, 15}}},
+	{"mammoth", []WeightedEmoji{{"🦣", SourceUnknown, 15}}},
+	{"man", []WeightedEmoji{{"👨", SourceUnknown, 15}}},
+	{"man artist", []WeightedEmoji{{"👨\u200d🎨", SourceUnknown, 15}}},
+	{"man astronaut", []WeightedEmoji{{"👨\u200d🚀", SourceUnknown, 15}}},
+	{"man beard", []WeightedEmoji{{"🧔\u200d♂️", SourceUnknown, 15}}},
+	{"man biking", []WeightedEmoji{{"🚴\u200d♂️", SourceUnknown, 15}}},
+	{"man bouncing ball", []WeightedEmoji{{"⛹️\u200d♂️", SourceUnknown, 15}}},
+	{"man bowing", []WeightedEmoji{{"🙇\u200d♂️", SourceUnknown, 15}}},
+	{"man cartwheeling", []WeightedEmoji{{"🤸\u200d♂️", SourceUnknown, 15}}},
+	{"man climbing", []WeightedEmoji{{"🧗\u200d♂️", SourceUnknown, 15}}},
+	{"man construction worker", []WeightedEmoji{{"👷\u200d♂️", SourceUnknown, 15}}},
+	{"man cook", []WeightedEmoji{{"👨\u200d🍳", SourceUnknown, 15}}},
+	{"man dancing", []WeightedEmoji{{"🕺", SourceUnknown, 15}}},
+	{"man detective", []WeightedEmoji{{"🕵️\u200d♂️", SourceUnknown, 15}}},
+	{"man elf", []WeightedEmoji{{"🧝\u200d♂️", SourceUnknown, 15}}},
+	{"man facepalming", []WeightedEmoji{{"🤦\u200d♂️", SourceUnknown, 15}}},
+	{"man factory worker", []WeightedEmoji{{"👨\u200d🏭", SourceUnknown, 15}}},
+	{"man fairy", []WeightedEmoji{{"🧚\u200d♂️", SourceUnknown, 15}}},
+	{"man farmer", []WeightedEmoji{{"👨\u200d🌾", SourceUnknown, 15}}},
+	{"man feeding baby", []WeightedEmoji{{"👨\u200d🍼", SourceUnknown, 15}}},
+	{"man firefighter", []WeightedEmoji{{"👨\u200d🚒", SourceUnknown, 15}}},
+	{"man frowning", []WeightedEmoji{{"🙍\u200d♂️", SourceUnknown, 15}}},
+	{"man genie", []WeightedEmoji{{"🧞\u200d♂️", SourceUnknown, 15}}},
+	{"man gesturing no", []WeightedEmoji{{"🙅\u200d♂️", SourceUnknown, 15}}},
+	{"man gesturing ok", []WeightedEmoji{{"🙆\u200d♂️", SourceUnknown, 15}}},
+	{"man getting haircut", []WeightedEmoji{{"💇\u200d♂️", SourceUnknown, 15}}},
+	{"man getting massage", []WeightedEmoji{{"💆\u200d♂️", SourceUnknown, 15}}},
+	{"man golfing", []WeightedEmoji{{"🏌️\u200d♂️", SourceUnknown, 15}}},
+	{"man guard", []WeightedEmoji{{"💂\u200d♂️", SourceUnknown, 15}}},
+	{"man health worker", []WeightedEmoji{{"👨\u200d⚕️", SourceUnknown, 15}}},
+	{"man in lotus position", []WeightedEmoji{{"🧘\u200d♂️", SourceUnknown, 15}}},
+	{"man in manual wheelchair", []WeightedEmoji{{"👨\u200d🦽", SourceUnknown, 15}}},
+	{"man in motorized wheelchair", []WeightedEmoji{{"👨\u200d🦼", SourceUnknown, 15}}},
+	{"man in steamy room", []WeightedEmoji{{"🧖\u200d♂️", SourceUnknown, 15}}},
+	{"man in tuxedo", []WeightedEmoji{{"🤵\u200d♂️", SourceUnknown, 15}}},
+	{"man judge", []WeightedEmoji{{"👨\u200d⚖️", SourceUnknown, 15}}},
+	{"man juggling", []WeightedEmoji{{"🤹\u200d♂️", SourceUnknown, 15}}},
+	{"man kneeling", []WeightedEmoji{{"🧎\u200d♂️", SourceUnknown, 15}}},
+	{"man lifting weights", []WeightedEmoji{{"🏋️\u200d♂️", SourceUnknown, 15}}},
+	{"man mage", []WeightedEmoji{{"🧙\u200d♂️", SourceUnknown, 15}}},
+	{"man mechanic", []WeightedEmoji{{"👨\u200d🔧", SourceUnknown, 15}}},
+	{"man mountain biking", []WeightedEmoji{{"🚵\u200d♂️", SourceUnknown, 15}}},
+	{"man office worker", []WeightedEmoji{{"👨\u200d💼", SourceUnknown, 15}}},
+	{"man pilot", []WeightedEmoji{{"👨\u200d✈️", SourceUnknown, 15}}},
+	{"man playing handball", []WeightedEmoji{{"🤾\u200d♂️", SourceUnknown, 15}}},
+	{"man playing water polo", []WeightedEmoji{{"🤽\u200d♂️", SourceUnknown, 15}}},
+	{"man police officer", []WeightedEmoji{{"👮\u200d♂️", SourceUnknown, 15}}},
+	{"man pouting", []WeightedEmoji{{"🙎\u200d♂️", SourceUnknown, 15}}},
+	{"man raising hand", []WeightedEmoji{{"🙋\u200d♂️", SourceUnknown, 15}}},
+	{"man rowing boat", []WeightedEmoji{{"🚣\u200d♂️", SourceUnknown, 15}}},
+	{"man running", []WeightedEmoji{{"🏃\u200d♂️", SourceUnknown, 15}}},
+	{"man scientist", []WeightedEmoji{{"👨\u200d🔬", SourceUnknown, 15}}},
+	{"man shrugging", []WeightedEmoji{{"🤷\u200d♂️", SourceUnknown, 15}}},
+	{"man singer", []WeightedEmoji{{"👨\u200d🎤", SourceUnknown, 15}}},
+	{"man standing", []WeightedEmoji{{"🧍\u200d♂️", SourceUnknown, 15}}},
+	{"man student", []WeightedEmoji{{"👨\u200d🎓", SourceUnknown, 15}}},
+	{"man superhero", []WeightedEmoji{{"🦸\u200d♂️", SourceUnknown, 15}}},
+	{"man supervillain", []WeightedEmoji{{"🦹\u200d♂️", SourceUnknown, 15}}},
+	{"man surfing", []WeightedEmoji{{"🏄\u200d♂️", SourceUnknown, 15}}},
+	{"man swimming", []WeightedEmoji{{"🏊\u200d♂️", SourceUnknown, 15}}},
+	{"man teacher", []WeightedEmoji{{"👨\u200d🏫", SourceUnknown, 15}}},
+	{"man technologist", []WeightedEmoji{{"👨\u200d💻", SourceUnknown, 15}}},
+	{"man tipping hand", []WeightedEmoji{{"💁\u200d♂️", SourceUnknown, 15}}},
+	{"man vampire", []WeightedEmoji{{"🧛\u200d♂️", SourceUnknown, 15}}},
+	{"man walking", []WeightedEmoji{{"🚶\u200d♂️", SourceUnknown, 15}}},
+	{"man wearing turban", []WeightedEmoji{{"👳\u200d♂️", SourceUnknown, 15}}},
+	{"man with gua pi mao", []WeightedEmoji{{"👲", SourceUnknown, 15}}},
+	{"man with probing cane", []WeightedEmoji{{"👨\u200d🦯", SourceUnknown, 15}}},
+	{"man with turban", []WeightedEmoji{{"👳\u200d♂️", SourceUnknown, 15}}},
+	{"man with veil", []WeightedEmoji{{"👰\u200d♂️", SourceUnknown, 15}}},
+	{"man with white cane", []WeightedEmoji{{"👨\u200d🦯", SourceUnknown, 15}}},
+	{"man zombie", []WeightedEmoji{{"🧟\u200d♂️", SourceUnknown, 15}}},
+	{"man: bald", []WeightedEmoji{{"👨\u200d🦲", SourceUnknown, 15}}},
+	{"man: beard", []WeightedEmoji{{"🧔\u200d♂️", SourceUnknown, 15}}},
+	{"man: blond hair", []WeightedEmoji{{"👱\u200d♂️", SourceUnknown, 15}}},
+	{"man: curly hair", []WeightedEmoji{{"👨\u200d🦱", SourceUnknown, 15}}},
+	{"man: red hair", []WeightedEmoji{{"👨\u200d🦰", SourceUnknown, 15}}},
+	{"man: white hair", []WeightedEmoji{{"👨\u200d🦳", SourceUnknown, 15}}},
+	{"mandarin", []WeightedEmoji{{"🍊", SourceUnknown, 15}}},
+	{"mango", []WeightedEmoji{{"🥭", SourceUnknown, 15}}},
+	{"manicure", []WeightedEmoji{{"💅", SourceUnknown, 15}}},
+	{"mans shoe", []WeightedEmoji{{"👞", SourceUnknown, 15}}},
+	{"mantelpiece clock", []WeightedEmoji{{"🕰️", SourceUnknown, 15}}},
+	{"manual wheelchair", []WeightedEmoji{{"🦽", SourceUnknown, 15}}},
+	{"man’s shoe", []WeightedEmoji{{"👞", SourceUnknown, 15}}},
+	{"map of japan", []WeightedEmoji{{"🗾", SourceUnknown, 15}}},
+	{"maple leaf", []WeightedEmoji{{"🍁", SourceUnknown, 15}}},
+	{"maracas", []WeightedEmoji{{"🪇", SourceUnknown, 15}}},
+	{"marathon", []WeightedEmoji{{"🏃", SourceUnknown, 15}, {"🏃\u200d♂️", SourceUnknown, 15}, {"🏃\u200d♀️", SourceUnknown, 15}, {"🎽", SourceUnknown, 15}}},
+	{"marriage", []WeightedEmoji{{"🤵", SourceUnknown, 15}, {"👰", SourceUnknown, 15}, {"💒", SourceUnknown, 15}, {"💍", SourceUnknown, 15}}},
+	{"marshall islands", []WeightedEmoji{{"🇲🇭", SourceUnknown, 15}}},
+	{"martial arts uniform", []WeightedEmoji{{"🥋", SourceUnknown, 15}}},
+	{"martinique", []WeightedEmoji{{"🇲🇶", SourceUnknown, 15}}},
+	{"mask", []WeightedEmoji{{"😷", SourceUnknown, 15}}},
+	{"massage", []WeightedEmoji{{"💆", SourceUnknown, 15}}},
+	{"massage man", []WeightedEmoji{{"💆\u200d♂️", SourceUnknown, 15}}},
+	{"massage woman", []WeightedEmoji{{"💆\u200d♀️", SourceUnknown, 15}}},
+	{"mate", []WeightedEmoji{{"🧉", SourceUnknown, 15}}},
+	{"mauritania", []WeightedEmoji{{"🇲🇷", SourceUnknown, 15}}},
+	{"mauritius", []WeightedEmoji{{"🇲🇺", SourceUnknown, 15}}},
+	{"mayotte", []WeightedEmoji{{"🇾🇹", SourceUnknown, 15}}},
+	{"meat", []WeightedEmoji{{"🍗", SourceUnknown, 15}}},
+	{"meat on bone", []WeightedEmoji{{"🍖", SourceUnknown, 15}}},
+	{"mechanic", []WeightedEmoji{{"🧑\u200d🔧", SourceUnknown, 15}}},
+	{"mechanical arm", []WeightedEmoji{{"🦾", SourceUnknown, 15}}},
+	{"mechanical leg", []WeightedEmoji{{"🦿", SourceUnknown, 15}}},
+	{"medal military", []WeightedEmoji{{"🎖️", SourceUnknown, 15}}},
+	{"medal sports", []WeightedEmoji{{"🏅", SourceUnknown, 15}}},
+	{"medical symbol", []WeightedEmoji{{"⚕️", SourceUnknown, 15}}},
+	{"medicine", []WeightedEmoji{{"💊", SourceUnknown, 15}}},
+	{"meditation", []WeightedEmoji{{"🧘", SourceUnknown, 15}, {"🧘\u200d♂️", SourceUnknown, 15}, {"🧘\u200d♀️", SourceUnknown, 15}}},
+	{"mega", []WeightedEmoji{{"📣", SourceUnknown, 15}}},
+	{"megaphone", []WeightedEmoji{{"📣", SourceUnknown, 15}}},
+	{"meh", []WeightedEmoji{{"😐", SourceUnknown, 15}, {"😒", SourceUnknown, 15}}},
+	{"melon", []WeightedEmoji{{"🍈", SourceUnknown, 15}}},
+	{"melting face", []WeightedEmoji{{"🫠", SourceUnknown, 15}}},
+	{"memo", []WeightedEmoji{{"📝", SourceUnknown, 15}}},
+	{"men holding hands", []WeightedEmoji{{"👬", SourceUnknown, 15}}},
+	{"men with bunny ears", []WeightedEmoji{{"👯\u200d♂️", SourceUnknown, 15}}},
+	{"men wrestling", []WeightedEmoji{{"🤼\u200d♂️", SourceUnknown, 15}}},
+	{"mending heart", []WeightedEmoji{{"❤️\u200d🩹", SourceUnknown, 15}}},
+	{"menorah", []WeightedEmoji{{"🕎", SourceUnknown, 15}}},
+	{"mens", []WeightedEmoji{{"🚹", SourceUnknown, 15}}},
+	{"men’s room", []WeightedEmoji{{"🚹", SourceUnknown, 15}}},
+	{"mermaid", []WeightedEmoji{{"🧜\u200d♀️", SourceUnknown, 15}}},
+	{"merman", []WeightedEmoji{{"🧜\u200d♂️", SourceUnknown, 15}}},
+	{"merperson", []WeightedEmoji{{"🧜", SourceUnknown, 15}}},
+	{"metal", []WeightedEmoji{{"🤘", SourceUnknown, 15}}},
+	{"metrics", []WeightedEmoji{{"📈", SourceUnknown, 15}, {"📉", SourceUnknown, 15}, {"📊", SourceUnknown, 15}}},
+	{"metro", []WeightedEmoji{{"🚇", SourceUnknown, 15}}},
+	{"mexico", []WeightedEmoji{{"🇲🇽", SourceUnknown, 15}}},
+	{"microbe", []WeightedEmoji{{"🦠", SourceUnknown, 15}}},
+	{"micronesia", []WeightedEmoji{{"🇫🇲", SourceUnknown, 15}}},
+	{"microphone", []WeightedEmoji{{"🎤", SourceUnknown, 15}}},
+	{"microscope", []WeightedEmoji{{"🔬", SourceUnknown, 15}}},
+	{"middle finger", []WeightedEmoji{{"🖕", SourceUnknown, 15}}},
+	{"milestone", []WeightedEmoji{{"🏁", SourceUnknown, 15}}},
+	{"military helmet", []WeightedEmoji{{"🪖", SourceUnknown, 15}}},
+	{"military medal", []WeightedEmoji{{"🎖️", SourceUnknown, 15}}},
+	{"milk", []WeightedEmoji{{"🍼", SourceUnknown, 15}}},
+	{"milk glass", []WeightedEmoji{{"🥛", SourceUnknown, 15}}},
+	{"milky way", []WeightedEmoji{{"🌌", SourceUnknown, 15}}},
+	{"mind", []WeightedEmoji{{"🤯", SourceUnknown, 15}}},
+	{"minibus", []WeightedEmoji{{"🚐", SourceUnknown, 15}}},
+	{"minidisc", []WeightedEmoji{{"💽", SourceUnknown, 15}}},
+	{"minus", []WeightedEmoji{{"➖", SourceUnknown, 15}}},
+	{"mirror", []WeightedEmoji{{"🪞", SourceUnknown, 15}}},
+	{"mirror ball", []WeightedEmoji{{"🪩", SourceUnknown, 15}}},
+	{"moai", []WeightedEmoji{{"🗿", SourceUnknown, 15}}},
+	{"mobile", []WeightedEmoji{{"📱", SourceUnknown, 15}}},
+	{"mobile phone", []WeightedEmoji{{"📱", SourceUnknown, 15}}},
+	{"mobile phone off", []WeightedEmoji{{"📴", SourceUnknown, 15}}},
+	{"mobile phone with arrow", []WeightedEmoji{{"📲", SourceUnknown, 15}}},
+	{"moldova", []WeightedEmoji{{"🇲🇩", SourceUnknown, 15}}},
+	{"monaco", []WeightedEmoji{{"🇲🇨", SourceUnknown, 15}}},
+	{"money", []WeightedEmoji{{"💵", SourceUnknown, 15}}},
+	{"money bag", []WeightedEmoji{{"💰", SourceUnknown, 15}}},
+	{"money mouth face", []WeightedEmoji{{"🤑", SourceUnknown, 15}}},
+	{"money with wings", []WeightedEmoji{{"💸", SourceUnknown, 15}}},
+	{"money-mouth face", []WeightedEmoji{{"🤑", SourceUnknown, 15}}},
+	{"moneybag", []WeightedEmoji{{"💰", SourceUnknown, 15}}},
+	{"mongolia", []WeightedEmoji{{"🇲🇳", SourceUnknown, 15}}},
+	{"monkey", []WeightedEmoji{{"🐒", SourceUnknown, 15}, {"🙈", SourceUnknown, 15}, {"🙉", SourceUnknown, 15}, {"🙊", SourceUnknown, 15}}},
+	{"monkey face", []WeightedEmoji{{"🐵", SourceUnknown, 15}}},
+	{"monocle face", []WeightedEmoji{{"🧐", SourceUnknown, 15}}},
+	{"monorail", []WeightedEmoji{{"🚝", SourceUnknown, 15}}},
+	{"monster", []WeightedEmoji{{"👹", SourceUnknown, 15}}},
+	{"montenegro", []WeightedEmoji{{"🇲🇪", SourceUnknown, 15}}},
+	{"montserrat", []WeightedEmoji{{"🇲🇸", SourceUnknown, 15}}},
+	{"moon", []WeightedEmoji{{"🌔", SourceUnknown, 15}}},
+	{"moon cake", []WeightedEmoji{{"🥮", SourceUnknown, 15}}},
+	{"moon viewing ceremony", []WeightedEmoji{{"🎑", SourceUnknown, 15}}},
+	{"moose", []WeightedEmoji{{"🫎", SourceUnknown, 15}}},
+	{"morning", []WeightedEmoji{{"⏰", SourceUnknown, 15}}},
+	{"morocco", []WeightedEmoji{{"🇲🇦", SourceUnknown, 15}}},
+	{"mortar board", []WeightedEmoji{{"🎓", SourceUnknown, 15}}},
+	{"mosque", []WeightedEmoji{{"🕌", SourceUnknown, 15}}},
+	{"mosquito", []WeightedEmoji{{"🦟", SourceUnknown, 15}}},
+	{"motor boat", []WeightedEmoji{{"🛥️", SourceUnknown, 15}}},
+	{"motor scooter", []WeightedEmoji{{"🛵", SourceUnknown, 15}}},
+	{"motorcycle", []WeightedEmoji{{"🏍️", SourceUnknown, 15}}},
+	{"motorized wheelchair", []WeightedEmoji{{"🦼", SourceUnknown, 15}}},
+	{"motorway", []WeightedEmoji{{"🛣️", SourceUnknown, 15}}},
+	{"mount fuji", []WeightedEmoji{{"🗻", SourceUnknown, 15}}},
+	{"mountain", []WeightedEmoji{{"⛰️", SourceUnknown, 15}}},
+	{"mountain bicyclist", []WeightedEmoji{{"🚵", SourceUnknown, 15}}},
+	{"mountain biking man", []WeightedEmoji{{"🚵\u200d♂️", SourceUnknown, 15}}},
+	{"mountain biking woman", []WeightedEmoji{{"🚵\u200d♀️", SourceUnknown, 15}}},
+	{"mountain cableway", []WeightedEmoji{{"🚠", SourceUnknown, 15}}},
+	{"mountain railway", []WeightedEmoji{{"🚞", SourceUnknown, 15}}},
+	{"mountain snow", []WeightedEmoji{{"🏔️", SourceUnknown, 15}}},
+	{"mouse", []WeightedEmoji{{"🐁", SourceUnknown, 15}, {"🐭", SourceUnknown, 15}}},
+	{"mouse face", []WeightedEmoji{{"🐭", SourceUnknown, 15}}},
+	{"mouse trap", []WeightedEmoji{{"🪤", SourceUnknown, 15}}},
+	{"mouse2", []WeightedEmoji{{"🐁", SourceUnknown, 15}}},
+	{"mouth", []WeightedEmoji{{"👄", SourceUnknown, 15}}},
+	{"movie", []WeightedEmoji{{"🎦", SourceUnknown, 15}}},
+	{"movie camera", []WeightedEmoji{{"🎥", SourceUnknown, 15}}},
+	{"moyai", []WeightedEmoji{{"🗿", SourceUnknown, 15}}},
+	{"mozambique", []WeightedEmoji{{"🇲🇿", SourceUnknown, 15}}},
+	{"mrs claus", []WeightedEmoji{{"🤶", SourceUnknown, 15}}},
+	{"mrs. claus", []WeightedEmoji{{"🤶", SourceUnknown, 15}}},
+	{"mule", []WeightedEmoji{{"🫏", SourceUnknown, 15}}},
+	{"multiply", []WeightedEmoji{{"✖️", SourceUnknown, 15}}},
+	{"muscle", []WeightedEmoji{{"💪", SourceUnknown, 15}}},
+	{"mushroom", []WeightedEmoji{{"🍄", SourceUnknown, 15}}},
+	{"music", []WeightedEmoji{{"🎶", SourceUnknown, 15}, {"🎧", SourceUnknown, 15}}},
+	{"musical keyboard", []WeightedEmoji{{"🎹", SourceUnknown, 15}}},
+	{"musical note", []WeightedEmoji{{"🎵", SourceUnknown, 15}}},
+	{"musical notes", []WeightedEmoji{{"🎶", SourceUnknown, 15}}},
+	{"musical score", []WeightedEmoji{{"🎼", SourceUnknown, 15}}},
+	{"mustache", []WeightedEmoji{{"👨", SourceUnknown, 15}}},
+	{"mute", []WeightedEmoji{{"🔇", SourceUnknown, 15}, {"😶", SourceUnknown, 15}, {"🙊", SourceUnknown, 15}, {"📴", SourceUnknown, 15}}},
+	{"muted speaker", []WeightedEmoji{{"🔇", SourceUnknown, 15}}},
+	{"mx claus", []WeightedEmoji{{"🧑\u200d🎄", SourceUnknown, 15}}},
+	{"myanmar", []WeightedEmoji{{"🇲🇲", SourceUnknown, 15}}},
+	{"nail care", []WeightedEmoji{{"💅", SourceUnknown, 15}}},
+	{"nail polish", []WeightedEmoji{{"💅", SourceUnknown, 15}}},
+	{"name badge", []WeightedEmoji{{"📛", SourceUnknown, 15}}},
+	{"namibia", []WeightedEmoji{{"🇳🇦", SourceUnknown, 15}}},
+	{"national park", []WeightedEmoji{{"🏞️", SourceUnknown, 15}}},
+	{"nauru", []WeightedEmoji{{"🇳🇷", SourceUnknown, 15}}},
+	{"nauseated face", []WeightedEmoji{{"🤢", SourceUnknown, 15}}},
+	{"nazar amulet", []WeightedEmoji{{"🧿", SourceUnknown, 15}}},
+	{"necktie", []WeightedEmoji{{"👔", SourceUnknown, 15}}},
+	{"needle", []WeightedEmoji{{"💉", SourceUnknown, 15}}},
+	{"negative squared cross mark", []WeightedEmoji{{"❎", SourceUnknown, 15}}},
+	{"nepal", []WeightedEmoji{{"🇳🇵", SourceUnknown, 15}}},
+	{"nerd face", []WeightedEmoji{{"🤓", SourceUnknown, 15}}},
+	{"nervous", []WeightedEmoji{{"😟", SourceUnknown, 15}, {"😰", SourceUnknown, 15}, {"😥", SourceUnknown, 15}}},
+	{"nest with eggs", []WeightedEmoji{{"🪺", SourceUnknown, 15}}},
+	{"nesting dolls", []WeightedEmoji{{"🪆", SourceUnknown, 15}}},
+	{"netherlands", []WeightedEmoji{{"🇳🇱", SourceUnknown, 15}}},
+	{"neutral face", []WeightedEmoji{{"😐", SourceUnknown, 15}}},
+	{"new", []WeightedEmoji{{"🆕", SourceUnknown, 15}}},
+	{"new button", []WeightedEmoji{{"🆕", SourceUnknown, 15}}},
+	{"new caledonia", []WeightedEmoji{{"🇳🇨", SourceUnknown, 15}}},
+	{"new moon", []WeightedEmoji{{"🌑", SourceUnknown, 15}}},
+	{"new moon face", []WeightedEmoji{{"🌚", SourceUnknown, 15}}},
+	{"new moon with face", []WeightedEmoji{{"🌚", SourceUnknown, 15}}},
+	{"new zealand", []WeightedEmoji{{"🇳🇿", SourceUnknown, 15}}},
+	{"newborn", []WeightedEmoji{{"👶", SourceUnknown, 15}}},
+	{"newspaper", []WeightedEmoji{{"📰", SourceUnknown, 15}}},
+	{"newspaper roll", []WeightedEmoji{{"🗞️", SourceUnknown, 15}}},
+	{"next track button", []WeightedEmoji{{"⏭️", SourceUnknown, 15}}},
+	{"ng", []WeightedEmoji{{"🆖", SourceUnknown, 15}}},
+	{"ng button", []WeightedEmoji{{"🆖", SourceUnknown, 15}}},
+	{"ng man", []WeightedEmoji{{"🙅\u200d♂️", SourceUnknown, 15}}},
+	{"ng woman", []WeightedEmoji{{"🙅\u200d♀️", SourceUnknown, 15}}},
+	{"nicaragua", []WeightedEmoji{{"🇳🇮", SourceUnknown, 15}}},
+	{"niger", []WeightedEmoji{{"🇳🇪", SourceUnknown, 15}}},
+	{"nigeria", []WeightedEmoji{{"🇳🇬", SourceUnknown, 15}}},
+	{"night", []WeightedEmoji{{"🌙", SourceUnknown, 15}}},
+	{"night with stars", []WeightedEmoji{{"🌃", SourceUnknown, 15}}},
+	{"nine", []WeightedEmoji{{"9️⃣", SourceUnknown, 15}}},
+	{"nine o’clock", []WeightedEmoji{{"🕘", SourceUnknown, 15}}},
+	{"nine-thirty", []WeightedEmoji{{"🕤", SourceUnknown, 15}}},
+	{"ninja", []WeightedEmoji{{"🥷", SourceUnknown, 15}}},
+	{"niue", []WeightedEmoji{{"🇳🇺", SourceUnknown, 15}}},
+	{"no bell", []WeightedEmoji{{"🔕", SourceUnknown, 15}}},
+	{"no bicycles", []WeightedEmoji{{"🚳", SourceUnknown, 15}}},
+	{"no entry", []WeightedEmoji{{"⛔", SourceUnknown, 15}}},
+	{"no entry sign", []WeightedEmoji{{"🚫", SourceUnknown, 15}}},
+	{"no good", []WeightedEmoji{{"🙅", SourceUnknown, 15}}},
+	{"no good man", []WeightedEmoji{{"🙅\u200d♂️", SourceUnknown, 15}}},
+	{"no good woman", []WeightedEmoji{{"🙅\u200d♀️", SourceUnknown, 15}}},
+	{"no littering", []WeightedEmoji{{"🚯", SourceUnknown, 15}}},
+	{"no mobile phones", []WeightedEmoji{{"📵", SourceUnknown, 15}}},
+	{"no mouth", []WeightedEmoji{{"😶", SourceUnknown, 15}}},
+	{"no one under eighteen", []WeightedEmoji{{"🔞", SourceUnknown, 15}}},
+	{"no pedestrians", []WeightedEmoji{{"🚷", SourceUnknown, 15}}},
+	{"no smoking", []WeightedEmoji{{"🚭", SourceUnknown, 15}}},
+	{"non-potable water", []WeightedEmoji{{"🚱", SourceUnknown, 15}}},
+	{"noodle", []WeightedEmoji{{"🍜", SourceUnknown, 15}}},
+	{"norfolk island", []WeightedEmoji{{"🇳🇫", SourceUnknown, 15}}},
+	{"north korea", []WeightedEmoji{{"🇰🇵", SourceUnknown, 15}}},
+	{"northern mariana islands", []WeightedEmoji{{"🇲🇵", SourceUnknown, 15}}},
+	{"norway", []WeightedEmoji{{"🇳🇴", SourceUnknown, 15}}},
+	{"nose", []WeightedEmoji{{"👃", SourceUnknown, 15}}},
+	{"note", []WeightedEmoji{{"📝", SourceUnknown, 15}}},
+	{"notebook", []WeightedEmoji{{"📓", SourceUnknown, 15}}},
+	{"notebook with decorative cover", []WeightedEmoji{{"📔", SourceUnknown, 15}}},
+	{"notes", []WeightedEmoji{{"🎶", SourceUnknown, 15}}},
+	{"notification", []WeightedEmoji{{"🔔", SourceUnknown, 15}}},
+	{"number", []WeightedEmoji{{"#️⃣", SourceUnknown, 15}}},
+	{"numbers", []WeightedEmoji{{"🔢", SourceUnknown, 15}}},
+	{"nurse", []WeightedEmoji{{"👨\u200d⚕️", SourceUnknown, 15}, {"👩\u200d⚕️", SourceUnknown, 15}}},
+	{"nursing", []WeightedEmoji{{"🤱", SourceUnknown, 15}}},
+	{"nut and bolt", []WeightedEmoji{{"🔩", SourceUnknown, 15}}},
+	{"o button (blood type)", []WeightedEmoji{{"🅾️", SourceUnknown, 15}}},
+	{"o2", []WeightedEmoji{{"🅾️", SourceUnknown, 15}}},
+	{"ocean", []WeightedEmoji{{"🌊", SourceUnknown, 15}}},
+	{"octopus", []WeightedEmoji{{"🐙", SourceUnknown, 15}}},
+	{"oden", []WeightedEmoji{{"🍢", SourceUnknown, 15}}},
+	{"off", []WeightedEmoji{{"🔕", SourceUnknown, 15}, {"📴", SourceUnknown, 15}}},
+	{"office", []WeightedEmoji{{"🏢", SourceUnknown, 15}}},
+	{"office building", []WeightedEmoji{{"🏢", SourceUnknown, 15}}},
+	{"office worker", []WeightedEmoji{{"🧑\u200d💼", SourceUnknown, 15}}},
+	{"ogre", []WeightedEmoji{{"👹", SourceUnknown, 15}}},
+	{"oil drum", []WeightedEmoji{{"🛢️", SourceUnknown, 15}}},
+	{"ok", []WeightedEmoji{{"🆗", SourceUnknown, 15}, {"👍", SourceUnknown, 15}}},
+	{"ok button", []WeightedEmoji{{"🆗", SourceUnknown, 15}}},
+	{"ok hand", []WeightedEmoji{{"👌", SourceUnknown, 15}}},
+	{"ok man", []WeightedEmoji{{"🙆\u200d♂️", SourceUnknown, 15}}},
+	{"ok person", []WeightedEmoji{{"🙆", SourceUnknown, 15}}},
+	{"ok woman", []WeightedEmoji{{"🙆\u200d♀️", SourceUnknown, 15}}},
+	{"old key", []WeightedEmoji{{"🗝️", SourceUnknown, 15}}},
+	{"old man", []WeightedEmoji{{"👴", SourceUnknown, 15}}},
+	{"old woman", []WeightedEmoji{{"👵", SourceUnknown, 15}}},
+	{"older adult", []WeightedEmoji{{"🧓", SourceUnknown, 15}}},
+	{"older man", []WeightedEmoji{{"👴", SourceUnknown, 15}}},
+	{"older person", []WeightedEmoji{{"🧓", SourceUnknown, 15}}},
+	{"older woman", []WeightedEmoji{{"👵", SourceUnknown, 15}}},
+	{"olive", []WeightedEmoji{{"🫒", SourceUnknown, 15}}},
+	{"om", []WeightedEmoji{{"🕉️", SourceUnknown, 15}}},
+	{"oman", []WeightedEmoji{{"🇴🇲", SourceUnknown, 15}}},
+	{"on", []WeightedEmoji{{"🔛", SourceUnknown, 15}}},
+	{"on! arrow", []WeightedEmoji{{"🔛", SourceUnknown, 15}}},
+	{"oncoming automobile", []WeightedEmoji{{"🚘", SourceUnknown, 15}}},
+	{"oncoming bus", []WeightedEmoji{{"🚍", SourceUnknown, 15}}},
+	{"oncoming fist", []WeightedEmoji{{"👊", SourceUnknown, 15}}},
+	{"oncoming police car", []WeightedEmoji{{"🚔", SourceUnknown, 15}}},
+	{"oncoming taxi", []WeightedEmoji{{"🚖", SourceUnknown, 15}}},
+	{"one", []WeightedEmoji{{"1️⃣", SourceUnknown, 15}}},
+	{"one o’clock", []WeightedEmoji{{"🕐", SourceUnknown, 15}}},
+	{"one piece swimsuit", []WeightedEmoji{{"🩱", SourceUnknown, 15}}},
+	{"one-piece swimsuit", []WeightedEmoji{{"🩱", SourceUnknown, 15}}},
+	{"one-thirty", []WeightedEmoji{{"🕜", SourceUnknown, 15}}},
+	{"onion", []WeightedEmoji{{"🧅", SourceUnknown, 15}}},
+	{"oops", []WeightedEmoji{{"😨", SourceUnknown, 15}}},
+	{"open book", []WeightedEmoji{{"📖", SourceUnknown, 15}}},
+	{"open file folder", []WeightedEmoji{{"📂", SourceUnknown, 15}}},
+	{"open hands", []WeightedEmoji{{"👐", SourceUnknown, 15}}},
+	{"open mailbox with lowered flag", []WeightedEmoji{{"📭", SourceUnknown, 15}}},
+	{"open mailbox with raised flag", []WeightedEmoji{{"📬", SourceUnknown, 15}}},
+	{"open mouth", []WeightedEmoji{{"😮", SourceUnknown, 15}}},
+	{"open umbrella", []WeightedEmoji{{"☂️", SourceUnknown, 15}}},
+	{"ophiuchus", []WeightedEmoji{{"⛎", SourceUnknown, 15}}},
+	{"optical disk", []WeightedEmoji{{"💿", SourceUnknown, 15}}},
+	{"orange", []WeightedEmoji{{"🍊", SourceUnknown, 15}}},
+	{"orange book", []WeightedEmoji{{"📙", SourceUnknown, 15}}},
+	{"orange circle", []WeightedEmoji{{"🟠", SourceUnknown, 15}}},
+	{"orange heart", []WeightedEmoji{{"🧡", SourceUnknown, 15}}},
+	{"orange square", []WeightedEmoji{{"🟧", SourceUnknown, 15}}},
+	{"orangutan", []WeightedEmoji{{"🦧", SourceUnknown, 15}}},
+	{"orbit", []WeightedEmoji{{"🛰️", SourceUnknown, 15}}},
+	{"orthodox cross", []WeightedEmoji{{"☦️", SourceUnknown, 15}}},
+	{"otter", []WeightedEmoji{{"🦦", SourceUnknown, 15}}},
+	{"outbox tray", []WeightedEmoji{{"📤", SourceUnknown, 15}}},
+	{"owl", []WeightedEmoji{{"🦉", SourceUnknown, 15}}},
+	{"ox", []WeightedEmoji{{"🐂", SourceUnknown, 15}}},
+	{"oyster", []WeightedEmoji{{"🦪", SourceUnknown, 15}}},
+	{"p button", []WeightedEmoji{{"🅿️", SourceUnknown, 15}}},
+	{"package", []WeightedEmoji{{"📦", SourceUnknown, 15}}},
+	{"paella", []WeightedEmoji{{"🥘", SourceUnknown, 15}}},
+	{"page facing up", []WeightedEmoji{{"📄", SourceUnknown, 15}}},
+	{"page with curl", []WeightedEmoji{{"📃", SourceUnknown, 15}}},
+	{"pager", []WeightedEmoji{{"📟", SourceUnknown, 15}}},
+	{"paint", []WeightedEmoji{{"🎨", SourceUnknown, 15}}},
+	{"paintbrush", []WeightedEmoji{{"🖌️", SourceUnknown, 15}}},
+	{"painter", []WeightedEmoji{{"👨\u200d🎨", SourceUnknown, 15}, {"👩\u200d🎨", SourceUnknown, 15}}},
+	{"pakistan", []WeightedEmoji{{"🇵🇰", SourceUnknown, 15}}},
+	{"palau", []WeightedEmoji{{"🇵🇼", SourceUnknown, 15}}},
+	{"palestinian territories", []WeightedEmoji{{"🇵🇸", SourceUnknown, 15}}},
+	{"palm down hand", []WeightedEmoji{{"🫳", SourceUnknown, 15}}},
+	{"palm tree", []WeightedEmoji{{"🌴", SourceUnknown, 15}}},
+	{"palm up hand", []WeightedEmoji{{"🫴", SourceUnknown, 15}}},
+	{"palms up together", []WeightedEmoji{{"🤲", SourceUnknown, 15}}},
+	{"panama", []WeightedEmoji{{"🇵🇦", SourceUnknown, 15}}},
+	{"pancakes", []WeightedEmoji{{"🥞", SourceUnknown, 15}}},
+	{"panda", []WeightedEmoji{{"🐼", SourceUnknown, 15}}},
+	{"panda face", []WeightedEmoji{{"🐼", SourceUnknown, 15}}},
+	{"pants", []WeightedEmoji{{"👖", SourceUnknown, 15}}},
+	{"paperclip", []WeightedEmoji{{"📎", SourceUnknown, 15}}},
+	{"paperclips", []WeightedEmoji{{"🖇️", SourceUnknown, 15}}},
+	{"papua new guinea", []WeightedEmoji{{"🇵🇬", SourceUnknown, 15}}},
+	{"parachute", []WeightedEmoji{{"🪂", SourceUnknown, 15}}},
+	{"paraguay", []WeightedEmoji{{"🇵🇾", SourceUnknown, 15}}},
+	{"parasol on ground", []WeightedEmoji{{"⛱️", SourceUnknown, 15}}},
+	{"parents", []WeightedEmoji{{"👪", SourceUnknown, 15}}},
+	{"parking", []WeightedEmoji{{"🅿️", SourceUnknown, 15}}},
+	{"parrot", []WeightedEmoji{{"🦜", SourceUnknown, 15}}},
+	{"part alternation mark", []WeightedEmoji{{"〽️", SourceUnknown, 15}}},
+	{"partly sunny", []WeightedEmoji{{"⛅", SourceUnknown, 15}}},
+	{"party", []WeightedEmoji{{"🎉", SourceCustom, 40}, {"🎂", SourceUnknown, 15}, {"🎈", SourceUnknown, 15}, {"🪩", SourceUnknown, 15}}},
+	{"party popper", []WeightedEmoji{{"🎉", SourceUnknown, 15}}},
+	{"partying face", []WeightedEmoji{{"🥳", SourceUnknown, 15}}},
+	{"passenger ship", []WeightedEmoji{{"🛳️", SourceUnknown, 15}}},
+	{"passport control", []WeightedEmoji{{"🛂", SourceUnknown, 15}}},
+	{"password", []WeightedEmoji{{"🔑", SourceUnknown, 15}}},
+	{"pasta", []WeightedEmoji{{"🍝", SourceUnknown, 15}}},
+	{"pause button", []WeightedEmoji{{"⏸️", SourceUnknown, 15}}},
+	{"paw prints", []WeightedEmoji{{"🐾", SourceUnknown, 15}}},
+	{"pea pod", []WeightedEmoji{{"🫛", SourceUnknown, 15}}},
+	{"peace", []WeightedEmoji{{"✌️", SourceUnknown, 15}, {"🕊️", SourceUnknown, 15}}},
+	{"peace symbol", []WeightedEmoji{{"☮️", SourceUnknown, 15}}},
+	{"peach", []WeightedEmoji{{"🍑", SourceUnknown, 15}}},
+	{"peacock", []WeightedEmoji{{"🦚", SourceUnknown, 15}}},
+	{"peanuts", []WeightedEmoji{{"🥜", SourceUnknown, 15}}},
+	{"pear", []WeightedEmoji{{"🍐", SourceUnknown, 15}}},
+	{"pen", []WeightedEmoji{{"🖊️", SourceUnknown, 15}}},
+	{"pencil", []WeightedEmoji{{"📝", SourceUnknown, 15}, {"✏️", SourceUnknown, 15}}},
+	{"pencil2", []WeightedEmoji{{"✏️", SourceUnknown, 15}}},
+	{"penguin", []WeightedEmoji{{"🐧", SourceUnknown, 15}}},
+	{"pensive", []WeightedEmoji{{"😔", SourceUnknown, 15}}},
+	{"pensive face", []WeightedEmoji{{"😔", SourceUnknown, 15}}},
+	{"people holding hands", []WeightedEmoji{{"🧑\u200d🤝\u200d🧑", SourceUnknown, 15}}},
+	{"people hugging", []WeightedEmoji{{"🫂", SourceUnknown, 15}}},
+	{"people with bunny ears", []WeightedEmoji{{"👯", SourceUnknown, 15}}},
+	{"people wrestling", []WeightedEmoji{{"🤼", SourceUnknown, 15}}},
+	{"perfect", []WeightedEmoji{{"💯", SourceUnknown, 15}}},
+	{"performing arts", []WeightedEmoji{{"🎭", SourceUnknown, 15}}},
+	{"persevere", []WeightedEmoji{{"😣", SourceUnknown, 15}}},
+	{"persevering face", []WeightedEmoji{{"😣", SourceUnknown, 15}}},
+	{"person", []WeightedEmoji{{"🧑", SourceUnknown, 15}}},
+	{"person bald", []WeightedEmoji{{"🧑\u200d🦲", SourceUnknown, 15}}},
+	{"person biking", []WeightedEmoji{{"🚴", SourceUnknown, 15}}},
+	{"person bouncing ball", []WeightedEmoji{{"⛹️", SourceUnknown, 15}}},
+	{"person bowing", []WeightedEmoji{{"🙇", SourceUnknown, 15}}},
+	{"person cartwheeling", []WeightedEmoji{{"🤸", SourceUnknown, 15}}},
+	{"person climbing", []WeightedEmoji{{"🧗", SourceUnknown, 15}}},
+	{"person curly hair", []WeightedEmoji{{"🧑\u200d🦱", SourceUnknown, 15}}},
+	{"person facepalming", []WeightedEmoji{{"🤦", SourceUnknown, 15}}},
+	{"person feeding baby", []WeightedEmoji{{"🧑\u200d🍼", SourceUnknown, 15}}},
+	{"person fencing", []WeightedEmoji{{"🤺", SourceUnknown, 15}}},
+	{"person frowning", []WeightedEmoji{{"🙍", SourceUnknown, 15}}},
+	{"person gesturing no", []WeightedEmoji{{"🙅", SourceUnknown, 15}}},
+	{"person gesturing ok", []WeightedEmoji{{"🙆", SourceUnknown, 15}}},
+	{"person getting haircut", []WeightedEmoji{{"💇", SourceUnknown, 15}}},
+	{"person getting massage", []WeightedEmoji{{"💆", SourceUnknown, 15}}},
+	{"person golfing", []WeightedEmoji{{"🏌️", SourceUnknown, 15}}},
+	{"person in bed", []WeightedEmoji{{"🛌", SourceUnknown, 15}}},
+	{"person in lotus position", []WeightedEmoji{{"🧘", SourceUnknown, 15}}},
+	{"person in manual wheelchair", []WeightedEmoji{{"🧑\u200d🦽", SourceUnknown, 15}}},
+	{"person in motorized wheelchair", []WeightedEmoji{{"🧑\u200d🦼", SourceUnknown, 15}}},
+	{"person in steamy room", []WeightedEmoji{{"🧖", SourceUnknown, 15}}},
+	{"person in suit levitating", []WeightedEmoji{{"🕴️", SourceUnknown, 15}}},
+	{"person in tuxedo", []WeightedEmoji{{"🤵", SourceUnknown, 15}}},
+	{"person juggling", []WeightedEmoji{{"🤹", SourceUnknown, 15}}},
+	{"person kneeling", []WeightedEmoji{{"🧎", SourceUnknown, 15}}},
+	{"person lifting weights", []WeightedEmoji{{"🏋️", SourceUnknown, 15}}},
+	{"person mountain biking", []WeightedEmoji{{"🚵", SourceUnknown, 15}}},
+	{"person playing handball", []WeightedEmoji{{"🤾", SourceUnknown, 15}}},
+	{"person playing water polo", []WeightedEmoji{{"🤽", SourceUnknown, 15}}},
+	{"person pouting", []WeightedEmoji{{"🙎", SourceUnknown, 15}}},
+	{"person raising hand", []WeightedEmoji{{"🙋", SourceUnknown, 15}}},
+	{"person red hair", []WeightedEmoji{{"🧑\u200d🦰", SourceUnknown, 15}}},
+	{"person rowing boat", []WeightedEmoji{{"🚣", SourceUnknown, 15}}},
+	{"person running", []WeightedEmoji{{"🏃", SourceUnknown, 15}}},
+	{"person shrugging", []WeightedEmoji{{"🤷", SourceUnknown, 15}}},
+	{"person standing", []WeightedEmoji{{"🧍", SourceUnknown, 15}}},
+	{"person surfing", []WeightedEmoji{{"🏄", SourceUnknown, 15}}},
+	{"person swimming", []WeightedEmoji{{"🏊", SourceUnknown, 15}}},
+	{"person taking bath", []WeightedEmoji{{"🛀", SourceUnknown, 15}}},
+	{"person tipping hand", []WeightedEmoji{{"💁", SourceUnknown, 15}}},
+	{"person walking", []WeightedEmoji{{"🚶", SourceUnknown, 15}}},
+	{"person wearing turban", []WeightedEmoji{{"👳", SourceUnknown, 15}}},
+	{"person white hair", []WeightedEmoji{{"🧑\u200d🦳", SourceUnknown, 15}}},
+	{"person with crown", []WeightedEmoji{{"🫅", SourceUnknown, 15}}},
+	{"person with probing cane", []WeightedEmoji{{"🧑\u200d🦯", SourceUnknown, 15}}},
+	{"person with skullcap", []WeightedEmoji{{"👲", SourceUnknown, 15}}},
+	{"person with turban", []WeightedEmoji{{"👳", SourceUnknown, 15}}},
+	{"person with veil", []WeightedEmoji{{"👰", SourceUnknown, 15}}},
+	{"person with white cane", []WeightedEmoji{{"🧑\u200d🦯", SourceUnknown, 15}}},
+	{"person: bald", []WeightedEmoji{{"🧑\u200d🦲", SourceUnknown, 15}}},
+	{"person: beard", []WeightedEmoji{{"🧔", SourceUnknown, 15}}},
+	{"person: blond hair", []WeightedEmoji{{"👱", SourceUnknown, 15}}},
+	{"person: curly hair", []WeightedEmoji{{"🧑\u200d🦱", SourceUnknown, 15}}},
+	{"person: red hair", []WeightedEmoji{{"🧑\u200d🦰", SourceUnknown, 15}}},
+	{"person: white hair", []WeightedEmoji{{"🧑\u200d🦳", SourceUnknown, 15}}},
+	{"peru", []WeightedEmoji{{"🇵🇪", SourceUnknown, 15}}},
+	{"pet", []WeightedEmoji{{"🐶", SourceUnknown, 15}, {"🐱", SourceUnknown, 15}, {"🐹", SourceUnknown, 15}}},
+	{"petri dish", []WeightedEmoji{{"🧫", SourceUnknown, 15}}},
+	{"phew", []WeightedEmoji{{"😥", SourceUnknown, 15}}},
+	{"philippines", []WeightedEmoji{{"🇵🇭", SourceUnknown, 15}}},
+	{"phone", []WeightedEmoji{{"☎️", SourceUnknown, 15}, {"📞", SourceUnknown, 15}}},
+	{"photo", []WeightedEmoji{{"📷", SourceUnknown, 15}, {"📸", SourceUnknown, 15}}},
+	{"piano", []WeightedEmoji{{"🎹", SourceUnknown, 15}}},
+	{"pick", []WeightedEmoji{{"⛏️", SourceUnknown, 15}}},
+	{"pickup truck", []WeightedEmoji{{"🛻", SourceUnknown, 15}}},
+	{"pie", []WeightedEmoji{{"🥧", SourceUnknown, 15}}},
+	{"pig", []WeightedEmoji{{"🐖", SourceUnknown, 15}, {"🐷", SourceUnknown, 15}}},
+	{"pig face", []WeightedEmoji{{"🐷", SourceUnknown, 15}}},
+	{"pig nose", []WeightedEmoji{{"🐽", SourceUnknown, 15}}},
+	{"pig2", []WeightedEmoji{{"🐖", SourceUnknown, 15}}},
+	{"pile of poo", []WeightedEmoji{{"💩", SourceUnknown, 15}}},
+	{"pill", []WeightedEmoji{{"💊", SourceUnknown, 15}}},
+	{"pilot", []WeightedEmoji{{"🧑\u200d✈️", SourceUnknown, 15}}},
+	{"pinata", []WeightedEmoji{{"🪅", SourceUnknown, 15}}},
+	{"pinched fingers", []WeightedEmoji{{"🤌", SourceUnknown, 15}}},
+	{"pinching hand", []WeightedEmoji{{"🤏", SourceUnknown, 15}}},
+	{"pine decoration", []WeightedEmoji{{"🎍", SourceUnknown, 15}}},
+	{"pineapple", []WeightedEmoji{{"🍍", SourceUnknown, 15}}},
+	{"ping pong", []WeightedEmoji{{"🏓", SourceUnknown, 15}}},
+	{"pink heart", []WeightedEmoji{{"🩷", SourceUnknown, 15}}},
+	{"pirate", []WeightedEmoji{{"☠️", SourceUnknown, 15}}},
+	{"pirate flag", []WeightedEmoji{{"🏴\u200d☠️", SourceUnknown, 15}}},
+	{"pisces", []WeightedEmoji{{"♓", SourceUnknown, 15}}},
+	{"pitcairn islands", []WeightedEmoji{{"🇵🇳", SourceUnknown, 15}}},
+	{"pizza", []WeightedEmoji{{"🍕", SourceUnknown, 15}}},
+	{"piñata", []WeightedEmoji{{"🪅", SourceUnknown, 15}}},
+	{"placard", []WeightedEmoji{{"🪧", SourceUnknown, 15}}},
+	{"place of worship", []WeightedEmoji{{"🛐", SourceUnknown, 15}}},
+	{"plant", []WeightedEmoji{{"🌱", SourceUnknown, 15}}},
+	{"plate with cutlery", []WeightedEmoji{{"🍽️", SourceUnknown, 15}}},
+	{"play", []WeightedEmoji{{"🎮", SourceUnknown, 15}}},
+	{"play button", []WeightedEmoji{{"▶️", SourceUnknown, 15}}},
+	{"play or pause button", []WeightedEmoji{{"⏯️", SourceUnknown, 15}}},
+	{"playground slide", []WeightedEmoji{{"🛝", SourceUnknown, 15}}},
+	{"pleading face", []WeightedEmoji{{"🥺", SourceUnknown, 15}}},
+	{"please", []WeightedEmoji{{"🙏", SourceUnknown, 15}}},
+	{"pleased", []WeightedEmoji{{"😄", SourceUnknown, 15}, {"☺️", SourceUnknown, 15}}},
+	{"plunger", []WeightedEmoji{{"🪠", SourceUnknown, 15}}},
+	{"plus", []WeightedEmoji{{"➕", SourceUnknown, 15}}},
+	{"podcast", []WeightedEmoji{{"🎙️", SourceUnknown, 15}, {"📻", SourceUnknown, 15}}},
+	{"point down", []WeightedEmoji{{"👇", SourceUnknown, 15}}},
+	{"point left", []WeightedEmoji{{"👈", SourceUnknown, 15}}},
+	{"point right", []WeightedEmoji{{"👉", SourceUnknown, 15}}},
+	{"point up", []WeightedEmoji{{"☝️", SourceUnknown, 15}}},
+	{"point up 2", []WeightedEmoji{{"👆", SourceUnknown, 15}}},
+	{"poison", []WeightedEmoji{{"💀", SourceUnknown, 15}}},
+	{"poland", []WeightedEmoji{{"🇵🇱", SourceUnknown, 15}}},
+	{"polar bear", []WeightedEmoji{{"🐻\u200d❄️", SourceUnknown, 15}}},
+	{"police car", []WeightedEmoji{{"🚓", SourceUnknown, 15}}},
+	{"police car light", []WeightedEmoji{{"🚨", SourceUnknown, 15}}},
+	{"police officer", []WeightedEmoji{{"👮", SourceUnknown, 15}}},
+	{"policeman", []WeightedEmoji{{"👮\u200d♂️", SourceUnknown, 15}}},
+	{"policewoman", []WeightedEmoji{{"👮\u200d♀️", SourceUnknown, 15}}},
+	{"poodle", []WeightedEmoji{{"🐩", SourceUnknown, 15}}},
+	{"pool", []WeightedEmoji{{"🎱", SourceUnknown, 15}}},
+	{"pool 8 ball", []WeightedEmoji{{"🎱", SourceUnknown, 15}}},
+	{"poop", []WeightedEmoji{{"💩", SourceUnknown, 15}}},
+	{"popcorn", []WeightedEmoji{{"🍿", SourceUnknown, 15}}},
+	{"portugal", []WeightedEmoji{{"🇵🇹", SourceUnknown, 15}}},
+	{"post office", []WeightedEmoji{{"🏤", SourceUnknown, 15}, {"🏣", SourceUnknown, 15}}},
+	{"postal horn", []WeightedEmoji{{"📯", SourceUnknown, 15}}},
+	{"postbox", []WeightedEmoji{{"📮", SourceUnknown, 15}}},
+	{"pot of food", []WeightedEmoji{{"🍲", SourceUnknown, 15}}},
+	{"potable water", []WeightedEmoji{{"🚰", SourceUnknown, 15}}},
+	{"potato", []WeightedEmoji{{"🥔", SourceUnknown, 15}}},
+	{"potted plant", []WeightedEmoji{{"🪴", SourceUnknown, 15}}},
+	{"pouch", []WeightedEmoji{{"👝", SourceUnknown, 15}}},
+	{"poultry leg", []WeightedEmoji{{"🍗", SourceUnknown, 15}}},
+	{"pound", []WeightedEmoji{{"💷", SourceUnknown, 15}}},
+	{"pound banknote", []WeightedEmoji{{"💷", SourceUnknown, 15}}},
+	{"pouring liquid", []WeightedEmoji{{"🫗", SourceUnknown, 15}}},
+	{"pout", []WeightedEmoji{{"😡", SourceUnknown, 15}}},
+	{"pouting cat", []WeightedEmoji{{"😾", SourceUnknown, 15}}},
+	{"pouting face", []WeightedEmoji{{"🙎", SourceUnknown, 15}}},
+	{"pouting man", []WeightedEmoji{{"🙎\u200d♂️", SourceUnknown, 15}}},
+	{"pouting woman", []WeightedEmoji{{"🙎\u200d♀️", SourceUnknown, 15}}},
+	{"power", []WeightedEmoji{{"✊", SourceUnknown, 15}, {"🔋", SourceUnknown, 15}}},
+	{"praise", []WeightedEmoji{{"👏", SourceUnknown, 15}}},
+	{"prank", []WeightedEmoji{{"😜", SourceUnknown, 15}, {"😝", SourceUnknown, 15}}},
+	{"pray", []WeightedEmoji{{"🙏", SourceUnknown, 15}}},
+	{"prayer beads", []WeightedEmoji{{"📿", SourceUnknown, 15}}},
+	{"pregnant man", []WeightedEmoji{{"🫃", SourceUnknown, 15}}},
+	{"pregnant person", []WeightedEmoji{{"🫄", SourceUnknown, 15}}},
+	{"pregnant woman", []WeightedEmoji{{"🤰", SourceUnknown, 15}}},
+	{"present", []WeightedEmoji{{"🎁", SourceUnknown, 15}}},
+	{"press", []WeightedEmoji{{"📰", SourceUnknown, 15}, {"🗞️", SourceUnknown, 15}}},
+	{"pretzel", []WeightedEmoji{{"🥨", SourceUnknown, 15}}},
+	{"previous track button", []WeightedEmoji{{"⏮️", SourceUnknown, 15}}},
+	{"pride", []WeightedEmoji{{"🏳️\u200d🌈", SourceUnknown, 15}}},
+	{"prince", []WeightedEmoji{{"🤴", SourceUnknown, 15}}},
+	{"princess", []WeightedEmoji{{"👸", SourceUnknown, 15}}},
+	{"printer", []WeightedEmoji{{"🖨️", SourceUnknown, 15}}},
+	{"private", []WeightedEmoji{{"🔒", SourceUnknown, 15}}},
+	{"probing cane", []WeightedEmoji{{"🦯", SourceUnknown, 15}}},
+	{"professor", []WeightedEmoji{{"👨\u200d🏫", SourceUnknown, 15}, {"👩\u200d🏫", SourceUnknown, 15}}},
+	{"prohibited", []WeightedEmoji{{"🚫", SourceUnknown, 15}}},
+	{"prosper", []WeightedEmoji{{"🖖", SourceUnknown, 15}}},
+	{"proud", []WeightedEmoji{{"😊", SourceUnknown, 15}}},
+	{"puerto rico", []WeightedEmoji{{"🇵🇷", SourceUnknown, 15}}},
+	{"punch", []WeightedEmoji{{"👊", SourceUnknown, 15}}},
+	{"puppy", []WeightedEmoji{{"🥺", SourceUnknown, 15}}},
+	{"purple circle", []WeightedEmoji{{"🟣", SourceUnknown, 15}}},
+	{"purple heart", []WeightedEmoji{{"💜", SourceUnknown, 15}}},
+	{"purple square", []WeightedEmoji{{"🟪", SourceUnknown, 15}}},
+	{"purse", []WeightedEmoji{{"👛", SourceUnknown, 15}}},
+	{"pushpin", []WeightedEmoji{{"📌", SourceUnknown, 15}}},
+	{"put litter in its place", []WeightedEmoji{{"🚮", SourceUnknown, 15}}},
+	{"puzzle piece", []WeightedEmoji{{"🧩", SourceUnknown, 15}}},
+	{"qatar", []WeightedEmoji{{"🇶🇦", SourceUnknown, 15}}},
+	{"queen", []WeightedEmoji{{"👑", SourceUnknown, 15}}},
+	{"question", []WeightedEmoji{{"❓", SourceUnknown, 15}}},
+	{"quiet", []WeightedEmoji{{"🤭", SourceUnknown, 15}, {"🤫", SourceUnknown, 15}}},
+	{"rabbit", []WeightedEmoji{{"🐇", SourceUnknown, 15}, {"🐰", SourceUnknown, 15}}},
+	{"rabbit face", []WeightedEmoji{{"🐰", SourceUnknown, 15}}},
+	{"rabbit2", []WeightedEmoji{{"🐇", SourceUnknown, 15}}},
+	{"raccoon", []WeightedEmoji{{"🦝", SourceUnknown, 15}}},
+	{"racehorse", []WeightedEmoji{{"🐎", SourceUnknown, 15}}},
+	{"racing car", []WeightedEmoji{{"🏎️", SourceUnknown, 15}}},
+	{"radio", []WeightedEmoji{{"📻", SourceUnknown, 15}}},
+	{"radio button", []WeightedEmoji{{"🔘", SourceUnknown, 15}}},
+	{"radioactive", []WeightedEmoji{{"☢️", SourceUnknown, 15}}},
+	{"rage", []WeightedEmoji{{"😡", SourceUnknown, 15}}},
+	{"railway car", []WeightedEmoji{{"🚃", SourceUnknown, 15}}},
+	{"railway track", []WeightedEmoji{{"🛤️", SourceUnknown, 15}}},
+	{"rain", []WeightedEmoji{{"🌂", SourceUnknown, 15}, {"☔", SourceUnknown, 15}}},
+	{"rainbow", []WeightedEmoji{{"🌈", SourceUnknown, 15}}},
+	{"rainbow flag", []WeightedEmoji{{"🏳️\u200d🌈", SourceUnknown, 15}}},
+	{"raised back of hand", []WeightedEmoji{{"🤚", SourceUnknown, 15}}},
+	{"raised eyebrow", []WeightedEmoji{{"🤨", SourceUnknown, 15}}},
+	{"raised fist", []WeightedEmoji{{"✊", SourceUnknown, 15}}},
+	{"raised hand", []WeightedEmoji{{"✋", SourceUnknown, 15}}},
+	{"raised hand with fingers splayed", []WeightedEmoji{{"🖐️", SourceUnknown, 15}}},
+	{"raised hands", []WeightedEmoji{{"🙌", SourceUnknown, 15}}},
+	{"raising hand", []WeightedEmoji{{"🙋", SourceUnknown, 15}}},
+	{"raising hand man", []WeightedEmoji{{"🙋\u200d♂️", SourceUnknown, 15}}},
+	{"raising hand woman", []WeightedEmoji{{"🙋\u200d♀️", SourceUnknown, 15}}},
+	{"raising hands", []WeightedEmoji{{"🙌", SourceUnknown, 15}}},
+	{"ram", []WeightedEmoji{{"🐏", SourceUnknown, 15}}},
+	{"ramen", []WeightedEmoji{{"🍜", SourceUnknown, 15}}},
+	{"rat", []WeightedEmoji{{"🐀", SourceUnknown, 15}}},
+	{"razor", []WeightedEmoji{{"🪒", SourceUnknown, 15}}},
+	{"receipt", []WeightedEmoji{{"🧾", SourceUnknown, 15}}},
+	{"record button", []WeightedEmoji{{"⏺️", SourceUnknown, 15}}},
+	{"recorder", []WeightedEmoji{{"🪈", SourceUnknown, 15}}},
+	{"recycle", []WeightedEmoji{{"♻️", SourceUnknown, 15}}},
+	{"recycling symbol", []WeightedEmoji{{"♻️", SourceUnknown, 15}}},
+	{"red apple", []WeightedEmoji{{"🍎", SourceUnknown, 15}}},
+	{"red car", []WeightedEmoji{{"🚗", SourceUnknown, 15}}},
+	{"red circle", []WeightedEmoji{{"🔴", SourceUnknown, 15}}},
+	{"red envelope", []WeightedEmoji{{"🧧", SourceUnknown, 15}}},
+	{"red exclamation mark", []WeightedEmoji{{"❗", SourceUnknown, 15}}},
+	{"red haired man", []WeightedEmoji{{"👨\u200d🦰", SourceUnknown, 15}}},
+	{"red haired woman", []WeightedEmoji{{"👩\u200d🦰", SourceUnknown, 15}}},
+	{"red heart", []WeightedEmoji{{"❤️", SourceUnknown, 15}}},
+	{"red paper lantern", []WeightedEmoji{{"🏮", SourceUnknown, 15}}},
+	{"red question mark", []WeightedEmoji{{"❓", SourceUnknown, 15}}},
+	{"red square", []WeightedEmoji{{"🟥", SourceUnknown, 15}}},
+	{"red triangle pointed down", []WeightedEmoji{{"🔻", SourceUnknown, 15}}},
+	{"red triangle pointed up", []WeightedEmoji{{"🔺", SourceUnknown, 15}}},
+	{"registered", []WeightedEmoji{{"®️", SourceUnknown, 15}}},
+	{"relaxed", []WeightedEmoji{{"☺️", SourceUnknown, 15}}},
+	{"relieved", []WeightedEmoji{{"😌", SourceUnknown, 15}}},
+	{"relieved face", []WeightedEmoji{{"😌", SourceUnknown, 15}}},
+	{"reminder ribbon", []WeightedEmoji{{"🎗️", SourceUnknown, 15}}},
+	{"repeat", []WeightedEmoji{{"🔁", SourceUnknown, 15}}},
+	{"repeat button", []WeightedEmoji{{"🔁", SourceUnknown, 15}}},
+	{"repeat one", []WeightedEmoji{{"🔂", SourceUnknown, 15}}},
+	{"repeat single button", []WeightedEmoji{{"🔂", SourceUnknown, 15}}},
+	{"rescue worker helmet", []WeightedEmoji{{"⛑️", SourceUnknown, 15}}},
+	{"rescue worker’s helmet", []WeightedEmoji{{"⛑️", SourceUnknown, 15}}},
+	{"research", []WeightedEmoji{{"👨\u200d🔬", SourceUnknown, 15}, {"👩\u200d🔬", SourceUnknown, 15}}},
+	{"respect", []WeightedEmoji{{"🫡", SourceUnknown, 15}, {"🙇", SourceUnknown, 15}, {"🙇\u200d♂️", SourceUnknown, 15}, {"🙇\u200d♀️", SourceUnknown, 15}}},
+	{"restroom", []WeightedEmoji{{"🚻", SourceUnknown, 15}, {"🚾", SourceUnknown, 15}}},
+	{"retro", []WeightedEmoji{{"👾", SourceUnknown, 15}}},
+	{"return", []WeightedEmoji{{"↩️", SourceUnknown, 15}}},
+	{"reunion", []WeightedEmoji{{"🇷🇪", SourceUnknown, 15}}},
+	{"reverse button", []WeightedEmoji{{"◀️", SourceUnknown, 15}}},
+	{"revolving hearts", []WeightedEmoji{{"💞", SourceUnknown, 15}}},
+	{"rewind", []WeightedEmoji{{"⏪", SourceUnknown, 15}}},
+	{"rhinoceros", []WeightedEmoji{{"🦏", SourceUnknown, 15}}},
+	{"ribbon", []WeightedEmoji{{"🎀", SourceUnknown, 15}}},
+	{"rice", []WeightedEmoji{{"🍚", SourceUnknown, 15}}},
+	{"rice ball", []WeightedEmoji{{"🍙", SourceUnknown, 15}}},
+	{"rice cracker", []WeightedEmoji{{"🍘", SourceUnknown, 15}}},
+	{"rice scene", []WeightedEmoji{{"🎑", SourceUnknown, 15}}},
+	{"rich", []WeightedEmoji{{"🤑", SourceUnknown, 15}}},
+	{"right anger bubble", []WeightedEmoji{{"🗯️", SourceUnknown, 15}}},
+	{"right arrow", []WeightedEmoji{{"➡️", SourceUnknown, 15}}},
+	{"right arrow curving down", []WeightedEmoji{{"⤵️", SourceUnknown, 15}}},
+	{"right arrow curving left", []WeightedEmoji{{"↩️", SourceUnknown, 15}}},
+	{"right arrow curving up", []WeightedEmoji{{"⤴️", SourceUnknown, 15}}},
+	{"right-facing fist", []WeightedEmoji{{"🤜", SourceUnknown, 15}}},
+	{"rightwards hand", []WeightedEmoji{{"🫱", SourceUnknown, 15}}},
+	{"rightwards pushing hand", []WeightedEmoji{{"🫸", SourceUnknown, 15}}},
+	{"ring", []WeightedEmoji{{"💍", SourceUnknown, 15}}},
+	{"ring buoy", []WeightedEmoji{{"🛟", SourceUnknown, 15}}},
+	{"ringed planet", []WeightedEmoji{{"🪐", SourceUnknown, 15}}},
+	{"roasted sweet potato", []WeightedEmoji{{"🍠", SourceUnknown, 15}}},
+	{"robot", []WeightedEmoji{{"🤖", SourceUnknown, 15}}},
+	{"rock", []WeightedEmoji{{"🪨", SourceUnknown, 15}, {"🎸", SourceUnknown, 15}}},
+	{"rocket", []WeightedEmoji{{"🚀", SourceUnknown, 15}}},
+	{"rockstar", []WeightedEmoji{{"👨\u200d🎤", SourceUnknown, 15}, {"👩\u200d🎤", SourceUnknown, 15}}},
+	{"rofl", []WeightedEmoji{{"🤣", SourceUnknown, 15}}},
+	{"roll eyes", []WeightedEmoji{{"🙄", SourceUnknown, 15}}},
+	{"roll of paper", []WeightedEmoji{{"🧻", SourceUnknown, 15}}},
+	{"rolled-up newspaper", []WeightedEmoji{{"🗞️", SourceUnknown, 15}}},
+	{"roller coaster", []WeightedEmoji{{"🎢", SourceUnknown, 15}}},
+	{"roller skate", []WeightedEmoji{{"🛼", SourceUnknown, 15}}},
+	{"rolling on the floor laughing", []WeightedEmoji{{"🤣", SourceUnknown, 15}}},
+	{"romania", []WeightedEmoji{{"🇷🇴", SourceUnknown, 15}}},
+	{"rooster", []WeightedEmoji{{"🐓", SourceUnknown, 15}}},
+	{"rose", []WeightedEmoji{{"🌹", SourceUnknown, 15}}},
+	{"rosette", []WeightedEmoji{{"🏵️", SourceUnknown, 15}}},
+	{"rotating light", []WeightedEmoji{{"🚨", SourceUnknown, 15}}},
+	{"round pushpin", []WeightedEmoji{{"📍", SourceUnknown, 15}}},
+	{"rowboat", []WeightedEmoji{{"🚣", SourceUnknown, 15}}},
+	{"rowing man", []WeightedEmoji{{"🚣\u200d♂️", SourceUnknown, 15}}},
+	{"rowing woman", []WeightedEmoji{{"🚣\u200d♀️", SourceUnknown, 15}}},
+	{"royal", []WeightedEmoji{{"🤴", SourceUnknown, 15}, {"👸", SourceUnknown, 15}, {"👑", SourceUnknown, 15}}},
+	{"ru", []WeightedEmoji{{"🇷🇺", SourceUnknown, 15}}},
+	{"rugby football", []WeightedEmoji{{"🏉", SourceUnknown, 15}}},
+	{"runner", []WeightedEmoji{{"🏃", SourceUnknown, 15}}},
+	{"running", []WeightedEmoji{{"🏃", SourceUnknown, 15}, {"👟", SourceUnknown, 15}}},
+	{"running man", []WeightedEmoji{{"🏃\u200d♂️", SourceUnknown, 15}}},
+	{"running shirt", []WeightedEmoji{{"🎽", SourceUnknown, 15}}},
+	{"running shirt with sash", []WeightedEmoji{{"🎽", SourceUnknown, 15}}},
+	{"running shoe", []WeightedEmoji{{"👟", SourceUnknown, 15}}},
+	{"running woman", []WeightedEmoji{{"🏃\u200d♀️", SourceUnknown, 15}}},
+	{"russia", []WeightedEmoji{{"🇷🇺", SourceUnknown, 15}}},
+	{"rwanda", []WeightedEmoji{{"🇷🇼", SourceUnknown, 15}}},
+	{"sa", []WeightedEmoji{{"🈂️", SourceUnknown, 15}}},
+	{"sad", []WeightedEmoji{{"😢", SourceUnknown, 15}, {"😭", SourceUnknown, 15}, {"😞", SourceUnknown, 15}, {"😿", SourceUnknown, 15}}},
+	{"sad but relieved face", []WeightedEmoji{{"😥", SourceUnknown, 15}}},
+	{"safety pin", []WeightedEmoji{{"🧷", SourceUnknown, 15}}},
+	{"safety vest", []WeightedEmoji{{"🦺", SourceUnknown, 15}}},
+	{"sagittarius", []WeightedEmoji{{"♐", SourceUnknown, 15}}},
+	{"sailboat", []WeightedEmoji{{"⛵", SourceUnknown, 15}}},
+	{"sake", []WeightedEmoji{{"🍶", SourceUnknown, 15}}},
+	{"salt", []WeightedEmoji{{"🧂", SourceUnknown, 15}}},
+	{"saluting face", []WeightedEmoji{{"🫡", SourceUnknown, 15}}},
+	{"samoa", []WeightedEmoji{{"🇼🇸", SourceUnknown, 15}}},
+	{"san marino", []WeightedEmoji{{"🇸🇲", SourceUnknown, 15}}},
+	{"sandal", []WeightedEmoji{{"👡", SourceUnknown, 15}}},
+	{"sandwich", []WeightedEmoji{{"🥪", SourceUnknown, 15}}},
+	{"santa", []WeightedEmoji{{"🎅", SourceUnknown, 15}, {"🤶", SourceUnknown, 15}}},
+	{"santa claus", []WeightedEmoji{{"🎅", SourceUnknown, 15}}},
+	{"sao tome principe", []WeightedEmoji{{"🇸🇹", SourceUnknown, 15}}},
+	{"sarcasm", []WeightedEmoji{{"🫠", SourceUnknown, 15}}},
+	{"sari", []WeightedEmoji{{"🥻", SourceUnknown, 15}}},
+	{"sassy man", []WeightedEmoji{{"💁\u200d♂️", SourceUnknown, 15}}},
+	{"sassy woman", []WeightedEmoji{{"💁\u200d♀️", SourceUnknown, 15}}},
+	{"satellite", []WeightedEmoji{{"📡", SourceUnknown, 15}, {"🛰️", SourceUnknown, 15}}},
+	{"satellite antenna", []WeightedEmoji{{"📡", SourceUnknown, 15}}},
+	{"satisfied", []WeightedEmoji{{"😆", SourceUnknown, 15}}},
+	{"saudi arabia", []WeightedEmoji{{"🇸🇦", SourceUnknown, 15}}},
+	{"sauna man", []WeightedEmoji{{"🧖\u200d♂️", SourceUnknown, 15}}},
+	{"sauna person", []WeightedEmoji{{"🧖", SourceUnknown, 15}}},
+	{"sauna woman", []WeightedEmoji{{"🧖\u200d♀️", SourceUnknown, 15}}},
+	{"sauropod", []WeightedEmoji{{"🦕", SourceUnknown, 15}}},
+	{"save", []WeightedEmoji{{"💾", SourceUnknown, 15}}},
+	{"saxophone", []WeightedEmoji{{"🎷", SourceUnknown, 15}}},
+	{"scared", []WeightedEmoji{{"😨", SourceUnknown, 15}}},
+	{"scarf", []WeightedEmoji{{"🧣", SourceUnknown, 15}}},
+	{"schedule", []WeightedEmoji{{"📅", SourceUnknown, 15}, {"📆", SourceUnknown, 15}}},
+	{"school", []WeightedEmoji{{"🏫", SourceUnknown, 15}, {"👨\u200d🏫", SourceUnknown, 15}, {"👩\u200d🏫", SourceUnknown, 15}}},
+	{"school satchel", []WeightedEmoji{{"🎒", SourceUnknown, 15}}},
+	{"science", []WeightedEmoji{{"🔬", SourceUnknown, 15}}},
+	{"scientist", []WeightedEmoji{{"🧑\u200d🔬", SourceUnknown, 15}}},
+	{"scissors", []WeightedEmoji{{"✂️", SourceUnknown, 15}}},
+	{"score", []WeightedEmoji{{"💯", SourceUnknown, 15}}},
+	{"scorpio", []WeightedEmoji{{"♏", SourceUnknown, 15}}},
+	{"scorpion", []WeightedEmoji{{"🦂", SourceUnknown, 15}}},
+	{"scorpius", []WeightedEmoji{{"♏", SourceUnknown, 15}}},
+	{"scotland", []WeightedEmoji{{"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", SourceUnknown, 15}}},
+	{"scream", []WeightedEmoji{{"😱", SourceUnknown, 15}}},
+	{"scream cat", []WeightedEmoji{{"🙀", SourceUnknown, 15}}},
+	{"screen", []WeightedEmoji{{"💻", SourceUnknown, 15}}},
+	{"screwdriver", []WeightedEmoji{{"🪛", SourceUnknown, 15}}},
+	{"scroll", []WeightedEmoji{{"📜", SourceUnknown, 15}}},
+	{"sea", []WeightedEmoji{{"🐳", SourceUnknown, 15}, {"🐚", SourceUnknown, 15}, {"🌊", SourceUnknown, 15}}},
+	{"seal", []WeightedEmoji{{"🦭", SourceUnknown, 15}}},
+	{"search", []WeightedEmoji{{"🔍", SourceUnknown, 15}}},
+	{"seat", []WeightedEmoji{{"💺", SourceUnknown, 15}}},
+	{"secret", []WeightedEmoji{{"㊙️", SourceUnknown, 15}}},
+	{"security", []WeightedEmoji{{"🔒", SourceUnknown, 15}, {"🔓", SourceUnknown, 15}, {"🔐", SourceUnknown, 15}}},
+	{"see", []WeightedEmoji{{"👀", SourceUnknown, 15}}},
+	{"see no evil", []WeightedEmoji{{"🙈", SourceUnknown, 15}}},
+	{"see-no-evil monkey", []WeightedEmoji{{"🙈", SourceUnknown, 15}}},
+	{"seedling", []WeightedEmoji{{"🌱", SourceUnknown, 15}}},
+	{"selfie", []WeightedEmoji{{"🤳", SourceUnknown, 15}}},
+	{"semaphore", []WeightedEmoji{{"🚦", SourceUnknown, 15}}},
+	{"senegal", []WeightedEmoji{{"🇸🇳", SourceUnknown, 15}}},
+	{"sensu", []WeightedEmoji{{"🪭", SourceUnknown, 15}}},
+	{"serbia", []WeightedEmoji{{"🇷🇸", SourceUnknown, 15}}},
+	{"service dog", []WeightedEmoji{{"🐕\u200d🦺", SourceUnknown, 15}}},
+	{"seven", []WeightedEmoji{{"7️⃣", SourceUnknown, 15}}},
+	{"seven o’clock", []WeightedEmoji{{"🕖", SourceUnknown, 15}}},
+	{"seven-thirty", []WeightedEmoji{{"🕢", SourceUnknown, 15}}},
+	{"sewing needle", []WeightedEmoji{{"🪡", SourceUnknown, 15}}},
+	{"seychelles", []WeightedEmoji{{"🇸🇨", SourceUnknown, 15}}},
+	{"shaker", []WeightedEmoji{{"🪇", SourceUnknown, 15}}},
+	{"shaking face", []WeightedEmoji{{"🫨", SourceUnknown, 15}}},
+	{"shallow pan of food", []WeightedEmoji{{"🥘", SourceUnknown, 15}}},
+	{"shamrock", []WeightedEmoji{{"☘️", SourceUnknown, 15}}},
+	{"shark", []WeightedEmoji{{"🦈", SourceUnknown, 15}}},
+	{"shaved ice", []WeightedEmoji{{"🍧", SourceUnknown, 15}}},
+	{"sheaf of rice", []WeightedEmoji{{"🌾", SourceUnknown, 15}}},
+	{"sheep", []WeightedEmoji{{"🐑", SourceUnknown, 15}}},
+	{"shell", []WeightedEmoji{{"🐚", SourceUnknown, 15}}},
+	{"shield", []WeightedEmoji{{"🛡️", SourceUnknown, 15}}},
+	{"shinto shrine", []WeightedEmoji{{"⛩️", SourceUnknown, 15}}},
+	{"shiny", []WeightedEmoji{{"✨", SourceUnknown, 15}}},
+	{"ship", []WeightedEmoji{{"🚢", SourceUnknown, 15}, {"⚓", SourceUnknown, 15}, {"🚤", SourceUnknown, 15}, {"🚀", SourceUnknown, 15}}},
+	{"shipping", []WeightedEmoji{{"📦", SourceUnknown, 15}}},
+	{"shirt", []WeightedEmoji{{"👕", SourceUnknown, 15}, {"👔", SourceUnknown, 15}}},
+	{"shit", []WeightedEmoji{{"💩", SourceUnknown, 15}}},
+	{"shock", []WeightedEmoji{{"🫢", SourceUnknown, 15}, {"🫨", SourceUnknown, 15}}},
+	{"shocked", []WeightedEmoji{{"😨", SourceUnknown, 15}, {"😱", SourceUnknown, 15}}},
+	{"shoe", []WeightedEmoji{{"👞", SourceUnknown, 15}, {"👠", SourceUnknown, 15}, {"👡", SourceUnknown, 15}}},
+	{"shoot", []WeightedEmoji{{"🔫", SourceUnknown, 15}}},
+	{"shooting star", []WeightedEmoji{{"🌠", SourceUnknown, 15}}},
+	{"shopping", []WeightedEmoji{{"🛍️", SourceUnknown, 15}}},
+	{"shopping bags", []WeightedEmoji{{"🛍️", SourceUnknown, 15}}},
+	{"shopping cart", []WeightedEmoji{{"🛒", SourceUnknown, 15}}},
+	{"shortcake", []WeightedEmoji{{"🍰", SourceUnknown, 15}}},
+	{"shorts", []WeightedEmoji{{"🩳", SourceUnknown, 15}}},
+	{"shower", []WeightedEmoji{{"🚿", SourceUnknown, 15}, {"🛀", SourceUnknown, 15}}},
+	{"shrimp", []WeightedEmoji{{"🦐", SourceUnknown, 15}}},
+	{"shrug", []WeightedEmoji{{"🤷", SourceUnknown, 15}}},
+	{"shuffle", []WeightedEmoji{{"🔀", SourceUnknown, 15}}},
+	{"shuffle tracks button", []WeightedEmoji{{"🔀", SourceUnknown, 15}}},
+	{"shushing face", []WeightedEmoji{{"🤫", SourceUnknown, 15}}},
+	{"sick", []WeightedEmoji{{"😷", SourceUnknown, 15}, {"🤒", SourceUnknown, 15}, {"🤢", SourceUnknown, 15}, {"🤮", SourceUnknown, 15}, {"🤧", SourceUnknown, 15}}},
+	{"sierra leone", []WeightedEmoji{{"🇸🇱", SourceUnknown, 15}}},
+	{"sign of the horns", []WeightedEmoji{{"🤘", SourceUnknown, 15}}},
+	{"signal", []WeightedEmoji{{"📡", SourceUnknown, 15}}},
+	{"signal strength", []WeightedEmoji{{"📶", SourceUnknown, 15}}},
+	{"silence", []WeightedEmoji{{"🤫", SourceUnknown, 15}, {"🤐", SourceUnknown, 15}, {"😶", SourceUnknown, 15}, {"😯", SourceUnknown, 15}}},
+	{"silly", []WeightedEmoji{{"😜", SourceUnknown, 15}}},
+	{"silver", []WeightedEmoji{{"🥈", SourceUnknown, 15}}},
+	{"sing", []WeightedEmoji{{"🎤", SourceUnknown, 15}}},
+	{"singapore", []WeightedEmoji{{"🇸🇬", SourceUnknown, 15}}},
+	{"singer", []WeightedEmoji{{"🧑\u200d🎤", SourceUnknown, 15}}},
+	{"sint maarten", []WeightedEmoji{{"🇸🇽", SourceUnknown, 15}}},
+	{"six", []WeightedEmoji{{"6️⃣", SourceUnknown, 15}}},
+	{"six o’clock", []WeightedEmoji{{"🕕", SourceUnknown, 15}}},
+	{"six pointed star", []WeightedEmoji{{"🔯", SourceUnknown, 15}}},
+	{"six-thirty", []WeightedEmoji{{"🕡", SourceUnknown, 15}}},
+	{"skateboard", []WeightedEmoji{{"🛹", SourceUnknown, 15}}},
+	{"skating", []WeightedEmoji{{"⛸️", SourceUnknown, 15}}},
+	{"ski", []WeightedEmoji{{"🎿", SourceUnknown, 15}}},
+	{"skier", []WeightedEmoji{{"⛷️", SourceUnknown, 15}}},
+	{"skis", []WeightedEmoji{{"🎿", SourceUnknown, 15}}},
+	{"skull", []WeightedEmoji{{"💀", SourceUnknown, 15}}},
+	{"skull and crossbones", []WeightedEmoji{{"☠️", SourceUnknown, 15}}},
+	{"skunk", []WeightedEmoji{{"🦨", SourceUnknown, 15}}},
+	{"skyline", []WeightedEmoji{{"🏙️", SourceUnknown, 15}}},
+	{"sled", []WeightedEmoji{{"🛷", SourceUnknown, 15}}},
+	{"sleeping", []WeightedEmoji{{"😴", SourceUnknown, 15}, {"💤", SourceUnknown, 15}}},
+	{"sleeping bed", []WeightedEmoji{{"🛌", SourceUnknown, 15}}},
+	{"sleeping face", []WeightedEmoji{{"😴", SourceUnknown, 15}}},
+	{"sleepy", []WeightedEmoji{{"😪", SourceUnknown, 15}}},
+	{"sleepy face", []WeightedEmoji{{"😪", SourceUnknown, 15}}},
+	{"sleuth", []WeightedEmoji{{"🕵️", SourceUnknown, 15}, {"🕵️\u200d♂️", SourceUnknown, 15}, {"🕵️\u200d♀️", SourceUnknown, 15}}},
+	{"slightly frowning face", []WeightedEmoji{{"🙁", SourceUnknown, 15}}},
+	{"slightly smiling face", []WeightedEmoji{{"🙂", SourceUnknown, 15}}},
+	{"slot machine", []WeightedEmoji{{"🎰", SourceUnknown, 15}}},
+	{"sloth", []WeightedEmoji{{"🦥", SourceUnknown, 15}}},
+	{"slovakia", []WeightedEmoji{{"🇸🇰", SourceUnknown, 15}}},
+	{"slovenia", []WeightedEmoji{{"🇸🇮", SourceUnknown, 15}}},
+	{"slow", []WeightedEmoji{{"🐢", SourceUnknown, 15}, {"🐌", SourceUnknown, 15}}},
+	{"small airplane", []WeightedEmoji{{"🛩️", SourceUnknown, 15}}},
+	{"small blue diamond", []WeightedEmoji{{"🔹", SourceUnknown, 15}}},
+	{"small orange diamond", []WeightedEmoji{{"🔸", SourceUnknown, 15}}},
+	{"small red triangle", []WeightedEmoji{{"🔺", SourceUnknown, 15}}},
+	{"small red triangle down", []WeightedEmoji{{"🔻", SourceUnknown, 15}}},
+	{"smartphone", []WeightedEmoji{{"📱", SourceUnknown, 15}}},
+	{"smell", []WeightedEmoji{{"👃", SourceUnknown, 15}}},
+	{"smile", []WeightedEmoji{{"😄", SourceUnknown, 15}, {"😀", SourceUnknown, 15}}},
+	{"smile cat", []WeightedEmoji{{"😸", SourceUnknown, 15}}},
+	{"smiley", []WeightedEmoji{{"😃", SourceUnknown, 15}}},
+	{"smiley cat", []WeightedEmoji{{"😺", SourceUnknown, 15}}},
+	{"smiling cat with heart-eyes", []WeightedEmoji{{"😻", SourceUnknown, 15}}},
+	{"smiling face", []WeightedEmoji{{"☺️", SourceUnknown, 15}}},
+	{"smiling face with halo", []WeightedEmoji{{"😇", SourceUnknown, 15}}},
+	{"smiling face with heart-eyes", []WeightedEmoji{{"😍", SourceUnknown, 15}}},
+	{"smiling face with hearts", []WeightedEmoji{{"🥰", SourceUnknown, 15}}},
+	{"smiling face with horns", []WeightedEmoji{{"😈", SourceUnknown, 15}}},
+	{"smiling face with open hands", []WeightedEmoji{{"🤗", SourceUnknown, 15}}},
+	{"smiling face with smiling eyes", []WeightedEmoji{{"😊", SourceUnknown, 15}}},
+	{"smiling face with sunglasses", []WeightedEmoji{{"😎", SourceUnknown, 15}}},
+	{"smiling face with tear", []WeightedEmoji{{"🥲", SourceUnknown, 15}}},
+	{"smiling face with three hearts", []WeightedEmoji{{"🥰", SourceUnknown, 15}}},
+	{"smiling imp", []WeightedEmoji{{"😈", SourceUnknown, 15}}},
+	{"smirk", []WeightedEmoji{{"😏", SourceUnknown, 15}}},
+	{"smirk cat", []WeightedEmoji{{"😼", SourceUnknown, 15}}},
+	{"smirking face", []WeightedEmoji{{"😏", SourceUnknown, 15}}},
+	{"smoking", []WeightedEmoji{{"🚬", SourceUnknown, 15}}},
+	{"smug", []WeightedEmoji{{"😏", SourceUnknown, 15}, {"😤", SourceUnknown, 15}}},
+	{"snail", []WeightedEmoji{{"🐌", SourceUnknown, 15}}},
+	{"snake", []WeightedEmoji{{"🐍", SourceUnknown, 15}}},
+	{"sneaker", []WeightedEmoji{{"👟", SourceUnknown, 15}}},
+	{"sneezing face", []WeightedEmoji{{"🤧", SourceUnknown, 15}}},
+	{"snow-capped mountain", []WeightedEmoji{{"🏔️", SourceUnknown, 15}}},
+	{"snowboarder", []WeightedEmoji{{"🏂", SourceUnknown, 15}}},
+	{"snowflake", []WeightedEmoji{{"❄️", SourceUnknown, 15}}},
+	{"snowman", []WeightedEmoji{{"⛄", SourceUnknown, 15}, {"☃️", SourceUnknown, 15}}},
+	{"snowman with snow", []WeightedEmoji{{"☃️", SourceUnknown, 15}}},
+	{"snowman without snow", []WeightedEmoji{{"⛄", SourceUnknown, 15}}},
+	{"soap", []WeightedEmoji{{"🧼", SourceUnknown, 15}}},
+	{"sob", []WeightedEmoji{{"😭", SourceUnknown, 15}}},
+	{"soccer", []WeightedEmoji{{"⚽", SourceUnknown, 15}}},
+	{"soccer ball", []WeightedEmoji{{"⚽", SourceUnknown, 15}}},
+	{"socks", []WeightedEmoji{{"🧦", SourceUnknown, 15}}},
+	{"soft ice cream", []WeightedEmoji{{"🍦", SourceUnknown, 15}}},
+	{"softball", []WeightedEmoji{{"🥎", SourceUnknown, 15}}},
+	{"solomon islands", []WeightedEmoji{{"🇸🇧", SourceUnknown, 15}}},
+	{"somalia", []WeightedEmoji{{"🇸🇴", SourceUnknown, 15}}},
+	{"soon", []WeightedEmoji{{"🔜", SourceUnknown, 15}}},
+	{"soon arrow", []WeightedEmoji{{"🔜", SourceUnknown, 15}}},
+	{"sos", []WeightedEmoji{{"🆘", SourceUnknown, 15}}},
+	{"sos button", []WeightedEmoji{{"🆘", SourceUnknown, 15}}},
+	{"sound", []WeightedEmoji{{"🔉", SourceUnknown, 15}, {"👂", SourceUnknown, 15}, {"🔇", SourceUnknown, 15}, {"🔔", SourceUnknown, 15}}},
+	{"south africa", []WeightedEmoji{{"🇿🇦", SourceUnknown, 15}}},
+	{"south georgia south sandwich islands", []WeightedEmoji{{"🇬🇸", SourceUnknown, 15}}},
+	{"south sudan", []WeightedEmoji{{"🇸🇸", SourceUnknown, 15}}},
+	{"spa", []WeightedEmoji{{"💆", SourceUnknown, 15}, {"💆\u200d♂️", SourceUnknown, 15}, {"💆\u200d♀️", SourceUnknown, 15}}},
+	{"space", []WeightedEmoji{{"👨\u200d🚀", SourceUnknown, 15}, {"👩\u200d🚀", SourceUnknown, 15}, {"🛰️", SourceUnknown, 15}}},
+	{"space invader", []WeightedEmoji{{"👾", SourceUnknown, 15}}},
+	{"spade suit", []WeightedEmoji{{"♠️", SourceUnknown, 15}}},
+	{"spades", []WeightedEmoji{{"♠️", SourceUnknown, 15}}},
+	{"spaghetti", []WeightedEmoji{{"🍝", SourceUnknown, 15}}},
+	{"spain", []WeightedEmoji{{"🇪🇸", SourceUnknown, 15}}},
+	{"sparkle", []WeightedEmoji{{"❇️", SourceUnknown, 15}}},
+	{"sparkler", []WeightedEmoji{{"🎇", SourceUnknown, 15}}},
+	{"sparkles", []WeightedEmoji{{"✨", SourceUnknown, 15}}},
+	{"sparkling heart", []WeightedEmoji{{"💖", SourceUnknown, 15}}},
+	{"speak no evil", []WeightedEmoji{{"🙊", SourceUnknown, 15}}},
+	{"speak-no-evil monkey", []WeightedEmoji{{"🙊", SourceUnknown, 15}}},
+	{"speaker", []WeightedEmoji{{"🔈", SourceUnknown, 15}}},
+	{"speaker high volume", []WeightedEmoji{{"🔊", SourceUnknown, 15}}},
+	{"speaker low volume", []WeightedEmoji{{"🔈", SourceUnknown, 15}}},
+	{"speaker medium volume", []WeightedEmoji{{"🔉", SourceUnknown, 15}}},
+	{"speaking head", []WeightedEmoji{{"🗣️", SourceUnknown, 15}}},
+	{"speech balloon", []WeightedEmoji{{"💬", SourceUnknown, 15}}},
+	{"speechless", []WeightedEmoji{{"😯", SourceUnknown, 15}}},
+	{"speed", []WeightedEmoji{{"🐎", SourceUnknown, 15}}},
+	{"speedboat", []WeightedEmoji{{"🚤", SourceUnknown, 15}}},
+	{"spicy", []WeightedEmoji{{"🌶️", SourceUnknown, 15}}},
+	{"spider", []WeightedEmoji{{"🕷️", SourceUnknown, 15}}},
+	{"spider web", []WeightedEmoji{{"🕸️", SourceUnknown, 15}}},
+	{"spiral calendar", []WeightedEmoji{{"🗓️", SourceUnknown, 15}}},
+	{"spiral notepad", []WeightedEmoji{{"🗒️", SourceUnknown, 15}}},
+	{"spiral shell", []WeightedEmoji{{"🐚", SourceUnknown, 15}}},
+	{"spock", []WeightedEmoji{{"🖖", SourceUnknown, 15}}},
+	{"sponge", []WeightedEmoji{{"🧽", SourceUnknown, 15}}},
+	{"spoon", []WeightedEmoji{{"🥄", SourceUnknown, 15}}},
+	{"sport", []WeightedEmoji{{"👟", SourceUnknown, 15}}},
+	{"sport utility vehicle", []WeightedEmoji{{"🚙", SourceUnknown, 15}}},
+	{"sports", []WeightedEmoji{{"⚽", SourceUnknown, 15}, {"⚾", SourceUnknown, 15}, {"🏀", SourceUnknown, 15}, {"🏈", SourceUnknown, 15}, {"🎾", SourceUnknown, 15}}},
+	{"sports medal", []WeightedEmoji{{"🏅", SourceUnknown, 15}}},
+	{"spouting whale", []WeightedEmoji{{"🐳", SourceUnknown, 15}}},
+	{"spring", []WeightedEmoji{{"🌸", SourceUnknown, 15}}},
+	{"squid", []WeightedEmoji{{"🦑", SourceUnknown, 15}}},
+	{"squinting face with tongue", []WeightedEmoji{{"😝", SourceUnknown, 15}}},
+	{"sri lanka", []WeightedEmoji{{"🇱🇰", SourceUnknown, 15}}},
+	{"st barthelemy", []WeightedEmoji{{"🇧🇱", SourceUnknown, 15}}},
+	{"st helena", []WeightedEmoji{{"🇸🇭", SourceUnknown, 15}}},
+	{"st kitts nevis", []WeightedEmoji{{"🇰🇳", SourceUnknown, 15}}},
+	{"st lucia", []WeightedEmoji{{"🇱🇨", SourceUnknown, 15}}},
+	{"st martin", []WeightedEmoji{{"🇲🇫", SourceUnknown, 15}}},
+	{"st pierre miquelon", []WeightedEmoji{{"🇵🇲", SourceUnknown, 15}}},
+	{"st vincent grenadines", []WeightedEmoji{{"🇻🇨", SourceUnknown, 15}}},
+	{"stadium", []WeightedEmoji{{"🏟️", SourceUnknown, 15}}},
+	{"standing man", []WeightedEmoji{{"🧍\u200d♂️", SourceUnknown, 15}}},
+	{"standing person", []WeightedEmoji{{"🧍", SourceUnknown, 15}}},
+	{"standing woman", []WeightedEmoji{{"🧍\u200d♀️", SourceUnknown, 15}}},
+	{"star", []WeightedEmoji{{"⭐", SourceUnknown, 15}, {"💫", SourceUnknown, 15}}},
+	{"star and crescent", []WeightedEmoji{{"☪️", SourceUnknown, 15}}},
+	{"star of david", []WeightedEmoji{{"✡️", SourceUnknown, 15}}},
+	{"star struck", []WeightedEmoji{{"🤩", SourceUnknown, 15}}},
+	{"star-struck", []WeightedEmoji{{"🤩", SourceUnknown, 15}}},
+	{"star2", []WeightedEmoji{{"🌟", SourceUnknown, 15}}},
+	{"stars", []WeightedEmoji{{"🌠", SourceUnknown, 15}}},
+	{"station", []WeightedEmoji{{"🚉", SourceUnknown, 15}}},
+	{"stats", []WeightedEmoji{{"📊", SourceUnknown, 15}}},
+	{"statue of liberty", []WeightedEmoji{{"🗽", SourceUnknown, 15}}},
+	{"steam locomotive", []WeightedEmoji{{"🚂", SourceUnknown, 15}}},
+	{"steaming bowl", []WeightedEmoji{{"🍜", SourceUnknown, 15}}},
+	{"steamy", []WeightedEmoji{{"🧖", SourceUnknown, 15}, {"🧖\u200d♂️", SourceUnknown, 15}, {"🧖\u200d♀️", SourceUnknown, 15}}},
+	{"stethoscope", []WeightedEmoji{{"🩺", SourceUnknown, 15}}},
+	{"stew", []WeightedEmoji{{"🍲", SourceUnknown, 15}}},
+	{"stone", []WeightedEmoji{{"🗿", SourceUnknown, 15}}},
+	{"stop", []WeightedEmoji{{"✋", SourceUnknown, 15}, {"🙅", SourceUnknown, 15}, {"🙅\u200d♂️", SourceUnknown, 15}, {"🙅\u200d♀️", SourceUnknown, 15}}},
+	{"stop button", []WeightedEmoji{{"⏹️", SourceUnknown, 15}}},
+	{"stop sign", []WeightedEmoji{{"🛑", SourceUnknown, 15}}},
+	{"stopwatch", []WeightedEmoji{{"⏱️", SourceUnknown, 15}}},
+	{"straight ruler", []WeightedEmoji{{"📏", SourceUnknown, 15}}},
+	{"strawberry", []WeightedEmoji{{"🍓", SourceUnknown, 15}}},
+	{"strong", []WeightedEmoji{{"💪", SourceUnknown, 15}}},
+	{"struggling", []WeightedEmoji{{"😣", SourceUnknown, 15}}},
+	{"stuck out tongue", []WeightedEmoji{{"😛", SourceUnknown, 15}}},
+	{"stuck out tongue closed eyes", []WeightedEmoji{{"😝", SourceUnknown, 15}}},
+	{"stuck out tongue winking eye", []WeightedEmoji{{"😜", SourceUnknown, 15}}},
+	{"student", []WeightedEmoji{{"🧑\u200d🎓", SourceUnknown, 15}}},
+	{"studio microphone", []WeightedEmoji{{"🎙️", SourceUnknown, 15}}},
+	{"stuffed flatbread", []WeightedEmoji{{"🥙", SourceUnknown, 15}}},
+	{"stunned", []WeightedEmoji{{"😧", SourceUnknown, 15}}},
+	{"subscription", []WeightedEmoji{{"💳", SourceUnknown, 15}}},
+	{"sudan", []WeightedEmoji{{"🇸🇩", SourceUnknown, 15}}},
+	{"summer", []WeightedEmoji{{"🍹", SourceUnknown, 15}, {"🌞", SourceUnknown, 15}}},
+	{"sun", []WeightedEmoji{{"☀️", SourceUnknown, 15}}},
+	{"sun behind cloud", []WeightedEmoji{{"⛅", SourceUnknown, 15}}},
+	{"sun behind large cloud", []WeightedEmoji{{"🌥️", SourceUnknown, 15}}},
+	{"sun behind rain cloud", []WeightedEmoji{{"🌦️", SourceUnknown, 15}}},
+	{"sun behind small cloud", []WeightedEmoji{{"🌤️", SourceUnknown, 15}}},
+	{"sun with face", []WeightedEmoji{{"🌞", SourceUnknown, 15}}},
+	{"sunflower", []WeightedEmoji{{"🌻", SourceUnknown, 15}}},
+	{"sunglasses", []WeightedEmoji{{"🕶️", SourceUnknown, 15}, {"😎", SourceUnknown, 15}}},
+	{"sunny", []WeightedEmoji{{"☀️", SourceUnknown, 15}}},
+	{"sunrise", []WeightedEmoji{{"🌅", SourceUnknown, 15}}},
+	{"sunrise over mountains", []WeightedEmoji{{"🌄", SourceUnknown, 15}}},
+	{"sunset", []WeightedEmoji{{"🌇", SourceUnknown, 15}}},
+	{"superhero", []WeightedEmoji{{"🦸", SourceUnknown, 15}}},
+	{"superhero man", []WeightedEmoji{{"🦸\u200d♂️", SourceUnknown, 15}}},
+	{"superhero woman", []WeightedEmoji{{"🦸\u200d♀️", SourceUnknown, 15}}},
+	{"supervillain", []WeightedEmoji{{"🦹", SourceUnknown, 15}}},
+	{"supervillain man", []WeightedEmoji{{"🦹\u200d♂️", SourceUnknown, 15}}},
+	{"supervillain woman", []WeightedEmoji{{"🦹\u200d♀️", SourceUnknown, 15}}},
+	{"surfer", []WeightedEmoji{{"🏄", SourceUnknown, 15}}},
+	{"surfing man", []WeightedEmoji{{"🏄\u200d♂️", SourceUnknown, 15}}},
+	{"surfing woman", []WeightedEmoji{{"🏄\u200d♀️", SourceUnknown, 15}}},
+	{"suriname", []WeightedEmoji{{"🇸🇷", SourceUnknown, 15}}},
+	{"surprise", []WeightedEmoji{{"😮", SourceUnknown, 15}}},
+	{"sushi", []WeightedEmoji{{"🍣", SourceUnknown, 15}}},
+	{"suspension railway", []WeightedEmoji{{"🚟", SourceUnknown, 15}}},
+	{"suspicious", []WeightedEmoji{{"🤨", SourceUnknown, 15}}},
+	{"svalbard jan mayen", []WeightedEmoji{{"🇸🇯", SourceUnknown, 15}}},
+	{"swan", []WeightedEmoji{{"🦢", SourceUnknown, 15}}},
+	{"swaziland", []WeightedEmoji{{"🇸🇿", SourceUnknown, 15}}},
+	{"sweat", []WeightedEmoji{{"😓", SourceUnknown, 15}, {"😥", SourceUnknown, 15}}},
+	{"sweat droplets", []WeightedEmoji{{"💦", SourceUnknown, 15}}},
+	{"sweat drops", []WeightedEmoji{{"💦", SourceUnknown, 15}}},
+	{"sweat smile", []WeightedEmoji{{"😅", SourceUnknown, 15}}},
+	{"sweating", []WeightedEmoji{{"🥵", SourceUnknown, 15}}},
+	{"sweden", []WeightedEmoji{{"🇸🇪", SourceUnknown, 15}}},
+	{"sweet", []WeightedEmoji{{"🍬", SourceUnknown, 15}}},
+	{"sweet potato", []WeightedEmoji{{"🍠", SourceUnknown, 15}}},
+	{"swim brief", []WeightedEmoji{{"🩲", SourceUnknown, 15}}},
+	{"swimmer", []WeightedEmoji{{"🏊", SourceUnknown, 15}}},
+	{"swimming man", []WeightedEmoji{{"🏊\u200d♂️", SourceUnknown, 15}}},
+	{"swimming woman", []WeightedEmoji{{"🏊\u200d♀️", SourceUnknown, 15}}},
+	{"swirl", []WeightedEmoji{{"🌀", SourceUnknown, 15}}},
+	{"switzerland", []WeightedEmoji{{"🇨🇭", SourceUnknown, 15}}},
+	{"symbols", []WeightedEmoji{{"🔣", SourceUnknown, 15}}},
+	{"synagogue", []WeightedEmoji{{"🕍", SourceUnknown, 15}}},
+	{"sync", []WeightedEmoji{{"🔄", SourceUnknown, 15}}},
+	{"syria", []WeightedEmoji{{"🇸🇾", SourceUnknown, 15}}},
+	{"syringe", []WeightedEmoji{{"💉", SourceUnknown, 15}}},
+	{"t-rex", []WeightedEmoji{{"🦖", SourceUnknown, 15}}},
+	{"t-shirt", []WeightedEmoji{{"👕", SourceUnknown, 15}}},
+	{"taco", []WeightedEmoji{{"🌮", SourceUnknown, 15}}},
+	{"tada", []WeightedEmoji{{"🎉", SourceUnknown, 15}}},
+	{"tag", []WeightedEmoji{{"🏷️", SourceUnknown, 15}}},
+	{"taiwan", []WeightedEmoji{{"🇹🇼", SourceUnknown, 15}}},
+	{"tajikistan", []WeightedEmoji{{"🇹🇯", SourceUnknown, 15}}},
+	{"takeout box", []WeightedEmoji{{"🥡", SourceUnknown, 15}}},
+	{"tamale", []WeightedEmoji{{"🫔", SourceUnknown, 15}}},
+	{"tanabata tree", []WeightedEmoji{{"🎋", SourceUnknown, 15}}},
+	{"tangerine", []WeightedEmoji{{"🍊", SourceUnknown, 15}}},
+	{"tanzania", []WeightedEmoji{{"🇹🇿", SourceUnknown, 15}}},
+	{"target", []WeightedEmoji{{"🎯", SourceUnknown, 15}}},
+	{"taste", []WeightedEmoji{{"👅", SourceUnknown, 15}}},
+	{"taurus", []WeightedEmoji{{"♉", SourceUnknown, 15}}},
+	{"taxi", []WeightedEmoji{{"🚕", SourceUnknown, 15}}},
+	{"tea", []WeightedEmoji{{"🍵", SourceUnknown, 15}}},
+	{"teacher", []WeightedEmoji{{"🧑\u200d🏫", SourceUnknown, 15}}},
+	{"teacup without handle", []WeightedEmoji{{"🍵", SourceUnknown, 15}}},
+	{"team", []WeightedEmoji{{"👥", SourceUnknown, 15}}},
+	{"teapot", []WeightedEmoji{{"🫖", SourceUnknown, 15}}},
+	{"tear", []WeightedEmoji{{"😢", SourceUnknown, 15}, {"😿", SourceUnknown, 15}}},
+	{"tear-off calendar", []WeightedEmoji{{"📆", SourceUnknown, 15}}},
+	{"tears", []WeightedEmoji{{"😂", SourceUnknown, 15}, {"🥹", SourceUnknown, 15}}},
+	{"technologist", []WeightedEmoji{{"🧑\u200d💻", SourceUnknown, 15}}},
+	{"teddy bear", []WeightedEmoji{{"🧸", SourceUnknown, 15}}},
+	{"telephone", []WeightedEmoji{{"☎️", SourceUnknown, 15}}},
+	{"telephone receiver", []WeightedEmoji{{"📞", SourceUnknown, 15}}},
+	{"telescope", []WeightedEmoji{{"🔭", SourceUnknown, 15}}},
+	{"television", []WeightedEmoji{{"📺", SourceUnknown, 15}}},
+	{"tempura", []WeightedEmoji{{"🍤", SourceUnknown, 15}}},
+	{"ten o’clock", []WeightedEmoji{{"🕙", SourceUnknown, 15}}},
+	{"ten-thirty", []WeightedEmoji{{"🕥", SourceUnknown, 15}}},
+	{"tennis", []WeightedEmoji{{"🎾", SourceUnknown, 15}}},
+	{"tent", []WeightedEmoji{{"⛺", SourceUnknown, 15}}},
+	{"test tube", []WeightedEmoji{{"🧪", SourceUnknown, 15}}},
+	{"thailand", []WeightedEmoji{{"🇹🇭", SourceUnknown, 15}}},
+	{"thank you", []WeightedEmoji{{"🙏", SourceCustom, 40}}},
+	{"thanks", []WeightedEmoji{{"🙇", SourceUnknown, 15}, {"🙇\u200d♂️", SourceUnknown, 15}, {"🙇\u200d♀️", SourceUnknown, 15}}},
+	{"thanksgiving", []WeightedEmoji{{"🦃", SourceUnknown, 15}}},
+	{"theater", []WeightedEmoji{{"🎭", SourceUnknown, 15}}},
+	{"thermometer", []WeightedEmoji{{"🌡️", SourceUnknown, 15}}},
+	{"thinking", []WeightedEmoji{{"🤔", SourceUnknown, 15}, {"💭", SourceUnknown, 15}}},
+	{"thinking face", []WeightedEmoji{{"🤔", SourceUnknown, 15}}},
+	{"thong sandal", []WeightedEmoji{{"🩴", SourceUnknown, 15}}},
+	{"thought balloon", []WeightedEmoji{{"💭", SourceUnknown, 15}}},
+	{"thread", []WeightedEmoji{{"🧵", SourceUnknown, 15}}},
+	{"three", []WeightedEmoji{{"3️⃣", SourceUnknown, 15}}},
+	{"three o’clock", []WeightedEmoji{{"🕒", SourceUnknown, 15}}},
+	{"three-thirty", []WeightedEmoji{{"🕞", SourceUnknown, 15}}},
+	{"thumbs down", []WeightedEmoji{{"👎", SourceUnknown, 15}}},
+	{"thumbs up", []WeightedEmoji{{"👍", SourceUnknown, 15}}},
+	{"thumbsdown", []WeightedEmoji{{"👎", SourceUnknown, 15}}},
+	{"thumbsup", []WeightedEmoji{{"👍", SourceUnknown, 15}}},
+	{"thunder", []WeightedEmoji{{"⚡", SourceUnknown, 15}}},
+	{"ticket", []WeightedEmoji{{"🎫", SourceUnknown, 15}}},
+	{"tickets", []WeightedEmoji{{"🎟️", SourceUnknown, 15}}},
+	{"tiger", []WeightedEmoji{{"🐅", SourceUnknown, 15}, {"🐯", SourceUnknown, 15}}},
+	{"tiger face", []WeightedEmoji{{"🐯", SourceUnknown, 15}}},
+	{"tiger2", []WeightedEmoji{{"🐅", SourceUnknown, 15}}},
+	{"time", []WeightedEmoji{{"⌛", SourceUnknown, 15}, {"⏳", SourceUnknown, 15}, {"⌚", SourceUnknown, 15}}},
+	{"timer clock", []WeightedEmoji{{"⏲️", SourceUnknown, 15}}},
+	{"timor leste", []WeightedEmoji{{"🇹🇱", SourceUnknown, 15}}},
+	{"tipping hand man", []WeightedEmoji{{"💁\u200d♂️", SourceUnknown, 15}}},
+	{"tipping hand person", []WeightedEmoji{{"💁", SourceUnknown, 15}}},
+	{"tipping hand woman", []WeightedEmoji{{"💁\u200d♀️", SourceUnknown, 15}}},
+	{"tired", []WeightedEmoji{{"😪", SourceUnknown, 15}, {"😩", SourceUnknown, 15}}},
+	{"tired face", []WeightedEmoji{{"😫", SourceUnknown, 15}}},
+	{"tm", []WeightedEmoji{{"™️", SourceUnknown, 15}}},
+	{"toast", []WeightedEmoji{{"🍞", SourceUnknown, 15}, {"🥂", SourceUnknown, 15}}},
+	{"togo", []WeightedEmoji{{"🇹🇬", SourceUnknown, 15}}},
+	{"toilet", []WeightedEmoji{{"🚽", SourceUnknown, 15}, {"🧻", SourceUnknown, 15}, {"🚻", SourceUnknown, 15}, {"🚾", SourceUnknown, 15}}},
+	{"tokelau", []WeightedEmoji{{"🇹🇰", SourceUnknown, 15}}},
+	{"tokyo tower", []WeightedEmoji{{"🗼", SourceUnknown, 15}}},
+	{"tomato", []WeightedEmoji{{"🍅", SourceUnknown, 15}}},
+	{"tonga", []WeightedEmoji{{"🇹🇴", SourceUnknown, 15}}},
+	{"tongue", []WeightedEmoji{{"👅", SourceUnknown, 15}, {"😋", SourceUnknown, 15}}},
+	{"tool", []WeightedEmoji{{"🔨", SourceUnknown, 15}, {"🔧", SourceUnknown, 15}}},
+	{"toolbox", []WeightedEmoji{{"🧰", SourceUnknown, 15}}},
+	{"tooth", []WeightedEmoji{{"🦷", SourceUnknown, 15}}},
+	{"toothbrush", []WeightedEmoji{{"🪥", SourceUnknown, 15}}},
+	{"top", []WeightedEmoji{{"🔝", SourceUnknown, 15}}},
+	{"top arrow", []WeightedEmoji{{"🔝", SourceUnknown, 15}}},
+	{"top hat", []WeightedEmoji{{"🎩", SourceUnknown, 15}}},
+	{"tophat", []WeightedEmoji{{"🎩", SourceUnknown, 15}}},
+	{"tornado", []WeightedEmoji{{"🌪️", SourceUnknown, 15}}},
+	{"tr", []WeightedEmoji{{"🇹🇷", SourceUnknown, 15}}},
+	{"trackball", []WeightedEmoji{{"🖲️", SourceUnknown, 15}}},
+	{"tracks", []WeightedEmoji{{"👣", SourceUnknown, 15}}},
+	{"tractor", []WeightedEmoji{{"🚜", SourceUnknown, 15}}},
+	{"trade mark", []WeightedEmoji{{"™️", SourceUnknown, 15}}},
+	{"trademark", []WeightedEmoji{{"™️", SourceUnknown, 15}}},
+	{"traffic light", []WeightedEmoji{{"🚥", SourceUnknown, 15}}},
+	{"train", []WeightedEmoji{{"🚋", SourceUnknown, 15}, {"🚆", SourceUnknown, 15}, {"🚂", SourceUnknown, 15}, {"🚄", SourceUnknown, 15}, {"🚅", SourceUnknown, 15}}},
+	{"train2", []WeightedEmoji{{"🚆", SourceUnknown, 15}}},
+	{"tram", []WeightedEmoji{{"🚊", SourceUnknown, 15}}},
+	{"tram car", []WeightedEmoji{{"🚋", SourceUnknown, 15}}},
+	{"transgender flag", []WeightedEmoji{{"🏳️\u200d⚧️", SourceUnknown, 15}}},
+	{"transgender symbol", []WeightedEmoji{{"⚧️", SourceUnknown, 15}}},
+	{"trash", []WeightedEmoji{{"🗑️", SourceUnknown, 15}}},
+	{"travel", []WeightedEmoji{{"🗺️", SourceUnknown, 15}}},
+	{"triangular flag", []WeightedEmoji{{"🚩", SourceUnknown, 15}}},
+	{"triangular flag on post", []WeightedEmoji{{"🚩", SourceUnknown, 15}}},
+	{"triangular ruler", []WeightedEmoji{{"📐", SourceUnknown, 15}}},
+	{"trident", []WeightedEmoji{{"🔱", SourceUnknown, 15}}},
+	{"trident emblem", []WeightedEmoji{{"🔱", SourceUnknown, 15}}},
+	{"trinidad tobago", []WeightedEmoji{{"🇹🇹", SourceUnknown, 15}}},
+	{"tristan da cunha", []WeightedEmoji{{"🇹🇦", SourceUnknown, 15}}},
+	{"triumph", []WeightedEmoji{{"😤", SourceUnknown, 15}}},
+	{"troll", []WeightedEmoji{{"🧌", SourceUnknown, 15}}},
+	{"trolleybus", []WeightedEmoji{{"🚎", SourceUnknown, 15}}},
+	{"trophy", []WeightedEmoji{{"🏆", SourceUnknown, 15}}},
+	{"tropical drink", []WeightedEmoji{{"🍹", SourceUnknown, 15}}},
+	{"tropical fish", []WeightedEmoji{{"🐠", SourceUnknown, 15}}},
+	{"truck", []WeightedEmoji{{"🚚", SourceUnknown, 15}}},
+	{"trumpet", []WeightedEmoji{{"🎺", SourceUnknown, 15}}},
+	{"tshirt", []WeightedEmoji{{"👕", SourceUnknown, 15}}},
+	{"tulip", []WeightedEmoji{{"🌷", SourceUnknown, 15}}},
+	{"tumbler glass", []WeightedEmoji{{"🥃", SourceUnknown, 15}}},
+	{"tunisia", []WeightedEmoji{{"🇹🇳", SourceUnknown, 15}}},
+	{"turkey", []WeightedEmoji{{"🦃", SourceUnknown, 15}, {"🇹🇷", SourceUnknown, 15}}},
+	{"turkmenistan", []WeightedEmoji{{"🇹🇲", SourceUnknown, 15}}},
+	{"turks caicos islands", []WeightedEmoji{{"🇹🇨", SourceUnknown, 15}}},
+	{"turtle", []WeightedEmoji{{"🐢", SourceUnknown, 15}}},
+	{"tuvalu", []WeightedEmoji{{"🇹🇻", SourceUnknown, 15}}},
+	{"tv", []WeightedEmoji{{"📺", SourceUnknown, 15}}},
+	{"twelve o’clock", []WeightedEmoji{{"🕛", SourceUnknown, 15}}},
+	{"twelve-thirty", []WeightedEmoji{{"🕧", SourceUnknown, 15}}},
+	{"twisted rightwards arrows", []WeightedEmoji{{"🔀", SourceUnknown, 15}}},
+	{"two", []WeightedEmoji{{"2️⃣", SourceUnknown, 15}}},
+	{"two hearts", []WeightedEmoji{{"💕", SourceUnknown, 15}}},
+	{"two men holding hands", []WeightedEmoji{{"👬", SourceUnknown, 15}}},
+	{"two o’clock", []WeightedEmoji{{"🕑", SourceUnknown, 15}}},
+	{"two women holding hands", []WeightedEmoji{{"👭", SourceUnknown, 15}}},
+	{"two-hump camel", []WeightedEmoji{{"🐫", SourceUnknown, 15}}},
+	{"two-thirty", []WeightedEmoji{{"🕝", SourceUnknown, 15}}},
+	{"u5272", []WeightedEmoji{{"🈹", SourceUnknown, 15}}},
+	{"u5408", []WeightedEmoji{{"🈴", SourceUnknown, 15}}},
+	{"u55b6", []WeightedEmoji{{"🈺", SourceUnknown, 15}}},
+	{"u6307", []WeightedEmoji{{"🈯", SourceUnknown, 15}}},
+	{"u6708", []WeightedEmoji{{"🈷️", SourceUnknown, 15}}},
+	{"u6709", []WeightedEmoji{{"🈶", SourceUnknown, 15}}},
+	{"u6e80", []WeightedEmoji{{"🈵", SourceUnknown, 15}}},
+	{"u7121", []WeightedEmoji{{"🈚", SourceUnknown, 15}}},
+	{"u7533", []WeightedEmoji{{"🈸", SourceUnknown, 15}}},
+	{"u7981", []WeightedEmoji{{"🈲", SourceUnknown, 15}}},
+	{"u7a7a", []WeightedEmoji{{"🈳", SourceUnknown, 15}}},
+	{"ufo", []WeightedEmoji{{"👽", SourceUnknown, 15}, {"🛸", SourceUnknown, 15}}},
+	{"uganda", []WeightedEmoji{{"🇺🇬", SourceUnknown, 15}}},
+	{"uk", []WeightedEmoji{{"🇬🇧", SourceUnknown, 15}}},
+	{"ukraine", []WeightedEmoji{{"🇺🇦", SourceUnknown, 15}}},
+	{"umbrella", []WeightedEmoji{{"☔", SourceUnknown, 15}, {"☂️", SourceUnknown, 15}}},
+	{"umbrella on ground", []WeightedEmoji{{"⛱️", SourceUnknown, 15}}},
+	{"umbrella with rain drops", []WeightedEmoji{{"☔", SourceUnknown, 15}}},
+	{"unamused", []WeightedEmoji{{"😒", SourceUnknown, 15}}},
+	{"unamused face", []WeightedEmoji{{"😒", SourceUnknown, 15}}},
+	{"underage", []WeightedEmoji{{"🔞", SourceUnknown, 15}}},
+	{"unicorn", []WeightedEmoji{{"🦄", SourceUnknown, 15}}},
+	{"united", []WeightedEmoji{{"🇺🇸", SourceUnknown, 15}}},
+	{"united arab emirates", []WeightedEmoji{{"🇦🇪", SourceUnknown, 15}}},
+	{"united nations", []WeightedEmoji{{"🇺🇳", SourceUnknown, 15}}},
+	{"university", []WeightedEmoji{{"🎓", SourceUnknown, 15}}},
+	{"unlock", []WeightedEmoji{{"🔓", SourceUnknown, 15}}},
+	{"unlocked", []WeightedEmoji{{"🔓", SourceUnknown, 15}}},
+	{"up", []WeightedEmoji{{"🆙", SourceUnknown, 15}}},
+	{"up arrow", []WeightedEmoji{{"⬆️", SourceUnknown, 15}}},
+	{"up! button", []WeightedEmoji{{"🆙", SourceUnknown, 15}}},
+	{"up-down arrow", []WeightedEmoji{{"↕️", SourceUnknown, 15}}},
+	{"up-left arrow", []WeightedEmoji{{"↖️", SourceUnknown, 15}}},
+	{"up-right arrow", []WeightedEmoji{{"↗️", SourceUnknown, 15}}},
+	{"upset", []WeightedEmoji{{"😫", SourceUnknown, 15}}},
+	{"upside down face", []WeightedEmoji{{"🙃", SourceUnknown, 15}}},
+	{"upside-down face", []WeightedEmoji{{"🙃", SourceUnknown, 15}}},
+	{"upwards button", []WeightedEmoji{{"🔼", SourceUnknown, 15}}},
+	{"uruguay", []WeightedEmoji{{"🇺🇾", SourceUnknown, 15}}},
+	{"us", []WeightedEmoji{{"🇺🇸", SourceUnknown, 15}}},
+	{"us outlying islands", []WeightedEmoji{{"🇺🇲", SourceUnknown, 15}}},
+	{"us virgin islands", []WeightedEmoji{{"🇻🇮", SourceUnknown, 15}}},
+	{"user", []WeightedEmoji{{"👤", SourceUnknown, 15}}},
+	{"users", []WeightedEmoji{{"👥", SourceUnknown, 15}}},
+	{"uzbekistan", []WeightedEmoji{{"🇺🇿", SourceUnknown, 15}}},
+	{"v", []WeightedEmoji{{"✌️", SourceUnknown, 15}}},
+	{"vacation", []WeightedEmoji{{"🍹", SourceUnknown, 15}}},
+	{"vampire", []WeightedEmoji{{"🧛", SourceUnknown, 15}}},
+	{"vampire man", []WeightedEmoji{{"🧛\u200d♂️", SourceUnknown, 15}}},
+	{"vampire woman", []WeightedEmoji{{"🧛\u200d♀️", SourceUnknown, 15}}},
+	{"vanuatu", []WeightedEmoji{{"🇻🇺", SourceUnknown, 15}}},
+	{"vatican city", []WeightedEmoji{{"🇻🇦", SourceUnknown, 15}}},
+	{"venezuela", []WeightedEmoji{{"🇻🇪", SourceUnknown, 15}}},
+	{"vertical traffic light", []WeightedEmoji{{"🚦", SourceUnknown, 15}}},
+	{"vhs", []WeightedEmoji{{"📼", SourceUnknown, 15}}},
+	{"vibration mode", []WeightedEmoji{{"📳", SourceUnknown, 15}}},
+	{"victory", []WeightedEmoji{{"✌️", SourceUnknown, 15}}},
+	{"victory hand", []WeightedEmoji{{"✌️", SourceUnknown, 15}}},
+	{"video", []WeightedEmoji{{"🎥", SourceUnknown, 15}}},
+	{"video camera", []WeightedEmoji{{"📹", SourceUnknown, 15}}},
+	{"video game", []WeightedEmoji{{"🎮", SourceUnknown, 15}}},
+	{"videocassette", []WeightedEmoji{{"📼", SourceUnknown, 15}}},
+	{"vietnam", []WeightedEmoji{{"🇻🇳", SourceUnknown, 15}}},
+	{"violin", []WeightedEmoji{{"🎻", SourceUnknown, 15}}},
+	{"virgo", []WeightedEmoji{{"♍", SourceUnknown, 15}}},
+	{"volcano", []WeightedEmoji{{"🌋", SourceUnknown, 15}}},
+	{"volleyball", []WeightedEmoji{{"🏐", SourceUnknown, 15}}},
+	{"volume", []WeightedEmoji{{"🔇", SourceUnknown, 15}, {"🔉", SourceUnknown, 15}, {"🔊", SourceUnknown, 15}, {"🔕", SourceUnknown, 15}}},
+	{"vomiting face", []WeightedEmoji{{"🤮", SourceUnknown, 15}}},
+	{"vs", []WeightedEmoji{{"🆚", SourceUnknown, 15}}},
+	{"vs button", []WeightedEmoji{{"🆚", SourceUnknown, 15}}},
+	{"vulcan salute", []WeightedEmoji{{"🖖", SourceUnknown, 15}}},
+	{"wacky", []WeightedEmoji{{"🤪", SourceUnknown, 15}}},
+	{"waffle", []WeightedEmoji{{"🧇", SourceUnknown, 15}}},
+	{"wales", []WeightedEmoji{{"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f", SourceUnknown, 15}}},
+	{"walking", []WeightedEmoji{{"🚶", SourceUnknown, 15}}},
+	{"walking man", []WeightedEmoji{{"🚶\u200d♂️", SourceUnknown, 15}}},
+	{"walking woman", []WeightedEmoji{{"🚶\u200d♀️", SourceUnknown, 15}}},
+	{"wallis futuna", []WeightedEmoji{{"🇼🇫", SourceUnknown, 15}}},
+	{"waning crescent moon", []WeightedEmoji{{"🌘", SourceUnknown, 15}}},
+	{"waning gibbous moon", []WeightedEmoji{{"🌖", SourceUnknown, 15}}},
+	{"warning", []WeightedEmoji{{"⚠️", SourceUnknown, 15}}},
+	{"wastebasket", []WeightedEmoji{{"🗑️", SourceUnknown, 15}}},
+	{"watch", []WeightedEmoji{{"⌚", SourceUnknown, 15}, {"👀", SourceUnknown, 15}}},
+	{"water", []WeightedEmoji{{"💦", SourceUnknown, 15}, {"💧", SourceUnknown, 15}}},
+	{"water buffalo", []WeightedEmoji{{"🐃", SourceUnknown, 15}}},
+	{"water closet", []WeightedEmoji{{"🚾", SourceUnknown, 15}}},
+	{"water pistol", []WeightedEmoji{{"🔫", SourceUnknown, 15}}},
+	{"water polo", []WeightedEmoji{{"🤽", SourceUnknown, 15}}},
+	{"water wave", []WeightedEmoji{{"🌊", SourceUnknown, 15}}},
+	{"watermelon", []WeightedEmoji{{"🍉", SourceUnknown, 15}}},
+	{"wave", []WeightedEmoji{{"👋", SourceUnknown, 15}}},
+	{"waving hand", []WeightedEmoji{{"👋", SourceUnknown, 15}}},
+	{"wavy dash", []WeightedEmoji{{"〰️", SourceUnknown, 15}}},
+	{"waxing crescent moon", []WeightedEmoji{{"🌒", SourceUnknown, 15}}},
+	{"waxing gibbous moon", []WeightedEmoji{{"🌔", SourceUnknown, 15}}},
+	{"wc", []WeightedEmoji{{"🚾", SourceUnknown, 15}, {"🚽", SourceUnknown, 15}}},
+	{"weapon", []WeightedEmoji{{"🔫", SourceUnknown, 15}}},
+	{"weary", []WeightedEmoji{{"😩", SourceUnknown, 15}}},
+	{"weary cat", []WeightedEmoji{{"🙀", SourceUnknown, 15}}},
+	{"weary face", []WeightedEmoji{{"😩", SourceUnknown, 15}}},
+	{"weather", []WeightedEmoji{{"☀️", SourceUnknown, 15}, {"⛅", SourceUnknown, 15}, {"🌂", SourceUnknown, 15}, {"☔", SourceUnknown, 15}, {"❄️", SourceUnknown, 15}}},
+	{"wedding", []WeightedEmoji{{"💒", SourceUnknown, 15}, {"🤵", SourceUnknown, 15}, {"👰", SourceUnknown, 15}, {"💍", SourceUnknown, 15}}},
+	{"weight lifting", []WeightedEmoji{{"🏋️", SourceUnknown, 15}}},
+	{"weight lifting man", []WeightedEmoji{{"🏋️\u200d♂️", SourceUnknown, 15}}},
+	{"weight lifting woman", []WeightedEmoji{{"🏋️\u200d♀️", SourceUnknown, 15}}},
+	{"western sahara", []WeightedEmoji{{"🇪🇭", SourceUnknown, 15}}},
+	{"whale", []WeightedEmoji{{"🐋", SourceUnknown, 15}, {"🐳", SourceUnknown, 15}}},
+	{"whale2", []WeightedEmoji{{"🐋", SourceUnknown, 15}}},
+	{"wheel", []WeightedEmoji{{"🛞", SourceUnknown, 15}}},
+	{"wheel of dharma", []WeightedEmoji{{"☸️", SourceUnknown, 15}}},
+	{"wheelchair", []WeightedEmoji{{"♿", SourceUnknown, 15}}},
+	{"wheelchair symbol", []WeightedEmoji{{"♿", SourceUnknown, 15}}},
+	{"whew", []WeightedEmoji{{"😌", SourceUnknown, 15}}},
+	{"whine", []WeightedEmoji{{"😫", SourceUnknown, 15}}},
+	{"whisky", []WeightedEmoji{{"🥃", SourceUnknown, 15}}},
+	{"white cane", []WeightedEmoji{{"🦯", SourceUnknown, 15}}},
+	{"white check mark", []WeightedEmoji{{"✅", SourceUnknown, 15}}},
+	{"white circle", []WeightedEmoji{{"⚪", SourceUnknown, 15}}},
+	{"white exclamation mark", []WeightedEmoji{{"❕", SourceUnknown, 15}}},
+	{"white flag", []WeightedEmoji{{"🏳️", SourceUnknown, 15}}},
+	{"white flower", []WeightedEmoji{{"💮", SourceUnknown, 15}}},
+	{"white haired man", []WeightedEmoji{{"👨\u200d🦳", SourceUnknown, 15}}},
+	{"white haired woman", []WeightedEmoji{{"👩\u200d🦳", SourceUnknown, 15}}},
+	{"white heart", []WeightedEmoji{{"🤍", SourceUnknown, 15}}},
+	{"white large square", []WeightedEmoji{{"⬜", SourceUnknown, 15}}},
+	{"white medium small square", []WeightedEmoji{{"◽", SourceUnknown, 15}}},
+	{"white medium square", []WeightedEmoji{{"◻️", SourceUnknown, 15}}},
+	{"white medium-small square", []WeightedEmoji{{"◽", SourceUnknown, 15}}},
+	{"white question mark", []WeightedEmoji{{"❔", SourceUnknown, 15}}},
+	{"white small square", []WeightedEmoji{{"▫️", SourceUnknown, 15}}},
+	{"white square button", []WeightedEmoji{{"🔳", SourceUnknown, 15}}},
+	{"whoops", []WeightedEmoji{{"🤭", SourceUnknown, 15}}},
+	{"wifi", []WeightedEmoji{{"📶", SourceUnknown, 15}, {"🛜", SourceUnknown, 15}}},
+	{"wilted flower", []WeightedEmoji{{"🥀", SourceUnknown, 15}}},
+	{"wind", []WeightedEmoji{{"💨", SourceUnknown, 15}}},
+	{"wind chime", []WeightedEmoji{{"🎐", SourceUnknown, 15}}},
+	{"wind face", []WeightedEmoji{{"🌬️", SourceUnknown, 15}}},
+	{"window", []WeightedEmoji{{"🪟", SourceUnknown, 15}}},
+	{"wine glass", []WeightedEmoji{{"🍷", SourceUnknown, 15}}},
+	{"wing", []WeightedEmoji{{"🪽", SourceUnknown, 15}}},
+	{"wink", []WeightedEmoji{{"😉", SourceUnknown, 15}}},
+	{"winking face", []WeightedEmoji{{"😉", SourceUnknown, 15}}},
+	{"winking face with tongue", []WeightedEmoji{{"😜", SourceUnknown, 15}}},
+	{"winner", []WeightedEmoji{{"🏆", SourceUnknown, 15}, {"🏅", SourceUnknown, 15}}},
+	{"winter", []WeightedEmoji{{"❄️", SourceUnknown, 15}, {"☃️", SourceUnknown, 15}, {"⛄", SourceUnknown, 15}}},
+	{"wip", []WeightedEmoji{{"🚧", SourceUnknown, 15}, {"⚠️", SourceUnknown, 15}}},
+	{"wireless", []WeightedEmoji{{"🛜", SourceUnknown, 15}}},
+	{"wish", []WeightedEmoji{{"🙏", SourceUnknown, 15}}},
+	{"wizard", []WeightedEmoji{{"🧙", SourceUnknown, 15}, {"🧙\u200d♂️", SourceUnknown, 15}, {"🧙\u200d♀️", SourceUnknown, 15}}},
+	{"wolf", []WeightedEmoji{{"🐺", SourceUnknown, 15}}},
+	{"woman", []WeightedEmoji{{"👩", SourceUnknown, 15}}},
+	{"woman and man holding hands", []WeightedEmoji{{"👫", SourceUnknown, 15}}},
+	{"woman artist", []WeightedEmoji{{"👩\u200d🎨", SourceUnknown, 15}}},
+	{"woman astronaut", []WeightedEmoji{{"👩\u200d🚀", SourceUnknown, 15}}},
+	{"woman beard", []WeightedEmoji{{"🧔\u200d♀️", SourceUnknown, 15}}},
+	{"woman biking", []WeightedEmoji{{"🚴\u200d♀️", SourceUnknown, 15}}},
+	{"woman bouncing ball", []WeightedEmoji{{"⛹️\u200d♀️", SourceUnknown, 15}}},
+	{"woman bowing", []WeightedEmoji{{"🙇\u200d♀️", SourceUnknown, 15}}},
+	{"woman cartwheeling", []WeightedEmoji{{"🤸\u200d♀️", SourceUnknown, 15}}},
+	{"woman climbing", []WeightedEmoji{{"🧗\u200d♀️", SourceUnknown, 15}}},
+	{"woman construction worker", []WeightedEmoji{{"👷\u200d♀️", SourceUnknown, 15}}},
+	{"woman cook", []WeightedEmoji{{"👩\u200d🍳", SourceUnknown, 15}}},
+	{"woman dancing", []WeightedEmoji{{"💃", SourceUnknown, 15}}},
+	{"woman detective", []WeightedEmoji{{"🕵️\u200d♀️", SourceUnknown, 15}}},
+	{"woman elf", []WeightedEmoji{{"🧝\u200d♀️", SourceUnknown, 15}}},
+	{"woman facepalming", []WeightedEmoji{{"🤦\u200d♀️", SourceUnknown, 15}}},
+	{"woman factory worker", []WeightedEmoji{{"👩\u200d🏭", SourceUnknown, 15}}},
+	{"woman fairy", []WeightedEmoji{{"🧚\u200d♀️", SourceUnknown, 15}}},
+	{"woman farmer", []WeightedEmoji{{"👩\u200d🌾", SourceUnknown, 15}}},
+	{"woman feeding baby", []WeightedEmoji{{"👩\u200d🍼", SourceUnknown, 15}}},
+	{"woman firefighter", []WeightedEmoji{{"👩\u200d🚒", SourceUnknown, 15}}},
+	{"woman frowning", []WeightedEmoji{{"🙍\u200d♀️", SourceUnknown, 15}}},
+	{"woman genie", []WeightedEmoji{{"🧞\u200d♀️", SourceUnknown, 15}}},
+	{"woman gesturing no", []WeightedEmoji{{"🙅\u200d♀️", SourceUnknown, 15}}},
+	{"woman gesturing ok", []WeightedEmoji{{"🙆\u200d♀️", SourceUnknown, 15}}},
+	{"woman getting haircut", []WeightedEmoji{{"💇\u200d♀️", SourceUnknown, 15}}},
+	{"woman getting massage", []WeightedEmoji{{"💆\u200d♀️", SourceUnknown, 15}}},
+	{"woman golfing", []WeightedEmoji{{"🏌️\u200d♀️", SourceUnknown, 15}}},
+	{"woman guard", []WeightedEmoji{{"💂\u200d♀️", SourceUnknown, 15}}},
+	{"woman health worker", []WeightedEmoji{{"👩\u200d⚕️", SourceUnknown, 15}}},
+	{"woman in lotus position", []WeightedEmoji{{"🧘\u200d♀️", SourceUnknown, 15}}},
+	{"woman in manual wheelchair", []WeightedEmoji{{"👩\u200d🦽", SourceUnknown, 15}}},
+	{"woman in motorized wheelchair", []WeightedEmoji{{"👩\u200d🦼", SourceUnknown, 15}}},
+	{"woman in steamy room", []WeightedEmoji{{"🧖\u200d♀️", SourceUnknown, 15}}},
+	{"woman in tuxedo", []WeightedEmoji{{"🤵\u200d♀️", SourceUnknown, 15}}},
+	{"woman judge", []WeightedEmoji{{"👩\u200d⚖️", SourceUnknown, 15}}},
+	{"woman juggling", []WeightedEmoji{{"🤹\u200d♀️", SourceUnknown, 15}}},
+	{"woman kneeling", []WeightedEmoji{{"🧎\u200d♀️", SourceUnknown, 15}}},
+	{"woman lifting weights", []WeightedEmoji{{"🏋️\u200d♀️", SourceUnknown, 15}}},
+	{"woman mage", []WeightedEmoji{{"🧙\u200d♀️", SourceUnknown, 15}}},
+	{"woman mechanic", []WeightedEmoji{{"👩\u200d🔧", SourceUnknown, 15}}},
+	{"woman mountain biking", []WeightedEmoji{{"🚵\u200d♀️", SourceUnknown, 15}}},
+	{"woman office worker", []WeightedEmoji{{"👩\u200d💼", SourceUnknown, 15}}},
+	{"woman pilot", []WeightedEmoji{{"👩\u200d✈️", SourceUnknown, 15}}},
+	{"woman playing handball", []WeightedEmoji{{"🤾\u200d♀️", SourceUnknown, 15}}},
+	{"woman playing water polo", []WeightedEmoji{{"🤽\u200d♀️", SourceUnknown, 15}}},
+	{"woman police officer", []WeightedEmoji{{"👮\u200d♀️", SourceUnknown, 15}}},
+	{"woman pouting", []WeightedEmoji{{"🙎\u200d♀️", SourceUnknown, 15}}},
+	{"woman raising hand", []WeightedEmoji{{"🙋\u200d♀️", SourceUnknown, 15}}},
+	{"woman rowing boat", []WeightedEmoji{{"🚣\u200d♀️", SourceUnknown, 15}}},
+	{"woman running", []WeightedEmoji{{"🏃\u200d♀️", SourceUnknown, 15}}},
+	{"woman scientist", []WeightedEmoji{{"👩\u200d🔬", SourceUnknown, 15}}},
+	{"woman shrugging", []WeightedEmoji{{"🤷\u200d♀️", SourceUnknown, 15}}},
+	{"woman singer", []WeightedEmoji{{"👩\u200d🎤", SourceUnknown, 15}}},
+	{"woman standing", []WeightedEmoji{{"🧍\u200d♀️", SourceUnknown, 15}}},
+	{"woman student", []WeightedEmoji{{"👩\u200d🎓", SourceUnknown, 15}}},
+	{"woman superhero", []WeightedEmoji{{"🦸\u200d♀️", SourceUnknown, 15}}},
+	{"woman supervillain", []WeightedEmoji{{"🦹\u200d♀️", SourceUnknown, 15}}},
+	{"woman surfing", []WeightedEmoji{{"🏄\u200d♀️", SourceUnknown, 15}}},
+	{"woman swimming", []WeightedEmoji{{"🏊\u200d♀️", SourceUnknown, 15}}},
+	{"woman teacher", []WeightedEmoji{{"👩\u200d🏫", SourceUnknown, 15}}},
+	{"woman technologist", []WeightedEmoji{{"👩\u200d💻", SourceUnknown, 15}}},
+	{"woman tipping hand", []WeightedEmoji{{"💁\u200d♀️", SourceUnknown, 15}}},
+	{"woman vampire", []WeightedEmoji{{"🧛\u200d♀️", SourceUnknown, 15}}},
+	{"woman walking", []WeightedEmoji{{"🚶\u200d♀️", SourceUnknown, 15}}},
+	{"woman wearing turban", []WeightedEmoji{{"👳\u200d♀️", SourceUnknown, 15}}},
+	{"woman with headscarf", []WeightedEmoji{{"🧕", SourceUnknown, 15}}},
+	{"woman with probing cane", []WeightedEmoji{{"👩\u200d🦯", SourceUnknown, 15}}},
+	{"woman with turban", []WeightedEmoji{{"👳\u200d♀️", SourceUnknown, 15}}},
+	{"woman with veil", []WeightedEmoji{{"👰\u200d♀️", SourceUnknown, 15}}},
+	{"woman with white cane", []WeightedEmoji{{"👩\u200d🦯", SourceUnknown, 15}}},
+	{"woman zombie", []WeightedEmoji{{"🧟\u200d♀️", SourceUnknown, 15}}},
+	{"woman: bald", []WeightedEmoji{{"👩\u200d🦲", SourceUnknown, 15}}},
+	{"woman: beard", []WeightedEmoji{{"🧔\u200d♀️", SourceUnknown, 15}}},
+	{"woman: blond hair", []WeightedEmoji{{"👱\u200d♀️", SourceUnknown, 15}}},
+	{"woman: curly hair", []WeightedEmoji{{"👩\u200d🦱", SourceUnknown, 15}}},
+	{"woman: red hair", []WeightedEmoji{{"👩\u200d🦰", SourceUnknown, 15}}},
+	{"woman: white hair", []WeightedEmoji{{"👩\u200d🦳", SourceUnknown, 15}}},
+	{"womans clothes", []WeightedEmoji{{"👚", SourceUnknown, 15}}},
+	{"womans hat", []WeightedEmoji{{"👒", SourceUnknown, 15}}},
+	{"woman’s boot", []WeightedEmoji{{"👢", SourceUnknown, 15}}},
+	{"woman’s clothes", []WeightedEmoji{{"👚", SourceUnknown, 15}}},
+	{"woman’s hat", []WeightedEmoji{{"👒", SourceUnknown, 15}}},
+	{"woman’s sandal", []WeightedEmoji{{"👡", SourceUnknown, 15}}},
+	{"women holding hands", []WeightedEmoji{{"👭", SourceUnknown, 15}}},
+	{"women with bunny ears", []WeightedEmoji{{"👯\u200d♀️", SourceUnknown, 15}}},
+	{"women wrestling", []WeightedEmoji{{"🤼\u200d♀️", SourceUnknown, 15}}},
+	{"womens", []WeightedEmoji{{"🚺", SourceUnknown, 15}}},
+	{"women’s room", []WeightedEmoji{{"🚺", SourceUnknown, 15}}},
+	{"wood", []WeightedEmoji{{"🪵", SourceUnknown, 15}, {"🌲", SourceUnknown, 15}, {"🌳", SourceUnknown, 15}}},
+	{"woozy face", []WeightedEmoji{{"🥴", SourceUnknown, 15}}},
+	{"workout", []WeightedEmoji{{"💦", SourceUnknown, 15}, {"💪", SourceUnknown, 15}, {"🏃", SourceUnknown, 15}, {"🏃\u200d♂️", SourceUnknown, 15}, {"🏃\u200d♀️", SourceUnknown, 15}, {"🏋️", SourceUnknown, 15}, {"🏋️\u200d♂️", SourceUnknown, 15}, {"🏋️\u200d♀️", SourceUnknown, 15}}},
+	{"world", []WeightedEmoji{{"🌍", SourceUnknown, 15}, {"🌎", SourceUnknown, 15}, {"🌏", SourceUnknown, 15}, {"🌐", SourceUnknown, 15}}},
+	{"world map", []WeightedEmoji{{"🗺️", SourceUnknown, 15}}},
+	{"worm", []WeightedEmoji{{"🪱", SourceUnknown, 15}}},
+	{"worried", []WeightedEmoji{{"😟", SourceUnknown, 15}}},
+	{"worried face", []WeightedEmoji{{"😟", SourceUnknown, 15}}},
+	{"wow", []WeightedEmoji{{"😮", SourceUnknown, 15}}},
+	{"wrapped gift", []WeightedEmoji{{"🎁", SourceUnknown, 15}}},
+	{"wrench", []WeightedEmoji{{"🔧", SourceUnknown, 15}}},
+	{"wrestling", []WeightedEmoji{{"🤼", SourceUnknown, 15}}},
+	{"writing hand", []WeightedEmoji{{"✍️", SourceUnknown, 15}}},
+	{"x", []WeightedEmoji{{"❌", SourceUnknown, 15}}},
+	{"x ray", []WeightedEmoji{{"🩻", SourceUnknown, 15}}},
+	{"x-ray", []WeightedEmoji{{"🩻", SourceUnknown, 15}}},
+	{"yarn", []WeightedEmoji{{"🧶", SourceUnknown, 15}}},
+	{"yawning face", []WeightedEmoji{{"🥱", SourceUnknown, 15}}},
+	{"yellow circle", []WeightedEmoji{{"🟡", SourceUnknown, 15}}},
+	{"yellow heart", []WeightedEmoji{{"💛", SourceUnknown, 15}}},
+	{"yellow square", []WeightedEmoji{{"🟨", SourceUnknown, 15}}},
+	{"yemen", []WeightedEmoji{{"🇾🇪", SourceUnknown, 15}}},
+	{"yen", []WeightedEmoji{{"💴", SourceUnknown, 15}}},
+	{"yen banknote", []WeightedEmoji{{"💴", SourceUnknown, 15}}},
+	{"yes", []WeightedEmoji{{"🆗", SourceUnknown, 15}}},
+	{"yin yang", []WeightedEmoji{{"☯️", SourceUnknown, 15}}},
+	{"yo yo", []WeightedEmoji{{"🪀", SourceUnknown, 15}}},
+	{"yo-yo", []WeightedEmoji{{"🪀", SourceUnknown, 15}}},
+	{"yum", []WeightedEmoji{{"😋", SourceUnknown, 15}}},
+	{"zambia", []WeightedEmoji{{"🇿🇲", SourceUnknown, 15}}},
+	{"zany face", []WeightedEmoji{{"🤪", SourceUnknown, 15}}},
+	{"zap", []WeightedEmoji{{"⚡", SourceUnknown, 15}}},
+	{"zebra", []WeightedEmoji{{"🦓", SourceUnknown, 15}}},
+	{"zero", []WeightedEmoji{{"0️⃣", SourceUnknown, 15}}},
+	{"zimbabwe", []WeightedEmoji{{"🇿🇼", SourceUnknown, 15}}},
+	{"zipper mouth face", []WeightedEmoji{{"🤐", SourceUnknown, 15}}},
+	{"zipper-mouth face", []WeightedEmoji{{"🤐", SourceUnknown, 15}}},
+	{"zombie", []WeightedEmoji{{"🧟", SourceUnknown, 15}}},
+	{"zombie man", []WeightedEmoji{{"🧟\u200d♂️", SourceUnknown, 15}}},
+	{"zombie woman", []WeightedEmoji{{"🧟\u200d♀️", SourceUnknown, 15}}},
+	{"zoom", []WeightedEmoji{{"🔍", SourceUnknown, 15}}},
+	{"zzz", []WeightedEmoji{{"💤", SourceUnknown, 15}, {"😴", SourceUnknown, 15}}},
+}
+
+// defaultEmojis contains every emoji of defaultEntries, sorted.
+var defaultEmojis = []string{
+	"#️⃣",
+	"*️⃣",
+	"0️⃣",
+	"1️⃣",
+	"2️⃣",
+	"3️⃣",
+	"4️⃣",
+	"5️⃣",
+	"6️⃣",
+	"7️⃣",
+	"8️⃣",
+	"9️⃣",
+	"©️",
+	"®️",
+	"‼️",
+	"⁉️",
+	"™️",
+	"ℹ️",
+	"↔️",
+	"↕️",
+	"↖️",
+	"↗️",
+	"↘️",
+	"↙️",
+	"↩️",
+	"↪️",
+	"⌚",
+	"⌛",
+	"⌨️",
+	"⏏️",
+	"⏩",
+	"⏪",
+	"⏫",
+	"⏬",
+	"⏭️",
+	"⏮️",
+	"⏯️",
+	"⏰",
+	"⏱️",
+	"⏲️",
+	"⏳",
+	"⏸️",
+	"⏹️",
+	"⏺️",
+	"Ⓜ️",
+	"▪️",
+	"▫️",
+	"▶️",
+	"◀️",
+	"◻️",
+	"◼️",
+	"◽",
+	"◾",
+	"☀️",
+	"☁️",
+	"☂️",
+	"☃️",
+	"☄️",
+	"☎️",
+	"☑️",
+	"☔",
+	"☕",
+	"☘️",
+	"☝️",
+	"☠️",
+	"☢️",
+	"☣️",
+	"☦️",
+	"☪️",
+	"☮️",
+	"☯️",
+	"☸️",
+	"☹️",
+	"☺️",
+	"♀️",
+	"♂️",
+	"♈",
+	"♉",
+	"♊",
+	"♋",
+	"♌",
+	"♍",
+	"♎",
+	"♏",
+	"♐",
+	"♑",
+	"♒",
+	"♓",
+	"♟️",
+	"♠️",
+	"♣️",
+	"♥️",
+	"♦️",
+	"♨️",
+	"♻️",
+	"♾️",
+	"♿",
+	"⚒️",
+	"⚓",
+	"⚔️",
+	"⚕️",
+	"⚖️",
+	"⚗️",
+	"⚙️",
+	"⚛️",
+	"⚜️",
+	"⚠️",
+	"⚡",
+	"⚧️",
+	"⚪",
+	"⚫",
+	"⚰️",
+	"⚱️",
+	"⚽",
+	"⚾",
+	"⛄",
+	"⛅",
+	"⛈️",
+	"⛎",
+	"⛏️",
+	"⛑️",
+	"⛓️",
+	"⛔",
+	"⛩️",
+	"⛪",
+	"⛰️",
+	"⛱️",
+	"⛲",
+	"⛳",
+	"⛴️",
+	"⛵",
+	"⛷️",
+	"⛸️",
+	"⛹️",
+	"⛹️\u200d♀️",
+	"⛹️\u200d♂️",
+	"⛺",
+	"⛽",
+	"✂️",
+	"✅",
+	"✈️",
+	"✉️",
+	"✊",
+	"✋",
+	"✌️",
+	"✍️",
+	"✏️",
+	"✒️",
+	"✔️",
+	"✖️",
+	"✝️",
+	"✡️",
+	"✨",
+	"✳️",
+	"✴️",
+	"❄️",
+	"❇️",
+	"❌",
+	"❎",
+	"❓",
+	"❔",
+	"❕",
+	"❗",
+	"❣️",
+	"❤️",
+	"❤️\u200d🔥",
+	"❤️\u200d🩹",
+	"➕",
+	"➖",
+	"➗",
+	"➡️",
+	"➰",
+	"➿",
+	"⤴️",
+	"⤵️",
+	"⬅️",
+	"⬆️",
+	"⬇️",
+	"⬛",
+	"⬜",
+	"⭐",
+	"⭕",
+	"〰️",
+	"〽️",
+	"㊗️",
+	"㊙️",
+	"🀄",
+	"🃏",
+	"🅰️",
+	"🅱️",
+	"🅾️",
+	"🅿️",
+	"🆎",
+	"🆑",
+	"🆒",
+	"🆓",
+	"🆔",
+	"🆕",
+	"🆖",
+	"🆗",
+	"🆘",
+	"🆙",
+	"🆚",
+	"🇦🇨",
+	"🇦🇩",
+	"🇦🇪",
+	"🇦🇫",
+	"🇦🇬",
+	"🇦🇮",
+	"🇦🇱",
+	"🇦🇲",
+	"🇦🇴",
+	"🇦🇶",
+	"🇦🇷",
+	"🇦🇸",
+	"🇦🇹",
+	"🇦🇺",
+	"🇦🇼",
+	"🇦🇽",
+	"🇦🇿",
+	"🇧🇦",
+	"🇧🇧",
+	"🇧🇩",
+	"🇧🇪",
+	"🇧🇫",
+	"🇧🇬",
+	"🇧🇭",
+	"🇧🇮",
+	"🇧🇯",
+	"🇧🇱",
+	"🇧🇲",
+	"🇧🇳",
+	"🇧🇴",
+	"🇧🇶",
+	"🇧🇷",
+	"🇧🇸",
+	"🇧🇹",
+	"🇧🇻",
+	"🇧🇼",
+	"🇧🇾",
+	"🇧🇿",
+	"🇨🇦",
+	"🇨🇨",
+	"🇨🇩",
+	"🇨🇫",
+	"🇨🇬",
+	"🇨🇭",
+	"🇨🇮",
+	"🇨🇰",
+	"🇨🇱",
+	"🇨🇲",
+	"🇨🇳",
+	"🇨🇴",
+	"🇨🇵",
+	"🇨🇷",
+	"🇨🇺",
+	"🇨🇻",
+	"🇨🇼",
+	"🇨🇽",
+	"🇨🇾",
+	"🇨🇿",
+	"🇩🇪",
+	"🇩🇬",
+	"🇩🇯",
+	"🇩🇰",
+	"🇩🇲",
+	"🇩🇴",
+	"🇩🇿",
+	"🇪🇦",
+	"🇪🇨",
+	"🇪🇪",
+	"🇪🇬",
+	"🇪🇭",
+	"🇪🇷",
+	"🇪🇸",
+	"🇪🇹",
+	"🇪🇺",
+	"🇫🇮",
+	"🇫🇯",
+	"🇫🇰",
+	"🇫🇲",
+	"🇫🇴",
+	"🇫🇷",
+	"🇬🇦",
+	"🇬🇧",
+	"🇬🇩",
+	"🇬🇪",
+	"🇬🇫",
+	"🇬🇬",
+	"🇬🇭",
+	"🇬🇮",
+	"🇬🇱",
+	"🇬🇲",
+	"🇬🇳",
+	"🇬🇵",
+	"🇬🇶",
+	"🇬🇷",
+	"🇬🇸",
+	"🇬🇹",
+	"🇬🇺",
+	"🇬🇼",
+	"🇬🇾",
+	"🇭🇰",
+	"🇭🇲",
+	"🇭🇳",
+	"🇭🇷",
+	"🇭🇹",
+	"🇭🇺",
+	"🇮🇨",
+	"🇮🇩",
+	"🇮🇪",
+	"🇮🇱",
+	"🇮🇲",
+	"🇮🇳",
+	"🇮🇴",
+	"🇮🇶",
+	"🇮🇷",
+	"🇮🇸",
+	"🇮🇹",
+	"🇯🇪",
+	"🇯🇲",
+	"🇯🇴",
+	"🇯🇵",
+	"🇰🇪",
+	"🇰🇬",
+	"🇰🇭",
+	"🇰🇮",
+	"🇰🇲",
+	"🇰🇳",
+	"🇰🇵",
+	"🇰🇷",
+	"🇰🇼",
+	"🇰🇾",
+	"🇰🇿",
+	"🇱🇦",
+	"🇱🇧",
+	"🇱🇨",
+	"🇱🇮",
+	"🇱🇰",
+	"🇱🇷",
+	"🇱🇸",
+	"🇱🇹",
+	"🇱🇺",
+	"🇱🇻",
+	"🇱🇾",
+	"🇲🇦",
+	"🇲🇨",
+	"🇲🇩",
+	"🇲🇪",
+	"🇲🇫",
+	"🇲🇬",
+	"🇲🇭",
+	"🇲🇰",
+	"🇲🇱",
+	"🇲🇲",
+	"🇲🇳",
+	"🇲🇴",
+	"🇲🇵",
+	"🇲🇶",
+	"🇲🇷",
+	"🇲🇸",
+	"🇲🇹",
+	"🇲🇺",
+	"🇲🇻",
+	"🇲🇼",
+	"🇲🇽",
+	"🇲🇾",
+	"🇲🇿",
+	"🇳🇦",
+	"🇳🇨",
+	"🇳🇪",
+	"🇳🇫",
+	"🇳🇬",
+	"🇳🇮",
+	"🇳🇱",
+	"🇳🇴",
+	"🇳🇵",
+	"🇳🇷",
+	"🇳🇺",
+	"🇳🇿",
+	"🇴🇲",
+	"🇵🇦",
+	"🇵🇪",
+	"🇵🇫",
+	"🇵🇬",
+	"🇵🇭",
+	"🇵🇰",
+	"🇵🇱",
+	"🇵🇲",
+	"🇵🇳",
+	"🇵🇷",
+	"🇵🇸",
+	"🇵🇹",
+	"🇵🇼",
+	"🇵🇾",
+	"🇶🇦",
+	"🇷🇪",
+	"🇷🇴",
+	"🇷🇸",
+	"🇷🇺",
+	"🇷🇼",
+	"🇸🇦",
+	"🇸🇧",
+	"🇸🇨",
+	"🇸🇩",
+	"🇸🇪",
+	"🇸🇬",
+	"🇸🇭",
+	"🇸🇮",
+	"🇸🇯",
+	"🇸🇰",
+	"🇸🇱",
+	"🇸🇲",
+	"🇸🇳",
+	"🇸🇴",
+	"🇸🇷",
+	"🇸🇸",
+	"🇸🇹",
+	"🇸🇻",
+	"🇸🇽",
+	"🇸🇾",
+	"🇸🇿",
+	"🇹🇦",
+	"🇹🇨",
+	"🇹🇩",
+	"🇹🇫",
+	"🇹🇬",
+	"🇹🇭",
+	"🇹🇯",
+	"🇹🇰",
+	"🇹🇱",
+	"🇹🇲",
+	"🇹🇳",
+	"🇹🇴",
+	"🇹🇷",
+	"🇹🇹",
+	"🇹🇻",
+	"🇹🇼",
+	"🇹🇿",
+	"🇺🇦",
+	"🇺🇬",
+	"🇺🇲",
+	"🇺🇳",
+	"🇺🇸",
+	"🇺🇾",
+	"🇺🇿",
+	"🇻🇦",
+	"🇻🇨",
+	"🇻🇪",
+	"🇻🇬",
+	"🇻🇮",
+	"🇻🇳",
+	"🇻🇺",
+	"🇼🇫",
+	"🇼🇸",
+	"🇽🇰",
+	"🇾🇪",
+	"🇾🇹",
+	"🇿🇦",
+	"🇿🇲",
+	"🇿🇼",
+	"🈁",
+	"🈂️",
+	"🈚",
+	"🈯",
+	"🈲",
+	"🈳",
+	"🈴",
+	"🈵",
+	"🈶",
+	"🈷️",
+	"🈸",
+	"🈹",
+	"🈺",
+	"🉐",
+	"🉑",
+	"🌀",
+	"🌁",
+	"🌂",
+	"🌃",
+	"🌄",
+	"🌅",
+	"🌆",
+	"🌇",
+	"🌈",
+	"🌉",
+	"🌊",
+	"🌋",
+	"🌌",
+	"🌍",
+	"🌎",
+	"🌏",
+	"🌐",
+	"🌑",
+	"🌒",
+	"🌓",
+	"🌔",
+	"🌕",
+	"🌖",
+	"🌗",
+	"🌘",
+	"🌙",
+	"🌚",
+	"🌛",
+	"🌜",
+	"🌝",
+	"🌞",
+	"🌟",
+	"🌠",
+	"🌡️",
+	"🌤️",
+	"🌥️",
+	"🌦️",
+	"🌧️",
+	"🌨️",
+	"🌩️",
+	"🌪️",
+	"🌫️",
+	"🌬️",
+	"🌭",
+	"🌮",
+	"🌯",
+	"🌰",
+	"🌱",
+	"🌲",
+	"🌳",
+	"🌴",
+	"🌵",
+	"🌶️",
+	"🌷",
+	"🌸",
+	"🌹",
+	"🌺",
+	"🌻",
+	"🌼",
+	"🌽",
+	"🌾",
+	"🌿",
+	"🍀",
+	"🍁",
+	"🍂",
+	"🍃",
+	"🍄",
+	"🍅",
+	"🍆",
+	"🍇",
+	"🍈",
+	"🍉",
+	"🍊",
+	"🍋",
+	"🍌",
+	"🍍",
+	"🍎",
+	"🍏",
+	"🍐",
+	"🍑",
+	"🍒",
+	"🍓",
+	"🍔",
+	"🍕",
+	"🍖",
+	"🍗",
+	"🍘",
+	"🍙",
+	"🍚",
+	"🍛",
+	"🍜",
+	"🍝",
+	"🍞",
+	"🍟",
+	"🍠",
+	"🍡",
+	"🍢",
+	"🍣",
+	"🍤",
+	"🍥",
+	"🍦",
+	"🍧",
+	"🍨",
+	"🍩",
+	"🍪",
+	"🍫",
+	"🍬",
+	"🍭",
+	"🍮",
+	"🍯",
+	"🍰",
+	"🍱",
+	"🍲",
+	"🍳",
+	"🍴",
+	"🍵",
+	"🍶",
+	"🍷",
+	"🍸",
+	"🍹",
+	"🍺",
+	"🍻",
+	"🍼",
+	"🍽️",
+	"🍾",
+	"🍿",
+	"🎀",
+	"🎁",
+	"🎂",
+	"🎃",
+	"🎄",
+	"🎅",
+	"🎆",
+	"🎇",
+	"🎈",
+	"🎉",
+	"🎊",
+	"🎋",
+	"🎌",
+	"🎍",
+	"🎎",
+	"🎏",
+	"🎐",
+	"🎑",
+	"🎒",
+	"🎓",
+	"🎖️",
+	"🎗️",
+	"🎙️",
+	"🎚️",
+	"🎛️",
+	"🎞️",
+	"🎟️",
+	"🎠",
+	"🎡",
+	"🎢",
+	"🎣",
+	"🎤",
+	"🎥",
+	"🎦",
+	"🎧",
+	"🎨",
+	"🎩",
+	"🎪",
+	"🎫",
+	"🎬",
+	"🎭",
+	"🎮",
+	"🎯",
+	"🎰",
+	"🎱",
+	"🎲",
+	"🎳",
+	"🎴",
+	"🎵",
+	"🎶",
+	"🎷",
+	"🎸",
+	"🎹",
+	"🎺",
+	"🎻",
+	"🎼",
+	"🎽",
+	"🎾",
+	"🎿",
+	"🏀",
+	"🏁",
+	"🏂",
+	"🏃",
+	"🏃\u200d♀️",
+	"🏃\u200d♂️",
+	"🏄",
+	"🏄\u200d♀️",
+	"🏄\u200d♂️",
+	"🏅",
+	"🏆",
+	"🏇",
+	"🏈",
+	"🏉",
+	"🏊",
+	"🏊\u200d♀️",
+	"🏊\u200d♂️",
+	"🏋️",
+	"🏋️\u200d♀️",
+	"🏋️\u200d♂️",
+	"🏌️",
+	"🏌️\u200d♀️",
+	"🏌️\u200d♂️",
+	"🏍️",
+	"🏎️",
+	"🏏",
+	"🏐",
+	"🏑",
+	"🏒",
+	"🏓",
+	"🏔️",
+	"🏕️",
+	"🏖️",
+	"🏗️",
+	"🏘️",
+	"🏙️",
+	"🏚️",
+	"🏛️",
+	"🏜️",
+	"🏝️",
+	"🏞️",
+	"🏟️",
+	"🏠",
+	"🏡",
+	"🏢",
+	"🏣",
+	"🏤",
+	"🏥",
+	"🏦",
+	"🏧",
+	"🏨",
+	"🏩",
+	"🏪",
+	"🏫",
+	"🏬",
+	"🏭",
+	"🏮",
+	"🏯",
+	"🏰",
+	"🏳️",
+	"🏳️\u200d⚧️",
+	"🏳️\u200d🌈",
+	"🏴",
+	"🏴\u200d☠️",
+	"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f",
+	"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f",
+	"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f",
+	"🏵️",
+	"🏷️",
+	"🏸",
+	"🏹",
+	"🏺",
+	"🐀",
+	"🐁",
+	"🐂",
+	"🐃",
+	"🐄",
+	"🐅",
+	"🐆",
+	"🐇",
+	"🐈",
+	"🐈\u200d⬛",
+	"🐉",
+	"🐊",
+	"🐋",
+	"🐌",
+	"🐍",
+	"🐎",
+	"🐏",
+	"🐐",
+	"🐑",
+	"🐒",
+	"🐓",
+	"🐔",
+	"🐕",
+	"🐕\u200d🦺",
+	"🐖",
+	"🐗",
+	"🐘",
+	"🐙",
+	"🐚",
+	"🐛",
+	"🐜",
+	"🐝",
+	"🐞",
+	"🐟",
+	"🐠",
+	"🐡",
+	"🐢",
+	"🐣",
+	"🐤",
+	"🐥",
+	"🐦",
+	"🐦\u200d⬛",
+	"🐧",
+	"🐨",
+	"🐩",
+	"🐪",
+	"🐫",
+	"🐬",
+	"🐭",
+	"🐮",
+	"🐯",
+	"🐰",
+	"🐱",
+	"🐲",
+	"🐳",
+	"🐴",
+	"🐵",
+	"🐶",
+	"🐷",
+	"🐸",
+	"🐹",
+	"🐺",
+	"🐻",
+	"🐻\u200d❄️",
+	"🐼",
+	"🐽",
+	"🐾",
+	"🐿️",
+	"👀",
+	"👁️",
+	"👁️\u200d🗨️",
+	"👂",
+	"👃",
+	"👄",
+	"👅",
+	"👆",
+	"👇",
+	"👈",
+	"👉",
+	"👊",
+	"👋",
+	"👌",
+	"👍",
+	"👎",
+	"👏",
+	"👐",
+	"👑",
+	"👒",
+	"👓",
+	"👔",
+	"👕",
+	"👖",
+	"👗",
+	"👘",
+	"👙",
+	"👚",
+	"👛",
+	"👜",
+	"👝",
+	"👞",
+	"👟",
+	"👠",
+	"👡",
+	"👢",
+	"👣",
+	"👤",
+	"👥",
+	"👦",
+	"👧",
+	"👨",
+	"👨\u200d⚕️",
+	"👨\u200d⚖️",
+	"👨\u200d✈️",
+	"👨\u200d❤️\u200d👨",
+	"👨\u200d❤️\u200d💋\u200d👨",
+	"👨\u200d🌾",
+	"👨\u200d🍳",
+	"👨\u200d🍼",
+	"👨\u200d🎓",
+	"👨\u200d🎤",
+	"👨\u200d🎨",
+	"👨\u200d🏫",
+	"👨\u200d🏭",
+	"👨\u200d👦",
+	"👨\u200d👦\u200d👦",
+	"👨\u200d👧",
+	"👨\u200d👧\u200d👦",
+	"👨\u200d👧\u200d👧",
+	"👨\u200d👨\u200d👦",
+	"👨\u200d👨\u200d👦\u200d👦",
+	"👨\u200d👨\u200d👧",
+	"👨\u200d👨\u200d👧\u200d👦",
+	"👨\u200d👨\u200d👧\u200d👧",
+	"👨\u200d👩\u200d👦",
+	"👨\u200d👩\u200d👦\u200d👦",
+	"👨\u200d👩\u200d👧",
+	"👨\u200d👩\u200d👧\u200d👦",
+	"👨\u200d👩\u200d👧\u200d👧",
+	"👨\u200d💻",
+	"👨\u200d💼",
+	"👨\u200d🔧",
+	"👨\u200d🔬",
+	"👨\u200d🚀",
+	"👨\u200d🚒",
+	"👨\u200d🦯",
+	"👨\u200d🦰",
+	"👨\u200d🦱",
+	"👨\u200d🦲",
+	"👨\u200d🦳",
+	"👨\u200d🦼",
+	"👨\u200d🦽",
+	"👩",
+	"👩\u200d⚕️",
+	"👩\u200d⚖️",
+	"👩\u200d✈️",
+	"👩\u200d❤️\u200d👨",
+	"👩\u200d❤️\u200d👩",
+	"👩\u200d❤️\u200d💋\u200d👨",
+	"👩\u200d❤️\u200d💋\u200d👩",
+	"👩\u200d🌾",
+	"👩\u200d🍳",
+	"👩\u200d🍼",
+	"👩\u200d🎓",
+	"👩\u200d🎤",
+	"👩\u200d🎨",
+	"👩\u200d🏫",
+	"👩\u200d🏭",
+	"👩\u200d👦",
+	"👩\u200d👦\u200d👦",
+	"👩\u200d👧",
+	"👩\u200d👧\u200d👦",
+	"👩\u200d👧\u200d👧",
+	"👩\u200d👩\u200d👦",
+	"👩\u200d👩\u200d👦\u200d👦",
+	"👩\u200d👩\u200d👧",
+	"👩\u200d👩\u200d👧\u200d👦",
+	"👩\u200d👩\u200d👧\u200d👧",
+	"👩\u200d💻",
+	"👩\u200d💼",
+	"👩\u200d🔧",
+	"👩\u200d🔬",
+	"👩\u200d🚀",
+	"👩\u200d🚒",
+	"👩\u200d🦯",
+	"👩\u200d🦰",
+	"👩\u200d🦱",
+	"👩\u200d🦲",
+	"👩\u200d🦳",
+	"👩\u200d🦼",
+	"👩\u200d🦽",
+	"👪",
+	"👫",
+	"👬",
+	"👭",
+	"👮",
+	"👮\u200d♀️",
+	"👮\u200d♂️",
+	"👯",
+	"👯\u200d♀️",
+	"👯\u200d♂️",
+	"👰",
+	"👰\u200d♀️",
+	"👰\u200d♂️",
+	"👱",
+	"👱\u200d♀️",
+	"👱\u200d♂️",
+	"👲",
+	"👳",
+	"👳\u200d♀️",
+	"👳\u200d♂️",
+	"👴",
+	"👵",
+	"👶",
+	"👷",
+	"👷\u200d♀️",
+	"👷\u200d♂️",
+	"👸",
+	"👹",
+	"👺",
+	"👻",
+	"👼",
+	"👽",
+	"👾",
+	"👿",
+	"💀",
+	"💁",
+	"💁\u200d♀️",
+	"💁\u200d♂️",
+	"💂",
+	"💂\u200d♀️",
+	"💂\u200d♂️",
+	"💃",
+	"💄",
+	"💅",
+	"💆",
+	"💆\u200d♀️",
+	"💆\u200d♂️",
+	"💇",
+	"💇\u200d♀️",
+	"💇\u200d♂️",
+	"💈",
+	"💉",
+	"💊",
+	"💋",
+	"💌",
+	"💍",
+	"💎",
+	"💏",
+	"💐",
+	"💑",
+	"💒",
+	"💓",
+	"💔",
+	"💕",
+	"💖",
+	"💗",
+	"💘",
+	"💙",
+	"💚",
+	"💛",
+	"💜",
+	"💝",
+	"💞",
+	"💟",
+	"💠",
+	"💡",
+	"💢",
+	"💣",
+	"💤",
+	"💥",
+	"💦",
+	"💧",
+	"💨",
+	"💩",
+	"💪",
+	"💫",
+	"💬",
+	"💭",
+	"💮",
+	"💯",
+	"💰",
+	"💱",
+	"💲",
+	"💳",
+	"💴",
+	"💵",
+	"💶",
+	"💷",
+	"💸",
+	"💹",
+	"💺",
+	"💻",
+	"💼",
+	"💽",
+	"💾",
+	"💿",
+	"📀",
+	"📁",
+	"📂",
+	"📃",
+	"📄",
+	"📅",
+	"📆",
+	"📇",
+	"📈",
+	"📉",
+	"📊",
+	"📋",
+	"📌",
+	"📍",
+	"📎",
+	"📏",
+	"📐",
+	"📑",
+	"📒",
+	"📓",
+	"📔",
+	"📕",
+	"📖",
+	"📗",
+	"📘",
+	"📙",
+	"📚",
+	"📛",
+	"📜",
+	"📝",
+	"📞",
+	"📟",
+	"📠",
+	"📡",
+	"📢",
+	"📣",
+	"📤",
+	"📥",
+	"📦",
+	"📧",
+	"📨",
+	"📩",
+	"📪",
+	"📫",
+	"📬",
+	"📭",
+	"📮",
+	"📯",
+	"📰",
+	"📱",
+	"📲",
+	"📳",
+	"📴",
+	"📵",
+	"📶",
+	"📷",
+	"📸",
+	"📹",
+	"📺",
+	"📻",
+	"📼",
+	"📽️",
+	"📿",
+	"🔀",
+	"🔁",
+	"🔂",
+	"🔃",
+	"🔄",
+	"🔅",
+	"🔆",
+	"🔇",
+	"🔈",
+	"🔉",
+	"🔊",
+	"🔋",
+	"🔌",
+	"🔍",
+	"🔎",
+	"🔏",
+	"🔐",
+	"🔑",
+	"🔒",
+	"🔓",
+	"🔔",
+	"🔕",
+	"🔖",
+	"🔗",
+	"🔘",
+	"🔙",
+	"🔚",
+	"🔛",
+	"🔜",
+	"🔝",
+	"🔞",
+	"🔟",
+	"🔠",
+	"🔡",
+	"🔢",
+	"🔣",
+	"🔤",
+	"🔥",
+	"🔦",
+	"🔧",
+	"🔨",
+	"🔩",
+	"🔪",
+	"🔫",
+	"🔬",
+	"🔭",
+	"🔮",
+	"🔯",
+	"🔰",
+	"🔱",
+	"🔲",
+	"🔳",
+	"🔴",
+	"🔵",
+	"🔶",
+	"🔷",
+	"🔸",
+	"🔹",
+	"🔺",
+	"🔻",
+	"🔼",
+	"🔽",
+	"🕉️",
+	"🕊️",
+	"🕋",
+	"🕌",
+	"🕍",
+	"🕎",
+	"🕐",
+	"🕑",
+	"🕒",
+	"🕓",
+	"🕔",
+	"🕕",
+	"🕖",
+	"🕗",
+	"🕘",
+	"🕙",
+	"🕚",
+	"🕛",
+	"🕜",
+	"🕝",
+	"🕞",
+	"🕟",
+	"🕠",
+	"🕡",
+	"🕢",
+	"🕣",
+	"🕤",
+	"🕥",
+	"🕦",
+	"🕧",
+	"🕯️",
+	"🕰️",
+	"🕳️",
+	"🕴️",
+	"🕵️",
+	"🕵️\u200d♀️",
+	"🕵️\u200d♂️",
+	"🕶️",
+	"🕷️",
+	"🕸️",
+	"🕹️",
+	"🕺",
+	"🖇️",
+	"🖊️",
+	"🖋️",
+	"🖌️",
+	"🖍️",
+	"🖐️",
+	"🖕",
+	"🖖",
+	"🖤",
+	"🖥️",
+	"🖨️",
+	"🖱️",
+	"🖲️",
+	"🖼️",
+	"🗂️",
+	"🗃️",
+	"🗄️",
+	"🗑️",
+	"🗒️",
+	"🗓️",
+	"🗜️",
+	"🗝️",
+	"🗞️",
+	"🗡️",
+	"🗣️",
+	"🗨️",
+	"🗯️",
+	"🗳️",
+	"🗺️",
+	"🗻",
+	"🗼",
+	"🗽",
+	"🗾",
+	"🗿",
+	"😀",
+	"😁",
+	"😂",
+	"😃",
+	"😄",
+	"😅",
+	"😆",
+	"😇",
+	"😈",
+	"😉",
+	"😊",
+	"😋",
+	"😌",
+	"😍",
+	"😎",
+	"😏",
+	"😐",
+	"😑",
+	"😒",
+	"😓",
+	"😔",
+	"😕",
+	"😖",
+	"😗",
+	"😘",
+	"😙",
+	"😚",
+	"😛",
+	"😜",
+	"😝",
+	"😞",
+	"😟",
+	"😠",
+	"😡",
+	"😢",
+	"😣",
+	"😤",
+	"😥",
+	"😦",
+	"😧",
+	"😨",
+	"😩",
+	"😪",
+	"😫",
+	"😬",
+	"😭",
+	"😮",
+	"😮\u200d💨",
+	"😯",
+	"😰",
+	"😱",
+	"😲",
+	"😳",
+	"😴",
+	"😵",
+	"😵\u200d💫",
+	"😶",
+	"😶\u200d🌫️",
+	"😷",
+	"😸",
+	"😹",
+	"😺",
+	"😻",
+	"😼",
+	"😽",
+	"😾",
+	"😿",
+	"🙀",
+	"🙁",
+	"🙂",
+	"🙃",
+	"🙄",
+	"🙅",
+	"🙅\u200d♀️",
+	"🙅\u200d♂️",
+	"🙆",
+	"🙆\u200d♀️",
+	"🙆\u200d♂️",
+	"🙇",
+	"🙇\u200d♀️",
+	"🙇\u200d♂️",
+	"🙈",
+	"🙉",
+	"🙊",
+	"🙋",
+	"🙋\u200d♀️",
+	"🙋\u200d♂️",
+	"🙌",
+	"🙍",
+	"🙍\u200d♀️",
+	"🙍\u200d♂️",
+	"🙎",
+	"🙎\u200d♀️",
+	"🙎\u200d♂️",
+	"🙏",
+	"🚀",
+	"🚁",
+	"🚂",
+	"🚃",
+	"🚄",
+	"🚅",
+	"🚆",
+	"🚇",
+	"🚈",
+	"🚉",
+	"🚊",
+	"🚋",
+	"🚌",
+	"🚍",
+	"🚎",
+	"🚏",
+	"🚐",
+	"🚑",
+	"🚒",
+	"🚓",
+	"🚔",
+	"🚕",
+	"🚖",
+	"🚗",
+	"🚘",
+	"🚙",
+	"🚚",
+	"🚛",
+	"🚜",
+	"🚝",
+	"🚞",
+	"🚟",
+	"🚠",
+	"🚡",
+	"🚢",
+	"🚣",
+	"🚣\u200d♀️",
+	"🚣\u200d♂️",
+	"🚤",
+	"🚥",
+	"🚦",
+	"🚧",
+	"🚨",
+	"🚩",
+	"🚪",
+	"🚫",
+	"🚬",
+	"🚭",
+	"🚮",
+	"🚯",
+	"🚰",
+	"🚱",
+	"🚲",
+	"🚳",
+	"🚴",
+	"🚴\u200d♀️",
+	"🚴\u200d♂️",
+	"🚵",
+	"🚵\u200d♀️",
+	"🚵\u200d♂️",
+	"🚶",
+	"🚶\u200d♀️",
+	"🚶\u200d♂️",
+	"🚷",
+	"🚸",
+	"🚹",
+	"🚺",
+	"🚻",
+	"🚼",
+	"🚽",
+	"🚾",
+	"🚿",
+	"🛀",
+	"🛁",
+	"🛂",
+	"🛃",
+	"🛄",
+	"🛅",
+	"🛋️",
+	"🛌",
+	"🛍️",
+	"🛎️",
+	"🛏️",
+	"🛐",
+	"🛑",
+	"🛒",
+	"🛕",
+	"🛖",
+	"🛗",
+	"🛜",
+	"🛝",
+	"🛞",
+	"🛟",
+	"🛠️",
+	"🛡️",
+	"🛢️",
+	"🛣️",
+	"🛤️",
+	"🛥️",
+	"🛩️",
+	"🛫",
+	"🛬",
+	"🛰️",
+	"🛳️",
+	"🛴",
+	"🛵",
+	"🛶",
+	"🛷",
+	"🛸",
+	"🛹",
+	"🛺",
+	"🛻",
+	"🛼",
+	"🟠",
+	"🟡",
+	"🟢",
+	"🟣",
+	"🟤",
+	"🟥",
+	"🟦",
+	"🟧",
+	"🟨",
+	"🟩",
+	"🟪",
+	"🟫",
+	"🟰",
+	"🤌",
+	"🤍",
+	"🤎",
+	"🤏",
+	"🤐",
+	"🤑",
+	"🤒",
+	"🤓",
+	"🤔",
+	"🤕",
+	"🤖",
+	"🤗",
+	"🤘",
+	"🤙",
+	"🤚",
+	"🤛",
+	"🤜",
+	"🤝",
+	"🤞",
+	"🤟",
+	"🤠",
+	"🤡",
+	"🤢",
+	"🤣",
+	"🤤",
+	"🤥",
+	"🤦",
+	"🤦\u200d♀️",
+	"🤦\u200d♂️",
+	"🤧",
+	"🤨",
+	"🤩",
+	"🤪",
+	"🤫",
+	"🤬",
+	"🤭",
+	"🤮",
+	"🤯",
+	"🤰",
+	"🤱",
+	"🤲",
+	"🤳",
+	"🤴",
+	"🤵",
+	"🤵\u200d♀️",
+	"🤵\u200d♂️",
+	"🤶",
+	"🤷",
+	"🤷\u200d♀️",
+	"🤷\u200d♂️",
+	"🤸",
+	"🤸\u200d♀️",
+	"🤸\u200d♂️",
+	"🤹",
+	"🤹\u200d♀️",
+	"🤹\u200d♂️",
+	"🤺",
+	"🤼",
+	"🤼\u200d♀️",
+	"🤼\u200d♂️",
+	"🤽",
+	"🤽\u200d♀️",
+	"🤽\u200d♂️",
+	"🤾",
+	"🤾\u200d♀️",
+	"🤾\u200d♂️",
+	"🤿",
+	"🥀",
+	"🥁",
+	"🥂",
+	"🥃",
+	"🥄",
+	"🥅",
+	"🥇",
+	"🥈",
+	"🥉",
+	"🥊",
+	"🥋",
+	"🥌",
+	"🥍",
+	"🥎",
+	"🥏",
+	"🥐",
+	"🥑",
+	"🥒",
+	"🥓",
+	"🥔",
+	"🥕",
+	"🥖",
+	"🥗",
+	"🥘",
+	"🥙",
+	"🥚",
+	"🥛",
+	"🥜",
+	"🥝",
+	"🥞",
+	"🥟",
+	"🥠",
+	"🥡",
+	"🥢",
+	"🥣",
+	"🥤",
+	"🥥",
+	"🥦",
+	"🥧",
+	"🥨",
+	"🥩",
+	"🥪",
+	"🥫",
+	"🥬",
+	"🥭",
+	"🥮",
+	"🥯",
+	"🥰",
+	"🥱",
+	"🥲",
+	"🥳",
+	"🥴",
+	"🥵",
+	"🥶",
+	"🥷",
+	"🥸",
+	"🥹",
+	"🥺",
+	"🥻",
+	"🥼",
+	"🥽",
+	"🥾",
+	"🥿",
+	"🦀",
+	"🦁",
+	"🦂",
+	"🦃",
+	"🦄",
+	"🦅",
+	"🦆",
+	"🦇",
+	"🦈",
+	"🦉",
+	"🦊",
+	"🦋",
+	"🦌",
+	"🦍",
+	"🦎",
+	"🦏",
+	"🦐",
+	"🦑",
+	"🦒",
+	"🦓",
+	"🦔",
+	"🦕",
+	"🦖",
+	"🦗",
+	"🦘",
+	"🦙",
+	"🦚",
+	"🦛",
+	"🦜",
+	"🦝",
+	"🦞",
+	"🦟",
+	"🦠",
+	"🦡",
+	"🦢",
+	"🦣",
+	"🦤",
+	"🦥",
+	"🦦",
+	"🦧",
+	"🦨",
+	"🦩",
+	"🦪",
+	"🦫",
+	"🦬",
+	"🦭",
+	"🦮",
+	"🦯",
+	"🦴",
+	"🦵",
+	"🦶",
+	"🦷",
+	"🦸",
+	"🦸\u200d♀️",
+	"🦸\u200d♂️",
+	"🦹",
+	"🦹\u200d♀️",
+	"🦹\u200d♂️",
+	"🦺",
+	"🦻",
+	"🦼",
+	"🦽",
+	"🦾",
+	"🦿",
+	"🧀",
+	"🧁",
+	"🧂",
+	"🧃",
+	"🧄",
+	"🧅",
+	"🧆",
+	"🧇",
+	"🧈",
+	"🧉",
+	"🧊",
+	"🧋",
+	"🧌",
+	"🧍",
+	"🧍\u200d♀️",
+	"🧍\u200d♂️",
+	"🧎",
+	"🧎\u200d♀️",
+	"🧎\u200d♂️",
+	"🧏",
+	"🧏\u200d♀️",
+	"🧏\u200d♂️",
+	"🧐",
+	"🧑",
+	"🧑\u200d⚕️",
+	"🧑\u200d⚖️",
+	"🧑\u200d✈️",
+	"🧑\u200d🌾",
+	"🧑\u200d🍳",
+	"🧑\u200d🍼",
+	"🧑\u200d🎄",
+	"🧑\u200d🎓",
+	"🧑\u200d🎤",
+	"🧑\u200d🎨",
+	"🧑\u200d🏫",
+	"🧑\u200d🏭",
+	"🧑\u200d💻",
+	"🧑\u200d💼",
+	"🧑\u200d🔧",
+	"🧑\u200d🔬",
+	"🧑\u200d🚀",
+	"🧑\u200d🚒",
+	"🧑\u200d🤝\u200d🧑",
+	"🧑\u200d🦯",
+	"🧑\u200d🦰",
+	"🧑\u200d🦱",
+	"🧑\u200d🦲",
+	"🧑\u200d🦳",
+	"🧑\u200d🦼",
+	"🧑\u200d🦽",
+	"🧒",
+	"🧓",
+	"🧔",
+	"🧔\u200d♀️",
+	"🧔\u200d♂️",
+	"🧕",
+	"🧖",
+	"🧖\u200d♀️",
+	"🧖\u200d♂️",
+	"🧗",
+	"🧗\u200d♀️",
+	"🧗\u200d♂️",
+	"🧘",
+	"🧘\u200d♀️",
+	"🧘\u200d♂️",
+	"🧙",
+	"🧙\u200d♀️",
+	"🧙\u200d♂️",
+	"🧚",
+	"🧚\u200d♀️",
+	"🧚\u200d♂️",
+	"🧛",
+	"🧛\u200d♀️",
+	"🧛\u200d♂️",
+	"🧜",
+	"🧜\u200d♀️",
+	"🧜\u200d♂️",
+	"🧝",
+	"🧝\u200d♀️",
+	"🧝\u200d♂️",
+	"🧞",
+	"🧞\u200d♀️",
+	"🧞\u200d♂️",
+	"🧟",
+	"🧟\u200d♀️",
+	"🧟\u200d♂️",
+	"🧠",
+	"🧡",
+	"🧢",
+	"🧣",
+	"🧤",
+	"🧥",
+	"🧦",
+	"🧧",
+	"🧨",
+	"🧩",
+	"🧪",
+	"🧫",
+	"🧬",
+	"🧭",
+	"🧮",
+	"🧯",
+	"🧰",
+	"🧱",
+	"🧲",
+	"🧳",
+	"🧴",
+	"🧵",
+	"🧶",
+	"🧷",
+	"🧸",
+	"🧹",
+	"🧺",
+	"🧻",
+	"🧼",
+	"🧽",
+	"🧾",
+	"🧿",
+	"🩰",
+	"🩱",
+	"🩲",
+	"🩳",
+	"🩴",
+	"🩵",
+	"🩶",
+	"🩷",
+	"🩸",
+	"🩹",
+	"🩺",
+	"🩻",
+	"🩼",
+	"🪀",
+	"🪁",
+	"🪂",
+	"🪃",
+	"🪄",
+	"🪅",
+	"🪆",
+	"🪇",
+	"🪈",
+	"🪐",
+	"🪑",
+	"🪒",
+	"🪓",
+	"🪔",
+	"🪕",
+	"🪖",
+	"🪗",
+	"🪘",
+	"🪙",
+	"🪚",
+	"🪛",
+	"🪜",
+	"🪝",
+	"🪞",
+	"🪟",
+	"🪠",
+	"🪡",
+	"🪢",
+	"🪣",
+	"🪤",
+	"🪥",
+	"🪦",
+	"🪧",
+	"🪨",
+	"🪩",
+	"🪪",
+	"🪫",
+	"🪬",
+	"🪭",
+	"🪮",
+	"🪯",
+	"🪰",
+	"🪱",
+	"🪲",
+	"🪳",
+	"🪴",
+	"🪵",
+	"🪶",
+	"🪷",
+	"🪸",
+	"🪹",
+	"🪺",
+	"🪻",
+	"🪼",
+	"🪽",
+	"🪿",
+	"🫀",
+	"🫁",
+	"🫂",
+	"🫃",
+	"🫄",
+	"🫅",
+	"🫎",
+	"🫏",
+	"🫐",
+	"🫑",
+	"🫒",
+	"🫓",
+	"🫔",
+	"🫕",
+	"🫖",
+	"🫗",
+	"🫘",
+	"🫙",
+	"🫚",
+	"🫛",
+	"🫠",
+	"🫡",
+	"🫢",
+	"🫣",
+	"🫤",
+	"🫥",
+	"🫦",
+	"🫧",
+	"🫨",
+	"🫰",
+	"🫱",
+	"🫲",
+	"🫳",
+	"🫴",
+	"🫵",
+	"🫶",
+	"🫷",
+	"🫸",
+}
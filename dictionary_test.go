@@ -0,0 +1,146 @@
+package goemoji
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNewMapDictionary_CopiesMap(t *testing.T) {
+	emojiTags := map[string][]string{"apple": {"🍎"}}
+	dictionary := NewMapDictionary(emojiTags)
+
+	emojiTags["apple"][0] = "🍍"
+	emojiTags["pineapple"] = []string{"🍍"}
+
+	if got, _ := dictionary.Lookup("apple"); !reflect.DeepEqual(got, []string{"🍎"}) {
+		t.Errorf("Lookup() = %v, want %v", got, []string{"🍎"})
+	}
+	if _, ok := dictionary.Lookup("pineapple"); ok {
+		t.Error("Lookup() found keyword added after creation")
+	}
+}
+
+func TestMapDictionary_Lookup(t *testing.T) {
+	emojis, ok := testDictionary.Lookup("apple")
+	if !ok || !reflect.DeepEqual(emojis, []string{"🍎", "🍏"}) {
+		t.Errorf("Lookup() = %v, %v, want %v, true", emojis, ok, []string{"🍎", "🍏"})
+	}
+
+	emojis[0] = "🍍"
+	if again, _ := testDictionary.Lookup("apple"); again[0] != "🍎" {
+		t.Errorf("Lookup() result could modify dictionary, got %v", again)
+	}
+
+	if _, ok := testDictionary.Lookup("banana"); ok {
+		t.Error("Lookup() found unknown keyword")
+	}
+}
+
+func TestMapDictionary_ContainsEmoji(t *testing.T) {
+	if !testDictionary.ContainsEmoji("🍍") {
+		t.Error("ContainsEmoji() = false, want true")
+	}
+	if testDictionary.ContainsEmoji("🍌") {
+		t.Error("ContainsEmoji() = true, want false")
+	}
+}
+
+func TestMapDictionary_Range(t *testing.T) {
+	visited := make(map[string][]string)
+	testDictionary.Range(func(keyword string, emojis []string) bool {
+		visited[keyword] = emojis
+		return true
+	})
+	if !reflect.DeepEqual(visited, defaultEmojiTags) {
+		t.Errorf("Range() visited %v, want %v", visited, defaultEmojiTags)
+	}
+
+	calls := 0
+	testDictionary.Range(func(keyword string, emojis []string) bool {
+		calls++
+		return false
+	})
+	if calls != 1 {
+		t.Errorf("Range() continued after fn returned false, calls = %d", calls)
+	}
+}
+
+func TestMapDictionary_MaxPhraseLength(t *testing.T) {
+	if got := testDictionary.MaxPhraseLength(); got != 2 {
+		t.Errorf("MaxPhraseLength() = %d, want 2", got)
+	}
+}
+
+func TestOverlayDictionary(t *testing.T) {
+	overlay := newOverlayDictionary(testDictionary, nil, nil).
+		withKeyword("banana split", []string{"🍌"}).
+		withoutKeyword("pineapple")
+
+	visited := make(map[string][]string)
+	overlay.Range(func(keyword string, emojis []string) bool {
+		visited[keyword] = emojis
+		return true
+	})
+	want := map[string][]string{
+		"apple":        {"🍎", "🍏"},
+		"green apple":  {"🍏"},
+		"banana split": {"🍌"},
+	}
+	if !reflect.DeepEqual(visited, want) {
+		t.Errorf("Range() visited %v, want %v", visited, want)
+	}
+	if !overlay.ContainsEmoji("🍌") {
+		t.Error("ContainsEmoji() = false for added emoji")
+	}
+	if got := overlay.MaxPhraseLength(); got != 2 {
+		t.Errorf("MaxPhraseLength() = %d, want 2", got)
+	}
+}
+
+func TestDefaultDictionary_TablesSorted(t *testing.T) {
+	byKeyword := func(a, b dictionaryEntry) int { return strings.Compare(a.keyword, b.keyword) }
+	if !slices.IsSortedFunc(defaultEntries, byKeyword) {
+		t.Error("defaultEntries are not sorted by keyword")
+	}
+	if !slices.IsSorted(defaultEmojis) {
+		t.Error("defaultEmojis are not sorted")
+	}
+
+	defaultDictionary.Range(func(keyword string, emojis []string) bool {
+		if len(emojis) == 0 {
+			t.Errorf("keyword '%s' has no emojis", keyword)
+		}
+		for _, emoji := range emojis {
+			if !defaultDictionary.ContainsEmoji(emoji) {
+				t.Errorf("emoji '%s' of keyword '%s' is missing in defaultEmojis", emoji, keyword)
+			}
+		}
+		return true
+	})
+}
+
+func TestTableDictionary_Lookup(t *testing.T) {
+	emojis, ok := defaultDictionary.Lookup("+1")
+	if !ok || !reflect.DeepEqual(emojis, []string{"👍"}) {
+		t.Errorf("Lookup() = %v, %v, want %v, true", emojis, ok, []string{"👍"})
+	}
+	if _, ok := defaultDictionary.Lookup("not a keyword"); ok {
+		t.Error("Lookup() found unknown keyword")
+	}
+}
+
+func TestReadJSONDictionary(t *testing.T) {
+	dictionary, err := ReadJSONDictionary(strings.NewReader(`{"apfel": ["🍎"], "roter apfel": ["🍎"]}`))
+	if err != nil {
+		t.Fatalf("ReadJSONDictionary() error = %v", err)
+	}
+	if emojis, ok := dictionary.Lookup("roter apfel"); !ok || !reflect.DeepEqual(emojis, []string{"🍎"}) {
+		t.Errorf("Lookup() = %v, %v, want %v, true", emojis, ok, []string{"🍎"})
+	}
+
+	if _, err := ReadJSONDictionary(strings.NewReader(`["🍎"]`)); err == nil {
+		t.Error("ReadJSONDictionary() with invalid JSON error = nil")
+	}
+}
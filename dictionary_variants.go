@@ -0,0 +1,334 @@
+// Code generated by internal/main.go; DO NOT EDIT.
+
+package goemoji
+
+// defaultVariants lists the skin tone and gender variants of emojis, sorted by emoji.
+var defaultVariants = []emojiVariant{
+	{"☝️", true, "", ""},
+	{"⛹️", true, "⛹️\u200d♀️", "⛹️\u200d♂️"},
+	{"⛹️\u200d♀️", true, "", ""},
+	{"⛹️\u200d♂️", true, "", ""},
+	{"✊", true, "", ""},
+	{"✋", true, "", ""},
+	{"✌️", true, "", ""},
+	{"✍️", true, "", ""},
+	{"🎅", true, "", ""},
+	{"🏂", true, "", ""},
+	{"🏃", true, "🏃\u200d♀️", "🏃\u200d♂️"},
+	{"🏃\u200d♀️", true, "", ""},
+	{"🏃\u200d♀️\u200d➡️", true, "", ""},
+	{"🏃\u200d♂️", true, "", ""},
+	{"🏃\u200d♂️\u200d➡️", true, "", ""},
+	{"🏃\u200d➡️", true, "", ""},
+	{"🏄", true, "🏄\u200d♀️", "🏄\u200d♂️"},
+	{"🏄\u200d♀️", true, "", ""},
+	{"🏄\u200d♂️", true, "", ""},
+	{"🏇", true, "", ""},
+	{"🏊", true, "🏊\u200d♀️", "🏊\u200d♂️"},
+	{"🏊\u200d♀️", true, "", ""},
+	{"🏊\u200d♂️", true, "", ""},
+	{"🏋️", true, "🏋️\u200d♀️", "🏋️\u200d♂️"},
+	{"🏋️\u200d♀️", true, "", ""},
+	{"🏋️\u200d♂️", true, "", ""},
+	{"🏌️", true, "🏌️\u200d♀️", "🏌️\u200d♂️"},
+	{"🏌️\u200d♀️", true, "", ""},
+	{"🏌️\u200d♂️", true, "", ""},
+	{"👂", true, "", ""},
+	{"👃", true, "", ""},
+	{"👆", true, "", ""},
+	{"👇", true, "", ""},
+	{"👈", true, "", ""},
+	{"👉", true, "", ""},
+	{"👊", true, "", ""},
+	{"👋", true, "", ""},
+	{"👌", true, "", ""},
+	{"👍", true, "", ""},
+	{"👎", true, "", ""},
+	{"👏", true, "", ""},
+	{"👐", true, "", ""},
+	{"👦", true, "", ""},
+	{"👧", true, "", ""},
+	{"👨", true, "", ""},
+	{"👨\u200d⚕️", true, "", ""},
+	{"👨\u200d⚖️", true, "", ""},
+	{"👨\u200d✈️", true, "", ""},
+	{"👨\u200d❤️\u200d👨", true, "", ""},
+	{"👨\u200d❤️\u200d💋\u200d👨", true, "", ""},
+	{"👨\u200d🌾", true, "", ""},
+	{"👨\u200d🍳", true, "", ""},
+	{"👨\u200d🍼", true, "", ""},
+	{"👨\u200d🎓", true, "", ""},
+	{"👨\u200d🎤", true, "", ""},
+	{"👨\u200d🎨", true, "", ""},
+	{"👨\u200d🏫", true, "", ""},
+	{"👨\u200d🏭", true, "", ""},
+	{"👨\u200d💻", true, "", ""},
+	{"👨\u200d💼", true, "", ""},
+	{"👨\u200d🔧", true, "", ""},
+	{"👨\u200d🔬", true, "", ""},
+	{"👨\u200d🚀", true, "", ""},
+	{"👨\u200d🚒", true, "", ""},
+	{"👨\u200d🦯", true, "", ""},
+	{"👨\u200d🦯\u200d➡️", true, "", ""},
+	{"👨\u200d🦰", true, "", ""},
+	{"👨\u200d🦱", true, "", ""},
+	{"👨\u200d🦲", true, "", ""},
+	{"👨\u200d🦳", true, "", ""},
+	{"👨\u200d🦼", true, "", ""},
+	{"👨\u200d🦼\u200d➡️", true, "", ""},
+	{"👨\u200d🦽", true, "", ""},
+	{"👨\u200d🦽\u200d➡️", true, "", ""},
+	{"👩", true, "", ""},
+	{"👩\u200d⚕️", true, "", ""},
+	{"👩\u200d⚖️", true, "", ""},
+	{"👩\u200d✈️", true, "", ""},
+	{"👩\u200d❤️\u200d👨", true, "", ""},
+	{"👩\u200d❤️\u200d👩", true, "", ""},
+	{"👩\u200d❤️\u200d💋\u200d👨", true, "", ""},
+	{"👩\u200d❤️\u200d💋\u200d👩", true, "", ""},
+	{"👩\u200d🌾", true, "", ""},
+	{"👩\u200d🍳", true, "", ""},
+	{"👩\u200d🍼", true, "", ""},
+	{"👩\u200d🎓", true, "", ""},
+	{"👩\u200d🎤", true, "", ""},
+	{"👩\u200d🎨", true, "", ""},
+	{"👩\u200d🏫", true, "", ""},
+	{"👩\u200d🏭", true, "", ""},
+	{"👩\u200d💻", true, "", ""},
+	{"👩\u200d💼", true, "", ""},
+	{"👩\u200d🔧", true, "", ""},
+	{"👩\u200d🔬", true, "", ""},
+	{"👩\u200d🚀", true, "", ""},
+	{"👩\u200d🚒", true, "", ""},
+	{"👩\u200d🦯", true, "", ""},
+	{"👩\u200d🦯\u200d➡️", true, "", ""},
+	{"👩\u200d🦰", true, "", ""},
+	{"👩\u200d🦱", true, "", ""},
+	{"👩\u200d🦲", true, "", ""},
+	{"👩\u200d🦳", true, "", ""},
+	{"👩\u200d🦼", true, "", ""},
+	{"👩\u200d🦼\u200d➡️", true, "", ""},
+	{"👩\u200d🦽", true, "", ""},
+	{"👩\u200d🦽\u200d➡️", true, "", ""},
+	{"👫", true, "", ""},
+	{"👬", true, "", ""},
+	{"👭", true, "", ""},
+	{"👮", true, "👮\u200d♀️", "👮\u200d♂️"},
+	{"👮\u200d♀️", true, "", ""},
+	{"👮\u200d♂️", true, "", ""},
+	{"👯", false, "👯\u200d♀️", "👯\u200d♂️"},
+	{"👰", true, "👰\u200d♀️", "👰\u200d♂️"},
+	{"👰\u200d♀️", true, "", ""},
+	{"👰\u200d♂️", true, "", ""},
+	{"👱", true, "👱\u200d♀️", "👱\u200d♂️"},
+	{"👱\u200d♀️", true, "", ""},
+	{"👱\u200d♂️", true, "", ""},
+	{"👲", true, "", ""},
+	{"👳", true, "👳\u200d♀️", "👳\u200d♂️"},
+	{"👳\u200d♀️", true, "", ""},
+	{"👳\u200d♂️", true, "", ""},
+	{"👴", true, "", ""},
+	{"👵", true, "", ""},
+	{"👶", true, "", ""},
+	{"👷", true, "👷\u200d♀️", "👷\u200d♂️"},
+	{"👷\u200d♀️", true, "", ""},
+	{"👷\u200d♂️", true, "", ""},
+	{"👸", true, "", ""},
+	{"👼", true, "", ""},
+	{"💁", true, "💁\u200d♀️", "💁\u200d♂️"},
+	{"💁\u200d♀️", true, "", ""},
+	{"💁\u200d♂️", true, "", ""},
+	{"💂", true, "💂\u200d♀️", "💂\u200d♂️"},
+	{"💂\u200d♀️", true, "", ""},
+	{"💂\u200d♂️", true, "", ""},
+	{"💃", true, "", ""},
+	{"💅", true, "", ""},
+	{"💆", true, "💆\u200d♀️", "💆\u200d♂️"},
+	{"💆\u200d♀️", true, "", ""},
+	{"💆\u200d♂️", true, "", ""},
+	{"💇", true, "💇\u200d♀️", "💇\u200d♂️"},
+	{"💇\u200d♀️", true, "", ""},
+	{"💇\u200d♂️", true, "", ""},
+	{"💏", true, "", ""},
+	{"💑", true, "", ""},
+	{"💪", true, "", ""},
+	{"🕴️", true, "", ""},
+	{"🕵️", true, "🕵️\u200d♀️", "🕵️\u200d♂️"},
+	{"🕵️\u200d♀️", true, "", ""},
+	{"🕵️\u200d♂️", true, "", ""},
+	{"🕺", true, "", ""},
+	{"🖐️", true, "", ""},
+	{"🖕", true, "", ""},
+	{"🖖", true, "", ""},
+	{"🙅", true, "🙅\u200d♀️", "🙅\u200d♂️"},
+	{"🙅\u200d♀️", true, "", ""},
+	{"🙅\u200d♂️", true, "", ""},
+	{"🙆", true, "🙆\u200d♀️", "🙆\u200d♂️"},
+	{"🙆\u200d♀️", true, "", ""},
+	{"🙆\u200d♂️", true, "", ""},
+	{"🙇", true, "🙇\u200d♀️", "🙇\u200d♂️"},
+	{"🙇\u200d♀️", true, "", ""},
+	{"🙇\u200d♂️", true, "", ""},
+	{"🙋", true, "🙋\u200d♀️", "🙋\u200d♂️"},
+	{"🙋\u200d♀️", true, "", ""},
+	{"🙋\u200d♂️", true, "", ""},
+	{"🙌", true, "", ""},
+	{"🙍", true, "🙍\u200d♀️", "🙍\u200d♂️"},
+	{"🙍\u200d♀️", true, "", ""},
+	{"🙍\u200d♂️", true, "", ""},
+	{"🙎", true, "🙎\u200d♀️", "🙎\u200d♂️"},
+	{"🙎\u200d♀️", true, "", ""},
+	{"🙎\u200d♂️", true, "", ""},
+	{"🙏", true, "", ""},
+	{"🚣", true, "🚣\u200d♀️", "🚣\u200d♂️"},
+	{"🚣\u200d♀️", true, "", ""},
+	{"🚣\u200d♂️", true, "", ""},
+	{"🚴", true, "🚴\u200d♀️", "🚴\u200d♂️"},
+	{"🚴\u200d♀️", true, "", ""},
+	{"🚴\u200d♂️", true, "", ""},
+	{"🚵", true, "🚵\u200d♀️", "🚵\u200d♂️"},
+	{"🚵\u200d♀️", true, "", ""},
+	{"🚵\u200d♂️", true, "", ""},
+	{"🚶", true, "🚶\u200d♀️", "🚶\u200d♂️"},
+	{"🚶\u200d♀️", true, "", ""},
+	{"🚶\u200d♀️\u200d➡️", true, "", ""},
+	{"🚶\u200d♂️", true, "", ""},
+	{"🚶\u200d♂️\u200d➡️", true, "", ""},
+	{"🚶\u200d➡️", true, "", ""},
+	{"🛀", true, "", ""},
+	{"🛌", true, "", ""},
+	{"🤌", true, "", ""},
+	{"🤏", true, "", ""},
+	{"🤘", true, "", ""},
+	{"🤙", true, "", ""},
+	{"🤚", true, "", ""},
+	{"🤛", true, "", ""},
+	{"🤜", true, "", ""},
+	{"🤝", true, "", ""},
+	{"🤞", true, "", ""},
+	{"🤟", true, "", ""},
+	{"🤦", true, "🤦\u200d♀️", "🤦\u200d♂️"},
+	{"🤦\u200d♀️", true, "", ""},
+	{"🤦\u200d♂️", true, "", ""},
+	{"🤰", true, "", ""},
+	{"🤱", true, "", ""},
+	{"🤲", true, "", ""},
+	{"🤳", true, "", ""},
+	{"🤴", true, "", ""},
+	{"🤵", true, "🤵\u200d♀️", "🤵\u200d♂️"},
+	{"🤵\u200d♀️", true, "", ""},
+	{"🤵\u200d♂️", true, "", ""},
+	{"🤶", true, "", ""},
+	{"🤷", true, "🤷\u200d♀️", "🤷\u200d♂️"},
+	{"🤷\u200d♀️", true, "", ""},
+	{"🤷\u200d♂️", true, "", ""},
+	{"🤸", true, "🤸\u200d♀️", "🤸\u200d♂️"},
+	{"🤸\u200d♀️", true, "", ""},
+	{"🤸\u200d♂️", true, "", ""},
+	{"🤹", true, "🤹\u200d♀️", "🤹\u200d♂️"},
+	{"🤹\u200d♀️", true, "", ""},
+	{"🤹\u200d♂️", true, "", ""},
+	{"🤼", false, "🤼\u200d♀️", "🤼\u200d♂️"},
+	{"🤽", true, "🤽\u200d♀️", "🤽\u200d♂️"},
+	{"🤽\u200d♀️", true, "", ""},
+	{"🤽\u200d♂️", true, "", ""},
+	{"🤾", true, "🤾\u200d♀️", "🤾\u200d♂️"},
+	{"🤾\u200d♀️", true, "", ""},
+	{"🤾\u200d♂️", true, "", ""},
+	{"🥷", true, "", ""},
+	{"🦵", true, "", ""},
+	{"🦶", true, "", ""},
+	{"🦸", true, "🦸\u200d♀️", "🦸\u200d♂️"},
+	{"🦸\u200d♀️", true, "", ""},
+	{"🦸\u200d♂️", true, "", ""},
+	{"🦹", true, "🦹\u200d♀️", "🦹\u200d♂️"},
+	{"🦹\u200d♀️", true, "", ""},
+	{"🦹\u200d♂️", true, "", ""},
+	{"🦻", true, "", ""},
+	{"🧍", true, "🧍\u200d♀️", "🧍\u200d♂️"},
+	{"🧍\u200d♀️", true, "", ""},
+	{"🧍\u200d♂️", true, "", ""},
+	{"🧎", true, "🧎\u200d♀️", "🧎\u200d♂️"},
+	{"🧎\u200d♀️", true, "", ""},
+	{"🧎\u200d♀️\u200d➡️", true, "", ""},
+	{"🧎\u200d♂️", true, "", ""},
+	{"🧎\u200d♂️\u200d➡️", true, "", ""},
+	{"🧎\u200d➡️", true, "", ""},
+	{"🧏", true, "🧏\u200d♀️", "🧏\u200d♂️"},
+	{"🧏\u200d♀️", true, "", ""},
+	{"🧏\u200d♂️", true, "", ""},
+	{"🧑", true, "👩", "👨"},
+	{"🧑\u200d⚕️", true, "👩\u200d⚕️", "👨\u200d⚕️"},
+	{"🧑\u200d⚖️", true, "👩\u200d⚖️", "👨\u200d⚖️"},
+	{"🧑\u200d✈️", true, "👩\u200d✈️", "👨\u200d✈️"},
+	{"🧑\u200d🌾", true, "👩\u200d🌾", "👨\u200d🌾"},
+	{"🧑\u200d🍳", true, "👩\u200d🍳", "👨\u200d🍳"},
+	{"🧑\u200d🍼", true, "👩\u200d🍼", "👨\u200d🍼"},
+	{"🧑\u200d🎄", true, "", ""},
+	{"🧑\u200d🎓", true, "👩\u200d🎓", "👨\u200d🎓"},
+	{"🧑\u200d🎤", true, "👩\u200d🎤", "👨\u200d🎤"},
+	{"🧑\u200d🎨", true, "👩\u200d🎨", "👨\u200d🎨"},
+	{"🧑\u200d🏫", true, "👩\u200d🏫", "👨\u200d🏫"},
+	{"🧑\u200d🏭", true, "👩\u200d🏭", "👨\u200d🏭"},
+	{"🧑\u200d💻", true, "👩\u200d💻", "👨\u200d💻"},
+	{"🧑\u200d💼", true, "👩\u200d💼", "👨\u200d💼"},
+	{"🧑\u200d🔧", true, "👩\u200d🔧", "👨\u200d🔧"},
+	{"🧑\u200d🔬", true, "👩\u200d🔬", "👨\u200d🔬"},
+	{"🧑\u200d🚀", true, "👩\u200d🚀", "👨\u200d🚀"},
+	{"🧑\u200d🚒", true, "👩\u200d🚒", "👨\u200d🚒"},
+	{"🧑\u200d🤝\u200d🧑", true, "", ""},
+	{"🧑\u200d🦯", true, "👩\u200d🦯", "👨\u200d🦯"},
+	{"🧑\u200d🦯\u200d➡️", true, "👩\u200d🦯\u200d➡️", "👨\u200d🦯\u200d➡️"},
+	{"🧑\u200d🦰", true, "👩\u200d🦰", "👨\u200d🦰"},
+	{"🧑\u200d🦱", true, "👩\u200d🦱", "👨\u200d🦱"},
+	{"🧑\u200d🦲", true, "👩\u200d🦲", "👨\u200d🦲"},
+	{"🧑\u200d🦳", true, "👩\u200d🦳", "👨\u200d🦳"},
+	{"🧑\u200d🦼", true, "👩\u200d🦼", "👨\u200d🦼"},
+	{"🧑\u200d🦼\u200d➡️", true, "👩\u200d🦼\u200d➡️", "👨\u200d🦼\u200d➡️"},
+	{"🧑\u200d🦽", true, "👩\u200d🦽", "👨\u200d🦽"},
+	{"🧑\u200d🦽\u200d➡️", true, "👩\u200d🦽\u200d➡️", "👨\u200d🦽\u200d➡️"},
+	{"🧒", true, "", ""},
+	{"🧓", true, "", ""},
+	{"🧔", true, "🧔\u200d♀️", "🧔\u200d♂️"},
+	{"🧔\u200d♀️", true, "", ""},
+	{"🧔\u200d♂️", true, "", ""},
+	{"🧕", true, "", ""},
+	{"🧖", true, "🧖\u200d♀️", "🧖\u200d♂️"},
+	{"🧖\u200d♀️", true, "", ""},
+	{"🧖\u200d♂️", true, "", ""},
+	{"🧗", true, "🧗\u200d♀️", "🧗\u200d♂️"},
+	{"🧗\u200d♀️", true, "", ""},
+	{"🧗\u200d♂️", true, "", ""},
+	{"🧘", true, "🧘\u200d♀️", "🧘\u200d♂️"},
+	{"🧘\u200d♀️", true, "", ""},
+	{"🧘\u200d♂️", true, "", ""},
+	{"🧙", true, "🧙\u200d♀️", "🧙\u200d♂️"},
+	{"🧙\u200d♀️", true, "", ""},
+	{"🧙\u200d♂️", true, "", ""},
+	{"🧚", true, "🧚\u200d♀️", "🧚\u200d♂️"},
+	{"🧚\u200d♀️", true, "", ""},
+	{"🧚\u200d♂️", true, "", ""},
+	{"🧛", true, "🧛\u200d♀️", "🧛\u200d♂️"},
+	{"🧛\u200d♀️", true, "", ""},
+	{"🧛\u200d♂️", true, "", ""},
+	{"🧜", true, "🧜\u200d♀️", "🧜\u200d♂️"},
+	{"🧜\u200d♀️", true, "", ""},
+	{"🧜\u200d♂️", true, "", ""},
+	{"🧝", true, "🧝\u200d♀️", "🧝\u200d♂️"},
+	{"🧝\u200d♀️", true, "", ""},
+	{"🧝\u200d♂️", true, "", ""},
+	{"🧞", false, "🧞\u200d♀️", "🧞\u200d♂️"},
+	{"🧟", false, "🧟\u200d♀️", "🧟\u200d♂️"},
+	{"🫃", true, "", ""},
+	{"🫄", true, "", ""},
+	{"🫅", true, "", ""},
+	{"🫰", true, "", ""},
+	{"🫱", true, "", ""},
+	{"🫲", true, "", ""},
+	{"🫳", true, "", ""},
+	{"🫴", true, "", ""},
+	{"🫵", true, "", ""},
+	{"🫶", true, "", ""},
+	{"🫷", true, "", ""},
+	{"🫸", true, "", ""},
+}
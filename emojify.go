@@ -1,26 +1,53 @@
 package goemoji
 
 import (
-	"embed"
-	"encoding/json"
 	"fmt"
-)
+	"strings"
+	"sync"
+	"sync/atomic"
 
-//go:embed emoji_map.json
-var emojiFileSystem embed.FS
+	"github.com/jo-hoe/goemoji/internal/casing"
+)
 
 const (
-	embedFileName        = "emoji_map.json"
 	defaultMinWordLength = 4
 )
 
 // Emojifier provides functionality to add emojis to text using different strategies.
-// It is safe for concurrent use by multiple goroutines.
+// It is safe for concurrent use by multiple goroutines, including concurrent
+// changes of its dictionary.
 type Emojifier struct {
 	strategy          EmojifyStrategy
-	emojiTags         map[string][]string
-	emojiSet          map[string]bool
 	minimumWordLength int
+	locale            string
+	// localeTables are the generated locale dictionaries, nil for localeTables.
+	localeTables map[string]*tableDictionary
+	// toLower is the lowercasing of the locale, nil for default Unicode lowercasing.
+	toLower     func(string) string
+	preferences Preferences
+	// emoticons converts emoticons to emojis before the strategy is applied, nil to keep them.
+	emoticons *EmoticonConverter
+	// withoutCountryCodes removes the flags of two-letter country code keywords.
+	withoutCountryCodes bool
+	// values selects the values converted to emojis before the strategy is applied.
+	values ValueKind
+
+	// dictionaryMutex serializes dictionary changes. Readers load the
+	// current dictionary without locking; changes swap in a new copy.
+	dictionaryMutex sync.Mutex
+	dictionary      atomic.Pointer[overlayDictionary]
+}
+
+// Option configures optional settings of an Emojifier.
+type Option func(*Emojifier)
+
+// WithDictionary replaces the embedded emoji map with a custom Dictionary.
+func WithDictionary(dictionary Dictionary) Option {
+	return func(e *Emojifier) {
+		if dictionary != nil {
+			e.dictionary.Store(newOverlayDictionary(dictionary, nil, nil))
+		}
+	}
 }
 
 // NewDefaultEmojifier creates a new Emojifier with default settings.
@@ -31,7 +58,7 @@ func NewDefaultEmojifier() (*Emojifier, error) {
 
 // NewEmojifier creates a new Emojifier with the specified strategy and minimum word length.
 // Returns an error if strategy is nil or minimumWordLength is negative.
-func NewEmojifier(strategy EmojifyStrategy, minimumWordLength int) (*Emojifier, error) {
+func NewEmojifier(strategy EmojifyStrategy, minimumWordLength int, options ...Option) (*Emojifier, error) {
 	if strategy == nil {
 		return nil, fmt.Errorf("strategy cannot be nil")
 	}
@@ -39,22 +66,51 @@ func NewEmojifier(strategy EmojifyStrategy, minimumWordLength int) (*Emojifier,
 		return nil, fmt.Errorf("minimumWordLength cannot be negative, got: %d", minimumWordLength)
 	}
 
-	loadedMap, err := loadEmojiMap()
-	if err != nil {
-		return nil, fmt.Errorf("failed to load emoji map: %w", err)
-	}
-
-	return &Emojifier{
+	emojifier := &Emojifier{
 		strategy:          strategy,
-		emojiTags:         loadedMap,
-		emojiSet:          createEmojiSet(loadedMap),
 		minimumWordLength: minimumWordLength,
-	}, nil
+	}
+	for _, option := range options {
+		option(emojifier)
+	}
+
+	caseLocale := emojifier.locale
+	if emojifier.dictionary.Load() == nil {
+		tables := emojifier.localeTables
+		if tables == nil {
+			tables = localeTables
+		}
+		dictionary, locale := localeDictionary(emojifier.locale, tables)
+		emojifier.dictionary.Store(newOverlayDictionary(dictionary, nil, nil))
+		caseLocale = locale
+	}
+	emojifier.toLower = casing.LowerFunc(caseLocale)
+
+	return emojifier, nil
 }
 
 // Emojify applies the configured strategy to add emojis to the given text.
 func (e *Emojifier) Emojify(text string) string {
-	return e.strategy.Emojify(text, e.minimumWordLength, e.emojiTags, e.emojiSet)
+	return e.EmojifyWith(text, e.preferences)
+}
+
+// EmojifyWith applies the configured strategy with the skin tone and gender of the
+// preferences instead of those of the Emojifier.
+func (e *Emojifier) EmojifyWith(text string, preferences Preferences) string {
+	dictionary := e.currentDictionary()
+	if preferences != (Preferences{}) {
+		dictionary = preferredDictionary{Dictionary: dictionary, preferences: preferences}
+	}
+	if e.withoutCountryCodes {
+		dictionary = countryCodeFilter{Dictionary: dictionary}
+	}
+	if e.emoticons != nil {
+		text = e.emoticons.ToEmojisWith(text, preferences)
+	}
+	if e.values != 0 {
+		text = EmojifyValues(text, e.values)
+	}
+	return e.strategy.Emojify(text, e.minimumWordLength, dictionary)
 }
 
 // ContainsEmoji returns true if the text contains any emoji characters.
@@ -65,33 +121,61 @@ func (e *Emojifier) ContainsEmoji(text string) bool {
 
 // ExtractEmojis returns a slice of all emoji characters found in the text.
 func (e *Emojifier) ExtractEmojis(text string) []string {
-	return extractEmojis(text, e.emojiSet)
+	return extractEmojis(text, e.currentDictionary())
+}
+
+// Dictionary returns a snapshot of the keyword dictionary used by the Emojifier.
+// Later changes of the Emojifier's dictionary do not affect the snapshot.
+func (e *Emojifier) Dictionary() Dictionary {
+	return e.currentDictionary()
+}
+
+// currentDictionary returns the current dictionary together with the lowercasing of the locale.
+func (e *Emojifier) currentDictionary() Dictionary {
+	dictionary := e.dictionary.Load()
+	if e.toLower == nil {
+		return dictionary
+	}
+	return localizedDictionary{Dictionary: dictionary, toLower: e.toLower}
 }
 
-func loadEmojiMap() (emojiMap map[string][]string, err error) {
-	data, err := emojiFileSystem.ReadFile(embedFileName)
-	if err != nil {
-		return emojiMap, err
+// AddKeyword maps the keyword to the emojis, replacing any emojis the keyword had before.
+// The keyword is matched case-insensitively.
+func (e *Emojifier) AddKeyword(keyword string, emojis ...string) error {
+	keyword = e.normalizeKeyword(keyword)
+	if keyword == "" {
+		return fmt.Errorf("keyword cannot be empty")
 	}
-	if err := json.Unmarshal(data, &emojiMap); err != nil {
-		return emojiMap, err
+	if len(emojis) == 0 {
+		return fmt.Errorf("keyword '%s' needs at least one emoji", keyword)
 	}
 
-	return emojiMap, nil
+	e.dictionaryMutex.Lock()
+	defer e.dictionaryMutex.Unlock()
+	e.dictionary.Store(e.dictionary.Load().withKeyword(keyword, emojis))
+	return nil
 }
 
-func createEmojiSet(emojiTags map[string][]string) map[string]bool {
-	// Pre-calculate capacity for better performance
-	capacity := 0
-	for _, emojis := range emojiTags {
-		capacity += len(emojis)
-	}
+// RemoveKeyword removes the keyword from the dictionary.
+func (e *Emojifier) RemoveKeyword(keyword string) {
+	e.dictionaryMutex.Lock()
+	defer e.dictionaryMutex.Unlock()
+	e.dictionary.Store(e.dictionary.Load().withoutKeyword(e.normalizeKeyword(keyword)))
+}
 
-	result := make(map[string]bool, capacity)
-	for _, emojis := range emojiTags {
-		for _, emoji := range emojis {
-			result[emoji] = true
-		}
+// ReplaceDictionary swaps the dictionary of the Emojifier.
+// Keywords added or removed before are discarded.
+func (e *Emojifier) ReplaceDictionary(dictionary Dictionary) error {
+	if dictionary == nil {
+		return fmt.Errorf("dictionary cannot be nil")
 	}
-	return result
+
+	e.dictionaryMutex.Lock()
+	defer e.dictionaryMutex.Unlock()
+	e.dictionary.Store(newOverlayDictionary(dictionary, nil, nil))
+	return nil
+}
+
+func (e *Emojifier) normalizeKeyword(keyword string) string {
+	return strings.Join(strings.Fields(toLower(e.currentDictionary(), keyword)), " ")
 }
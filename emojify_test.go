@@ -2,6 +2,7 @@ package goemoji
 
 import (
 	"reflect"
+	"sync"
 	"testing"
 )
 
@@ -12,12 +13,19 @@ type MockStrategy struct{}
 func (i MockStrategy) Emojify(
 	input string,
 	minimumWordLength int,
-	emojiTags map[string][]string,
-	emojiSet map[string]bool,
+	dictionary Dictionary,
 ) (output string) {
 	return mockStrategyReturn
 }
 
+func newTestEmojifier(strategy EmojifyStrategy) *Emojifier {
+	emojifier, err := NewEmojifier(strategy, 4, WithDictionary(testDictionary))
+	if err != nil {
+		panic(err)
+	}
+	return emojifier
+}
+
 func TestEmojifier_ContainsEmoji(t *testing.T) {
 	type args struct {
 		text string
@@ -30,24 +38,14 @@ func TestEmojifier_ContainsEmoji(t *testing.T) {
 	}{
 		{
 			name: "what a delicious apple",
-			e: &Emojifier{
-				strategy:          MockStrategy{},
-				minimumWordLength: 4,
-				emojiTags:         defaultEmojiTags,
-				emojiSet:          defaultEmojiSet,
-			},
+			e:    newTestEmojifier(MockStrategy{}),
 			args: args{
 				text: "what a delicious 🍎",
 			},
 			want: true,
 		}, {
 			name: "does not contain emoji",
-			e: &Emojifier{
-				strategy:          MockStrategy{},
-				minimumWordLength: 4,
-				emojiTags:         defaultEmojiTags,
-				emojiSet:          defaultEmojiSet,
-			},
+			e:    newTestEmojifier(MockStrategy{}),
 			args: args{
 				text: "what a delicious apple",
 			},
@@ -75,12 +73,7 @@ func TestEmojifier_ExtractEmojis(t *testing.T) {
 	}{
 		{
 			name: "extract emojis",
-			e: &Emojifier{
-				strategy:          MockStrategy{},
-				minimumWordLength: 4,
-				emojiTags:         defaultEmojiTags,
-				emojiSet:          defaultEmojiSet,
-			},
+			e:    newTestEmojifier(MockStrategy{}),
 			args: args{
 				text: "what a delicious 🍎🍏🍍",
 			},
@@ -108,12 +101,7 @@ func TestEmojifier_Emojify(t *testing.T) {
 	}{
 		{
 			name: "test emojify",
-			e: &Emojifier{
-				strategy:          MockStrategy{},
-				minimumWordLength: 4,
-				emojiTags:         defaultEmojiTags,
-				emojiSet:          defaultEmojiSet,
-			},
+			e:    newTestEmojifier(MockStrategy{}),
 			args: args{
 				text: "what a delicious apple",
 			},
@@ -140,6 +128,41 @@ func TestNewEmojifier(t *testing.T) {
 	}
 }
 
+func TestNewEmojifier_SharesDefaultDictionary(t *testing.T) {
+	first, err := NewDefaultEmojifier()
+	if err != nil {
+		t.Fatalf("NewDefaultEmojifier() error = %v", err)
+	}
+	second, err := NewEmojifier(InsertAfterString{}, 1)
+	if err != nil {
+		t.Fatalf("NewEmojifier() error = %v", err)
+	}
+
+	if first.dictionary.Load().base != second.dictionary.Load().base {
+		t.Error("Emojifiers do not share the default dictionary")
+	}
+	if err := first.AddKeyword("goemoji", "🍌"); err != nil {
+		t.Fatalf("AddKeyword() error = %v", err)
+	}
+	if _, ok := second.Dictionary().Lookup("goemoji"); ok {
+		t.Error("AddKeyword() on one Emojifier changed the dictionary of another")
+	}
+}
+
+func TestNewEmojifier_WithDictionary(t *testing.T) {
+	emojifier, err := NewEmojifier(ReplaceSubstring{}, 1, WithDictionary(testDictionary))
+	if err != nil {
+		t.Fatalf("NewEmojifier() error = %v", err)
+	}
+
+	if _, ok := emojifier.Dictionary().Lookup("pineapple"); !ok {
+		t.Error("Dictionary() does not contain keyword of custom dictionary")
+	}
+	if got := emojifier.Emojify("a green apple"); got != "a 🍏" {
+		t.Errorf("Emojify() = %v, want %v", got, "a 🍏")
+	}
+}
+
 func TestNewEmojifier_ValidationErrors(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -182,3 +205,108 @@ func TestNewEmojifier_ValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestEmojifier_AddKeyword(t *testing.T) {
+	emojifier := newTestEmojifier(ReplaceSubstring{})
+
+	if err := emojifier.AddKeyword("Banana  Split", "🍌", "🍨"); err != nil {
+		t.Fatalf("AddKeyword() error = %v", err)
+	}
+	if err := emojifier.AddKeyword("apple", "🍏"); err != nil {
+		t.Fatalf("AddKeyword() error = %v", err)
+	}
+
+	if got, want := emojifier.Emojify("a banana split and an apple"), "a 🍌 and an 🍏"; got != want {
+		t.Errorf("Emojify() = %v, want %v", got, want)
+	}
+	if !emojifier.ContainsEmoji("🍨") {
+		t.Error("ContainsEmoji() = false for emoji of added keyword")
+	}
+}
+
+func TestEmojifier_AddKeyword_ValidationErrors(t *testing.T) {
+	emojifier := newTestEmojifier(ReplaceSubstring{})
+
+	if err := emojifier.AddKeyword("  ", "🍌"); err == nil {
+		t.Error("AddKeyword() with empty keyword error = nil")
+	}
+	if err := emojifier.AddKeyword("banana"); err == nil {
+		t.Error("AddKeyword() without emojis error = nil")
+	}
+}
+
+func TestEmojifier_RemoveKeyword(t *testing.T) {
+	emojifier := newTestEmojifier(ReplaceSubstring{})
+	snapshot := emojifier.Dictionary()
+
+	emojifier.RemoveKeyword("Apple")
+
+	if got, want := emojifier.Emojify("an apple and a green apple"), "an apple and a 🍏"; got != want {
+		t.Errorf("Emojify() = %v, want %v", got, want)
+	}
+	if _, ok := snapshot.Lookup("apple"); !ok {
+		t.Error("RemoveKeyword() changed an earlier Dictionary() snapshot")
+	}
+
+	if err := emojifier.AddKeyword("apple", "🍎"); err != nil {
+		t.Fatalf("AddKeyword() error = %v", err)
+	}
+	if got, want := emojifier.Emojify("an apple"), "an 🍎"; got != want {
+		t.Errorf("Emojify() after re-adding = %v, want %v", got, want)
+	}
+}
+
+func TestEmojifier_ReplaceDictionary(t *testing.T) {
+	emojifier := newTestEmojifier(ReplaceSubstring{})
+	if err := emojifier.AddKeyword("banana", "🍌"); err != nil {
+		t.Fatalf("AddKeyword() error = %v", err)
+	}
+
+	if err := emojifier.ReplaceDictionary(NewMapDictionary(map[string][]string{"cherry": {"🍒"}})); err != nil {
+		t.Fatalf("ReplaceDictionary() error = %v", err)
+	}
+
+	if got, want := emojifier.Emojify("apple banana cherry"), "apple banana 🍒"; got != want {
+		t.Errorf("Emojify() = %v, want %v", got, want)
+	}
+	if err := emojifier.ReplaceDictionary(nil); err == nil {
+		t.Error("ReplaceDictionary(nil) error = nil")
+	}
+}
+
+func TestEmojifier_ConcurrentDictionaryChanges(t *testing.T) {
+	emojifier := newTestEmojifier(InsertAfterString{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if err := emojifier.AddKeyword("banana", "🍌"); err != nil {
+					t.Error(err)
+				}
+				emojifier.RemoveKeyword("banana")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				got := emojifier.Emojify("an apple and a banana")
+				if got != "an apple and a banana 🍎" && got != "an apple and a banana 🍎🍌" {
+					t.Errorf("Emojify() = %v", got)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkNewEmojifier(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewEmojifier(ReplaceSubstring{}, defaultMinWordLength); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
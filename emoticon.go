@@ -0,0 +1,190 @@
+package goemoji
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// emoticonTrailingCharacters may follow an emoticon at the end of a sentence, like in "great :)!".
+const emoticonTrailingCharacters = ".,!?"
+
+// Emoticon is an ASCII emoticon like :) together with its emoji.
+type Emoticon struct {
+	Text  string
+	Emoji string
+}
+
+// defaultEmoticons lists the emoticons of DefaultEmoticons. The first emoticon of
+// an emoji is the one EmojisToEmoticons converts it to.
+var defaultEmoticons = []Emoticon{
+	{":)", "🙂"}, {":-)", "🙂"}, {"=)", "🙂"}, {"(:", "🙂"},
+	{":D", "😃"}, {":-D", "😃"}, {"=D", "😃"},
+	{"xD", "😆"}, {"XD", "😆"},
+	{";)", "😉"}, {";-)", "😉"},
+	{":(", "🙁"}, {":-(", "🙁"}, {"=(", "🙁"},
+	{":'(", "😢"}, {":'-(", "😢"},
+	{":P", "😛"}, {":-P", "😛"}, {":p", "😛"}, {":-p", "😛"},
+	{";P", "😜"}, {";-P", "😜"}, {";p", "😜"},
+	{":O", "😮"}, {":-O", "😮"}, {":o", "😮"}, {":-o", "😮"},
+	{":|", "😐"}, {":-|", "😐"},
+	{":/", "😕"}, {":-/", "😕"}, {`:\`, "😕"},
+	{":*", "😘"}, {":-*", "😘"},
+	{":$", "😳"},
+	{"B-)", "😎"}, {"8-)", "😎"},
+	{">:(", "😠"}, {">:-(", "😠"},
+	{"O:)", "😇"}, {"O:-)", "😇"}, {"0:)", "😇"},
+	{"^_^", "😊"}, {"^^", "😊"},
+	{"-_-", "😑"},
+	{"<3", "❤️"},
+	{"</3", "💔"},
+	{"o/", "👋"},
+	{`¯\_(ツ)_/¯`, "🤷"},
+}
+
+// DefaultEmoticons returns the emoticons converted by the default EmoticonConverter.
+func DefaultEmoticons() []Emoticon {
+	emoticons := make([]Emoticon, len(defaultEmoticons))
+	copy(emoticons, defaultEmoticons)
+	return emoticons
+}
+
+// EmoticonConverter converts emoticons to emojis and back. Emoticons are only
+// converted if they stand alone between whitespace, optionally followed by
+// sentence punctuation, so :) within URLs or words is kept. Inline code and
+// code blocks in backticks are never changed.
+type EmoticonConverter struct {
+	emojis    map[string]string
+	emoticons map[string]string
+}
+
+var defaultEmoticonConverter = sync.OnceValue(func() *EmoticonConverter {
+	return newEmoticonConverter(defaultEmoticons)
+})
+
+// NewEmoticonConverter creates a converter for the emoticons. Returns an error if an
+// emoticon is empty or contains whitespace, if its emoji is not a single emoji or if
+// the same emoticon is listed with different emojis.
+func NewEmoticonConverter(emoticons []Emoticon) (*EmoticonConverter, error) {
+	emojis := make(map[string]string, len(emoticons))
+	for _, emoticon := range emoticons {
+		if emoticon.Text == "" || strings.IndexFunc(emoticon.Text, unicode.IsSpace) >= 0 {
+			return nil, fmt.Errorf("invalid emoticon '%s'", emoticon.Text)
+		}
+		if !IsEmoji(emoticon.Emoji) {
+			return nil, fmt.Errorf("emoticon '%s' has no valid emoji, got '%s'", emoticon.Text, emoticon.Emoji)
+		}
+		if emoji, ok := emojis[emoticon.Text]; ok && emoji != emoticon.Emoji {
+			return nil, fmt.Errorf("emoticon '%s' has the emojis '%s' and '%s'", emoticon.Text, emoji, emoticon.Emoji)
+		}
+		emojis[emoticon.Text] = emoticon.Emoji
+	}
+	return newEmoticonConverter(emoticons), nil
+}
+
+func newEmoticonConverter(emoticons []Emoticon) *EmoticonConverter {
+	converter := &EmoticonConverter{
+		emojis:    make(map[string]string, len(emoticons)),
+		emoticons: make(map[string]string),
+	}
+	for _, emoticon := range emoticons {
+		converter.emojis[emoticon.Text] = emoticon.Emoji
+		key := emoticonKey(emoticon.Emoji)
+		if _, ok := converter.emoticons[key]; !ok {
+			converter.emoticons[key] = emoticon.Text
+		}
+	}
+	return converter
+}
+
+// EmoticonsToEmojis replaces the default emoticons in the text by emojis, e.g. "great :)" by "great 🙂".
+func EmoticonsToEmojis(text string) string {
+	return defaultEmoticonConverter().ToEmojis(text)
+}
+
+// EmojisToEmoticons replaces the emojis in the text which have a default emoticon,
+// e.g. "great 🙂" by "great :)". Skin tones are ignored.
+func EmojisToEmoticons(text string) string {
+	return defaultEmoticonConverter().ToEmoticons(text)
+}
+
+// WithEmoticons converts emoticons to emojis before the strategy is applied.
+// A nil converter uses the default emoticons.
+func WithEmoticons(converter *EmoticonConverter) Option {
+	return func(e *Emojifier) {
+		if converter == nil {
+			converter = defaultEmoticonConverter()
+		}
+		e.emoticons = converter
+	}
+}
+
+// ToEmojis replaces the emoticons in the text by their emojis.
+func (c *EmoticonConverter) ToEmojis(text string) string {
+	return c.ToEmojisWith(text, Preferences{})
+}
+
+// ToEmojisWith replaces the emoticons in the text by their emojis with the skin
+// tone and gender of the preferences.
+func (c *EmoticonConverter) ToEmojisWith(text string, preferences Preferences) string {
+	return replaceOutsideCode(text, func(prose string) string {
+		var builder strings.Builder
+		for pos := 0; pos < len(prose); {
+			wordStart := skipRunes(prose, pos, unicode.IsSpace)
+			wordEnd := skipRunes(prose, wordStart, func(r rune) bool { return !unicode.IsSpace(r) })
+			builder.WriteString(prose[pos:wordStart])
+			pos = wordEnd
+
+			word := prose[wordStart:wordEnd]
+			emoticon := strings.TrimRight(word, emoticonTrailingCharacters)
+			emoji, ok := c.emojis[word]
+			if ok {
+				emoticon = word
+			} else {
+				emoji, ok = c.emojis[emoticon]
+			}
+			if !ok {
+				builder.WriteString(word)
+				continue
+			}
+			builder.WriteString(preferences.Apply(emoji))
+			builder.WriteString(word[len(emoticon):])
+		}
+		return builder.String()
+	})
+}
+
+// ToEmoticons replaces the emojis in the text which have an emoticon by the first
+// emoticon listed for them. Skin tones are ignored.
+func (c *EmoticonConverter) ToEmoticons(text string) string {
+	return replaceOutsideCode(text, func(prose string) string {
+		var builder strings.Builder
+		for _, segment := range Segment(prose) {
+			if emoticon, ok := c.emoticons[emoticonKey(segment.Text)]; segment.IsEmoji && ok {
+				builder.WriteString(emoticon)
+				continue
+			}
+			builder.WriteString(segment.Text)
+		}
+		return builder.String()
+	})
+}
+
+// emoticonKey identifies an emoji regardless of its qualification and skin tone.
+func emoticonKey(emoji string) string {
+	return normalizeEmoji(emoji, NormalizeFullyQualified|NormalizeStripSkinTones)
+}
+
+// replaceOutsideCode applies replace to the text between Markdown code and link targets.
+func replaceOutsideCode(text string, replace func(string) string) string {
+	var builder strings.Builder
+	start := 0
+	for _, region := range markdownCodeRegions(text) {
+		builder.WriteString(replace(text[start:region[0]]))
+		builder.WriteString(text[region[0]:region[1]])
+		start = region[1]
+	}
+	builder.WriteString(replace(text[start:]))
+	return builder.String()
+}
@@ -0,0 +1,107 @@
+package goemoji
+
+import (
+	"testing"
+)
+
+func TestNewEmoticonConverter(t *testing.T) {
+	tests := []struct {
+		name      string
+		emoticons []Emoticon
+		wantErr   bool
+	}{
+		{name: "defaults", emoticons: DefaultEmoticons(), wantErr: false},
+		{name: "empty emoticon", emoticons: []Emoticon{{"", "🙂"}}, wantErr: true},
+		{name: "whitespace", emoticons: []Emoticon{{": )", "🙂"}}, wantErr: true},
+		{name: "no emoji", emoticons: []Emoticon{{":)", "smile"}}, wantErr: true},
+		{name: "conflicting emojis", emoticons: []Emoticon{{":)", "🙂"}, {":)", "😀"}}, wantErr: true},
+		{name: "duplicate", emoticons: []Emoticon{{":)", "🙂"}, {":)", "🙂"}}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewEmoticonConverter(tt.emoticons); (err != nil) != tt.wantErr {
+				t.Errorf("NewEmoticonConverter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEmoticonsToEmojis(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "single", text: "great :)", want: "great 🙂"},
+		{name: "several", text: ":D I <3 it ;-)", want: "😃 I ❤️ it 😉"},
+		{name: "trailing punctuation", text: "thanks :)! see you :P.", want: "thanks 🙂! see you 😛."},
+		{name: "shrug", text: `¯\_(ツ)_/¯ whatever`, want: "🤷 whatever"},
+		{name: "within words", text: "a:)b (:-)", want: "a:)b (:-)"},
+		{name: "url", text: "see http://example.com/:) now", want: "see http://example.com/:) now"},
+		{name: "inline code", text: "run `echo :)` :)", want: "run `echo :)` 🙂"},
+		{name: "code block", text: "```\nsmile := \":)\"\n:)\n```\n:(", want: "```\nsmile := \":)\"\n:)\n```\n🙁"},
+		{name: "whitespace kept", text: "\t:)  \n", want: "\t🙂  \n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EmoticonsToEmojis(tt.text); got != tt.want {
+				t.Errorf("EmoticonsToEmojis() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmojisToEmoticons(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "single", text: "great 🙂", want: "great :)"},
+		{name: "first emoticon", text: "😃 I ❤️ it 😉", want: ":D I <3 it ;)"},
+		{name: "text presentation", text: "I ❤ it", want: "I ❤ it"},
+		{name: "skin tone", text: "bye 👋🏽", want: "bye o/"},
+		{name: "no emoticon", text: "ship 🚀", want: "ship 🚀"},
+		{name: "code", text: "`🙂` 🙂", want: "`🙂` :)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EmojisToEmoticons(tt.text); got != tt.want {
+				t.Errorf("EmojisToEmoticons() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithEmoticons(t *testing.T) {
+	converter, err := NewEmoticonConverter([]Emoticon{{"(y)", "👍"}})
+	if err != nil {
+		t.Fatalf("NewEmoticonConverter() error = %v", err)
+	}
+	dictionary := NewMapDictionary(map[string][]string{"music": {"🎶"}})
+
+	tests := []struct {
+		name    string
+		options []Option
+		text    string
+		want    string
+	}{
+		{name: "default emoticons", options: []Option{WithEmoticons(nil)}, text: "music :)", want: "🎶 🙂"},
+		{name: "custom emoticons", options: []Option{WithEmoticons(converter)}, text: "music (y) :)", want: "🎶 👍 :)"},
+		{name: "skin tone", options: []Option{WithEmoticons(converter), WithSkinTone(SkinToneDark)},
+			text: "(y)", want: "👍🏿"},
+		{name: "disabled", text: "music :)", want: "🎶 :)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := append([]Option{WithDictionary(dictionary)}, tt.options...)
+			emojifier, err := NewEmojifier(ReplaceSubstring{}, 4, options...)
+			if err != nil {
+				t.Fatalf("NewEmojifier() error = %v", err)
+			}
+			if got := emojifier.Emojify(tt.text); got != tt.want {
+				t.Errorf("Emojify() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package goemoji
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// fuzzyMinimumLength is the number of characters a word needs to be matched fuzzily,
+// so short words like "cat" do not match similar keywords like "car".
+const fuzzyMinimumLength = 4
+
+// FuzzyMatcher matches phrases like PhraseMatcher and additionally single words which
+// differ from a single word keyword by a few edits, e.g. the typo "aple" matches "apple".
+// Only words of at least four characters are matched fuzzily.
+type FuzzyMatcher struct {
+	// MaxDistance is the maximum number of inserted, deleted or replaced characters.
+	// Zero means one.
+	MaxDistance int
+}
+
+// Match finds the keyword phrases and then the closest keyword of each remaining word.
+// Keywords of equal distance are chosen by their weight and then alphabetically.
+func (f FuzzyMatcher) Match(tokens []Token, minimumWordLength int, dictionary Dictionary) []Match {
+	maxDistance := f.MaxDistance
+	if maxDistance <= 0 {
+		maxDistance = 1
+	}
+
+	matches := PhraseMatcher{}.Match(tokens, minimumWordLength, dictionary)
+	var keywords []string
+	for _, token := range tokens {
+		overlaps := slices.ContainsFunc(matches, func(match Match) bool {
+			return token.Start < match.End && match.Start < token.End
+		})
+		word := toLower(dictionary, token.Text)
+		length := utf8.RuneCountInString(word)
+		if overlaps || length < max(minimumWordLength, fuzzyMinimumLength) {
+			continue
+		}
+		if keywords == nil {
+			keywords = singleWordKeywords(dictionary)
+		}
+		if match, ok := fuzzyMatch(token, word, keywords, maxDistance, dictionary); ok {
+			matches = append(matches, match)
+		}
+	}
+
+	slices.SortFunc(matches, func(a, b Match) int {
+		return a.Start - b.Start
+	})
+	return matches
+}
+
+// fuzzyMatch returns the closest of the keywords to the word if it is within maxDistance.
+func fuzzyMatch(token Token, word string, keywords []string, maxDistance int, dictionary Dictionary) (Match, bool) {
+	var best Match
+	bestDistance := maxDistance + 1
+	for _, keyword := range keywords {
+		distance := editDistance(word, keyword, maxDistance)
+		if distance > maxDistance || distance > bestDistance {
+			continue
+		}
+		emojis, ok := lookupWeighted(dictionary, keyword)
+		if !ok || len(emojis) == 0 {
+			continue
+		}
+		if distance == bestDistance && emojis[0].Weight <= best.Weight {
+			continue
+		}
+		bestDistance = distance
+		best = Match{
+			Phrase:     word,
+			Start:      token.Start,
+			End:        token.End,
+			Candidates: EmojiStrings(emojis),
+			Weight:     emojis[0].Weight,
+		}
+	}
+	return best, bestDistance <= maxDistance
+}
+
+// singleWordKeywords returns the keywords of the dictionary without spaces in alphabetical order.
+func singleWordKeywords(dictionary Dictionary) []string {
+	keywords := make([]string, 0)
+	dictionary.Range(func(keyword string, _ []string) bool {
+		if !strings.Contains(keyword, " ") {
+			keywords = append(keywords, keyword)
+		}
+		return true
+	})
+	slices.Sort(keywords)
+	return keywords
+}
+
+// editDistance returns the Levenshtein distance of a and b in characters. Distances
+// above maxDistance are returned as maxDistance+1 without computing them fully.
+func editDistance(a, b string, maxDistance int) int {
+	source, target := []rune(a), []rune(b)
+	if abs(len(source)-len(target)) > maxDistance {
+		return maxDistance + 1
+	}
+
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		rowMinimum := i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+			rowMinimum = min(rowMinimum, current[j])
+		}
+		if rowMinimum > maxDistance {
+			return maxDistance + 1
+		}
+		previous, current = current, previous
+	}
+	return min(previous[len(target)], maxDistance+1)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,59 @@
+package goemoji
+
+import "testing"
+
+func TestFuzzyMatcher_Match(t *testing.T) {
+	dictionary := NewWeightedMapDictionary(map[string][]WeightedEmoji{
+		"apple":       {{Emoji: "🍎", Weight: WeightDescription}},
+		"pineapple":   {{Emoji: "🍍", Weight: WeightDescription}},
+		"cow":         {{Emoji: "🐄", Weight: WeightDescription}},
+		"cart":        {{Emoji: "🛒", Weight: WeightTag}},
+		"card":        {{Emoji: "💳", Weight: WeightDescription}},
+		"green apple": {{Emoji: "🍏", Weight: WeightDescription}},
+	})
+
+	tests := []struct {
+		name       string
+		matcher    FuzzyMatcher
+		input      string
+		wantOutput string
+	}{
+		{name: "exact", input: "an apple", wantOutput: "an 🍎"},
+		{name: "missing character", input: "an aple", wantOutput: "an 🍎"},
+		{name: "replaced character", input: "a pineappel", wantOutput: "a pineappel"},
+		{name: "larger distance", input: "a pineappel", wantOutput: "a 🍍", matcher: FuzzyMatcher{MaxDistance: 2}},
+		{name: "short word", input: "a cot", wantOutput: "a cot"},
+		{name: "phrase first", input: "a green apple", wantOutput: "a 🍏"},
+		{name: "higher weight wins", input: "carx", wantOutput: "💳"},
+		{name: "closer keyword wins", input: "carts", wantOutput: "🛒"},
+		{name: "case-insensitive", input: "Aple", wantOutput: "🍎"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := Pipeline{Matcher: tt.matcher}
+			if got := pipeline.Emojify(tt.input, 1, dictionary); got != tt.wantOutput {
+				t.Errorf("Emojify() = %q, want %q", got, tt.wantOutput)
+			}
+		})
+	}
+}
+
+func Test_editDistance(t *testing.T) {
+	tests := []struct {
+		a, b        string
+		maxDistance int
+		want        int
+	}{
+		{a: "apple", b: "apple", maxDistance: 1, want: 0},
+		{a: "aple", b: "apple", maxDistance: 1, want: 1},
+		{a: "äpple", b: "apple", maxDistance: 1, want: 1},
+		{a: "pineappel", b: "pineapple", maxDistance: 2, want: 2},
+		{a: "pineappel", b: "pineapple", maxDistance: 1, want: 2},
+		{a: "kiwi", b: "pineapple", maxDistance: 3, want: 4},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b, tt.maxDistance); got != tt.want {
+			t.Errorf("editDistance(%q, %q, %d) = %d, want %d", tt.a, tt.b, tt.maxDistance, got, tt.want)
+		}
+	}
+}
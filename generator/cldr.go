@@ -0,0 +1,68 @@
+package generator
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+const (
+	cldrKeywordSeparator  = "|"
+	cldrTextToSpeechType  = "tts"
+	cldrLanguageSeparator = "_"
+)
+
+type cldrDocument struct {
+	Identity struct {
+		Language struct {
+			Type string `xml:"type,attr"`
+		} `xml:"language"`
+		Territory struct {
+			Type string `xml:"type,attr"`
+		} `xml:"territory"`
+	} `xml:"identity"`
+	Annotations []cldrAnnotation `xml:"annotations>annotation"`
+}
+
+type cldrAnnotation struct {
+	CodePoints string `xml:"cp,attr"`
+	Type       string `xml:"type,attr"`
+	Value      string `xml:",chardata"`
+}
+
+// parseCLDRAnnotations reads a CLDR annotation XML file. The text-to-speech
+// annotation becomes the description of an emoji and all other annotations its tags.
+func parseCLDRAnnotations(data []byte) (locale string, emojis []Emoji, err error) {
+	var document cldrDocument
+	if err := xml.Unmarshal(data, &document); err != nil {
+		return "", nil, fmt.Errorf("error unmarshaling CLDR annotations: %w", err)
+	}
+
+	locale = document.Identity.Language.Type
+	if territory := document.Identity.Territory.Type; territory != "" {
+		locale += cldrLanguageSeparator + territory
+	}
+
+	emojis = make([]Emoji, 0)
+	indices := make(map[string]int)
+	for _, annotation := range document.Annotations {
+		i, ok := indices[annotation.CodePoints]
+		if !ok {
+			i = len(emojis)
+			indices[annotation.CodePoints] = i
+			emojis = append(emojis, Emoji{Emoji: annotation.CodePoints})
+		}
+
+		if annotation.Type == cldrTextToSpeechType {
+			emojis[i].Description = strings.TrimSpace(annotation.Value)
+			continue
+		}
+		for _, keyword := range strings.Split(annotation.Value, cldrKeywordSeparator) {
+			if keyword = strings.TrimSpace(keyword); keyword != "" {
+				emojis[i].Tags = appendUnique(emojis[i].Tags, keyword)
+			}
+		}
+	}
+
+	return locale, emojis, nil
+}
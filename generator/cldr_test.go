@@ -0,0 +1,31 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testCLDRPath = "testdata/cldr/annotations/en.xml"
+
+func Test_parseCLDRAnnotations(t *testing.T) {
+	locale, emojis, err := parseCLDRAnnotations(mustReadFile(t, testCLDRPath))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if locale != "en" {
+		t.Errorf("Expected locale 'en', got '%s'", locale)
+	}
+	if len(emojis) != 6 {
+		t.Fatalf("Expected 6 emojis, got %d", len(emojis))
+	}
+
+	expected := Emoji{
+		Emoji:       "🍎",
+		Description: "red apple",
+		Tags:        []string{"apple", "fruit", "red"},
+	}
+	if !reflect.DeepEqual(emojis[3], expected) {
+		t.Errorf("Expected %v, got %v", expected, emojis[3])
+	}
+}
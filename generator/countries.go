@@ -0,0 +1,79 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+const (
+	regionalIndicatorA = '\U0001F1E6'
+	regionalIndicatorZ = '\U0001F1FF'
+	flagPrefix         = "flag: "
+)
+
+// BuildCountries returns the countries of all flag emojis composed of two regional
+// indicators in the list, sorted by code. The name is taken from the description,
+// like "Germany" for "flag: Germany".
+func BuildCountries(emojis []Emoji) []goemoji.Country {
+	countries := make([]goemoji.Country, 0)
+	for _, emoji := range emojis {
+		code, ok := countryCode(emoji.Emoji)
+		if !ok {
+			continue
+		}
+		name := strings.TrimPrefix(emoji.Description, flagPrefix)
+		if name == "" {
+			name = code
+		}
+		countries = append(countries, goemoji.Country{Code: code, Name: name})
+	}
+
+	sort.Slice(countries, func(i, j int) bool {
+		return countries[i].Code < countries[j].Code
+	})
+	return countries
+}
+
+// countryCode returns the letters of a flag emoji composed of two regional indicators.
+func countryCode(emoji string) (string, bool) {
+	runes := []rune(emoji)
+	if len(runes) != 2 {
+		return "", false
+	}
+	code := make([]rune, len(runes))
+	for i, r := range runes {
+		if r < regionalIndicatorA || r > regionalIndicatorZ {
+			return "", false
+		}
+		code[i] = 'A' + r - regionalIndicatorA
+	}
+	return string(code), true
+}
+
+// WriteCountriesGoSource writes the countries as the country table of the goemoji package.
+func WriteCountriesGoSource(w io.Writer, countries []goemoji.Country) error {
+	return writeFormattedSource(w, renderCountriesGoSource(countries))
+}
+
+func renderCountriesGoSource(countries []goemoji.Country) []byte {
+	var buffer bytes.Buffer
+	writeGoSourceHeader(&buffer)
+	fmt.Fprintf(&buffer, "// defaultCountries lists the countries and regions with a flag emoji, sorted by code.\n")
+	fmt.Fprintf(&buffer, "var defaultCountries = []Country{\n")
+	for _, country := range countries {
+		fmt.Fprintf(&buffer, "{%s, %s},\n", strconv.Quote(country.Code), strconv.Quote(country.Name))
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	return buffer.Bytes()
+}
+
+func storeCountriesGoSource(countries []goemoji.Country, filePath string) error {
+	return writeFile(filePath, func(w io.Writer) error { return WriteCountriesGoSource(w, countries) })
+}
@@ -0,0 +1,45 @@
+package generator
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+func Test_BuildCountries(t *testing.T) {
+	emojis := []Emoji{
+		{Emoji: "🇩🇪", Description: "flag: Germany"},
+		{Emoji: "🇨🇮", Description: "flag: Côte d’Ivoire"},
+		{Emoji: "🇪🇺"},
+		{Emoji: "🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F", Description: "flag: England"},
+		{Emoji: "🏁", Description: "chequered flag"},
+		{Emoji: "🍎", Description: "red apple"},
+	}
+
+	expected := []goemoji.Country{
+		{Code: "CI", Name: "Côte d’Ivoire"},
+		{Code: "DE", Name: "Germany"},
+		{Code: "EU", Name: "EU"},
+	}
+	if got := BuildCountries(emojis); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func Test_WriteCountriesGoSource(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := WriteCountriesGoSource(&buffer, []goemoji.Country{{Code: "DE", Name: "Germany"}}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "", buffer.Bytes(), parser.AllErrors); err != nil {
+		t.Fatalf("Expected valid Go source, got %v", err)
+	}
+	if want := `{"DE", "Germany"},`; !strings.Contains(buffer.String(), want) {
+		t.Errorf("Expected Go source to contain %s, got %s", want, buffer.String())
+	}
+}
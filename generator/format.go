@@ -0,0 +1,119 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+// Formats of the emoji map at Options.OutputPath.
+const (
+	// FormatJSON is indented JSON, the format of emoji_map.json.
+	FormatJSON = "json"
+	// FormatJSONCompact is JSON without whitespace.
+	FormatJSONCompact = "json-compact"
+	// FormatCSV and FormatTSV list one emoji of a keyword per row with the
+	// columns keyword, emoji, source and rank, for review in a spreadsheet.
+	FormatCSV = "csv"
+	FormatTSV = "tsv"
+	// FormatGo is the Go source of the embedded dictionary of the goemoji package.
+	FormatGo = "go"
+	// FormatBinary is the compact format read by goemoji.ReadBinaryDictionary.
+	FormatBinary = "binary"
+)
+
+// Formats lists the supported formats of the emoji map.
+var Formats = []string{FormatJSON, FormatJSONCompact, FormatCSV, FormatTSV, FormatGo, FormatBinary}
+
+// WriteMap writes the emoji map in one of the Formats.
+func WriteMap(w io.Writer, emojiMap map[string][]goemoji.WeightedEmoji, format string) error {
+	switch format {
+	case "", FormatJSON:
+		return WriteJSON(w, emojiMap)
+	case FormatJSONCompact:
+		return WriteCompactJSON(w, emojiMap)
+	case FormatCSV:
+		return WriteCSV(w, emojiMap)
+	case FormatTSV:
+		return WriteTSV(w, emojiMap)
+	case FormatGo:
+		return WriteGoSource(w, emojiMap)
+	case FormatBinary:
+		return WriteBinary(w, emojiMap)
+	default:
+		return fmt.Errorf("unknown format '%s', supported are %v", format, Formats)
+	}
+}
+
+// WriteCompactJSON writes the emoji map as JSON without whitespace.
+func WriteCompactJSON(w io.Writer, emojiMap map[string][]goemoji.WeightedEmoji) error {
+	data, err := json.Marshal(emojiMap)
+	if err != nil {
+		return fmt.Errorf("error marshaling emoji map: %w", err)
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteCSV writes the emoji map as comma-separated values with a header row.
+func WriteCSV(w io.Writer, emojiMap map[string][]goemoji.WeightedEmoji) error {
+	return writeTable(w, emojiMap, ',')
+}
+
+// WriteTSV writes the emoji map as tab-separated values with a header row.
+func WriteTSV(w io.Writer, emojiMap map[string][]goemoji.WeightedEmoji) error {
+	return writeTable(w, emojiMap, '\t')
+}
+
+// writeTable writes a row per keyword and emoji, sorted by keyword. The rank is the
+// 1-based position of the emoji in the list of the keyword.
+func writeTable(w io.Writer, emojiMap map[string][]goemoji.WeightedEmoji, comma rune) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+
+	if err := writer.Write([]string{"keyword", "emoji", "source", "rank"}); err != nil {
+		return fmt.Errorf("error writing table: %w", err)
+	}
+	for _, keyword := range sortedKeys(emojiMap) {
+		for i, emoji := range emojiMap[keyword] {
+			if err := writer.Write([]string{keyword, emoji.Emoji, emoji.Source.String(), strconv.Itoa(i + 1)}); err != nil {
+				return fmt.Errorf("error writing table: %w", err)
+			}
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("error writing table: %w", err)
+	}
+	return nil
+}
+
+// WriteBinary writes the emoji map in the compact binary format of goemoji.ReadBinaryDictionary.
+func WriteBinary(w io.Writer, emojiMap map[string][]goemoji.WeightedEmoji) error {
+	return goemoji.WriteBinaryDictionary(w, goemoji.NewWeightedMapDictionary(emojiMap))
+}
+
+// isReadableFormat reports whether emoji maps of the format can be read back,
+// e.g. as the previous map of the change report.
+func isReadableFormat(format string) bool {
+	return format == "" || format == FormatJSON || format == FormatJSONCompact || format == FormatBinary
+}
+
+// readDictionary reads an emoji map in a readable format, binary or JSON.
+func readDictionary(data []byte) (goemoji.WeightedDictionary, error) {
+	if goemoji.IsBinaryDictionary(data) {
+		return goemoji.ReadBinaryDictionary(bytes.NewReader(data))
+	}
+	return goemoji.ReadJSONDictionary(bytes.NewReader(data))
+}
+
+func storeMap(emojiMap map[string][]goemoji.WeightedEmoji, filePath, format string) error {
+	return writeFile(filePath, func(w io.Writer) error { return WriteMap(w, emojiMap, format) })
+}
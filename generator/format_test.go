@@ -0,0 +1,102 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+var testFormatMap = map[string][]goemoji.WeightedEmoji{
+	"smile": {
+		{Emoji: "😄", Source: goemoji.SourceAlias, Weight: goemoji.WeightAlias},
+		{Emoji: "😃", Source: goemoji.SourceTag, Weight: goemoji.WeightTag},
+	},
+	"laugh, loud": {{Emoji: "🤣", Source: goemoji.SourceCustom, Weight: goemoji.WeightCustom}},
+}
+
+func Test_WriteMap_Tables(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{format: FormatCSV, want: "keyword,emoji,source,rank\n" +
+			"\"laugh, loud\",🤣,custom,1\n" +
+			"smile,😄,alias,1\n" +
+			"smile,😃,tag,2\n"},
+		{format: FormatTSV, want: "keyword\temoji\tsource\trank\n" +
+			"laugh, loud\t🤣\tcustom\t1\n" +
+			"smile\t😄\talias\t1\n" +
+			"smile\t😃\ttag\t2\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buffer bytes.Buffer
+			if err := WriteMap(&buffer, testFormatMap, tt.format); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got := buffer.String(); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_WriteMap_Readable(t *testing.T) {
+	for _, format := range []string{FormatJSON, FormatJSONCompact, FormatBinary} {
+		t.Run(format, func(t *testing.T) {
+			var buffer bytes.Buffer
+			if err := WriteMap(&buffer, testFormatMap, format); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if format == FormatJSONCompact && strings.Contains(buffer.String(), "\n") {
+				t.Errorf("Expected compact JSON, got %s", buffer.String())
+			}
+
+			dictionary, err := readDictionary(buffer.Bytes())
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			for keyword, want := range testFormatMap {
+				if got, _ := dictionary.LookupWeighted(keyword); !reflect.DeepEqual(got, want) {
+					t.Errorf("Expected %v for '%s', got %v", want, keyword, got)
+				}
+			}
+		})
+	}
+}
+
+func Test_WriteMap_UnknownFormat(t *testing.T) {
+	if err := WriteMap(&bytes.Buffer{}, testFormatMap, "xml"); err == nil {
+		t.Error("Expected an error")
+	}
+}
+
+func Test_Generate_Binary(t *testing.T) {
+	filePath := path.Join(t.TempDir(), "emoji_map.bin")
+	opts := Options{InputPath: testInputPath, OutputPath: filePath, Format: FormatBinary, ReportPath: filePath + ".txt"}
+
+	if err := New(nil).Generate(context.Background(), opts); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	var report bytes.Buffer
+	valid, err := New(nil).Validate(context.Background(), opts, &report)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !valid {
+		t.Errorf("Expected a valid binary emoji map, got %s", report.String())
+	}
+
+	dictionary, err := goemoji.ReadBinaryDictionary(bytes.NewReader(mustReadFile(t, filePath)))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, ok := dictionary.Lookup("grinning"); !ok {
+		t.Error("Expected keyword 'grinning' in binary emoji map")
+	}
+}
@@ -0,0 +1,505 @@
+// Package generator builds the emoji maps of goemoji from gemoji, Unicode and CLDR data.
+// It is used by the command in internal/main.go and can be used to build custom dictionaries.
+package generator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jo-hoe/goemoji"
+	"github.com/jo-hoe/goemoji/internal/phrase"
+)
+
+const (
+	// DefaultRef is the gemoji release which is used if no ref is pinned.
+	DefaultRef = "v4.1.0"
+	// DefaultURL is the URL of the gemoji emoji.json of DefaultRef.
+	DefaultURL = "https://raw.githubusercontent.com/github/gemoji/" + DefaultRef + "/db/emoji.json"
+	// gemojiURLPattern is the URL of the gemoji emoji.json of a ref.
+	gemojiURLPattern = "https://raw.githubusercontent.com/github/gemoji/%s/db/emoji.json"
+
+	goPackageName   = "goemoji"
+	filePermissions = 0600
+	maxResponseSize = 10 << 20 // 10MB
+)
+
+// ErrNotFound is returned if a requested URL does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Emoji is an emoji with its names and keywords as provided by a source.
+type Emoji struct {
+	Emoji       string   `json:"emoji"`
+	Description string   `json:"description"`
+	Category    string   `json:"category"`
+	Aliases     []string `json:"aliases"`
+	Tags        []string `json:"tags"`
+	// SkinTones reports whether the emoji supports skin tone modifiers.
+	SkinTones bool `json:"skin_tones,omitempty"`
+}
+
+// Options configure the inputs and outputs of a generation.
+type Options struct {
+	// Sources is a comma-separated list of the sources 'gemoji', 'unicode' and 'cldr'
+	// in order of precedence. It defaults to 'gemoji'.
+	Sources string
+	// InputPath reads the gemoji emoji.json from a local file instead of InputURL.
+	InputPath string
+	// InputURL is the URL of the gemoji emoji.json. It defaults to the URL of InputRef.
+	InputURL string
+	// InputRef pins the gemoji version, e.g. a tag or commit. It defaults to DefaultRef.
+	InputRef string
+	// InputSHA256 is the expected hex encoded checksum of the gemoji emoji.json. It is required to
+	// download the file and optional for InputPath. The generation fails if the input does not match.
+	InputSHA256   string
+	EmojiTestPath string
+	EmojiTestURL  string
+	// CLDRPaths is a comma-separated list of CLDR annotation XML files.
+	CLDRPaths  string
+	OutputPath string
+	// Format is the format of the emoji map at OutputPath, one of Formats. It defaults to FormatJSON.
+	Format       string
+	GoOutputPath string
+
+	// Locales is a comma-separated list of CLDR locales to generate dictionaries for.
+	Locales            string
+	CLDRDir            string
+	CLDRBaseURL        string
+	LocaleOutputDir    string
+	LocaleGoOutputPath string
+
+	// OverridesPath reads curated overrides of the emoji map from a JSON file.
+	OverridesPath string
+
+	// EmojiDataPath or EmojiDataURL locate the Unicode emoji-data.txt. The URL defaults to DefaultEmojiDataURL.
+	EmojiDataPath string
+	EmojiDataURL  string
+	// EastAsianWidthPath or EastAsianWidthURL locate the Unicode EastAsianWidth.txt.
+	// The URL defaults to DefaultEastAsianWidthURL.
+	EastAsianWidthPath string
+	EastAsianWidthURL  string
+	// PropertiesGoOutputPath stores the Unicode emoji property tables as Go source of the goemoji package.
+	PropertiesGoOutputPath string
+
+	// VariantsGoOutputPath stores the skin tone and gender variants of the emojis
+	// as Go source of the goemoji package.
+	VariantsGoOutputPath string
+
+	// CountriesGoOutputPath stores the countries of the flag emojis as Go source of the goemoji package.
+	CountriesGoOutputPath string
+
+	// MetadataPath and MetadataGoOutputPath store the sources of the emoji map
+	// as JSON and as Go source of the goemoji package.
+	MetadataPath         string
+	MetadataGoOutputPath string
+
+	// DiffAgainst is the previous emoji map for the change report.
+	// It defaults to the existing file at OutputPath.
+	DiffAgainst  string
+	ReportPath   string
+	ReportFormat string
+}
+
+// Generator downloads and reads the emoji sources and stores the emoji maps.
+type Generator struct {
+	client *http.Client
+	now    func() time.Time
+	// Logger receives progress messages. It is nil by default, which disables logging.
+	Logger *log.Logger
+}
+
+// New creates a Generator which downloads sources with the client.
+// If client is nil, http.DefaultClient is used.
+func New(client *http.Client) *Generator {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Generator{client: client, now: time.Now}
+}
+
+// Generate builds the emoji map and the locale emoji maps and stores them as configured by opts.
+func (g *Generator) Generate(ctx context.Context, opts Options) error {
+	if opts.OutputPath == "" {
+		return errors.New("output path is required")
+	}
+	if opts.Format != "" && !slices.Contains(Formats, opts.Format) {
+		return fmt.Errorf("unknown format '%s', supported are %v", opts.Format, Formats)
+	}
+	if opts.ReportPath != "" && opts.DiffAgainst == "" && !isReadableFormat(opts.Format) {
+		return fmt.Errorf("the previous emoji map cannot be read in format '%s', use a diff against path", opts.Format)
+	}
+
+	emojis, sources, err := g.loadEmojis(ctx, opts)
+	if err != nil {
+		return err
+	}
+	curation := Overrides{}
+	if opts.OverridesPath != "" {
+		if curation, err = LoadOverrides(opts.OverridesPath); err != nil {
+			return err
+		}
+	}
+	emojis = curation.FilterEmojis(emojis)
+	emojiMap := BuildEmojiMap(emojis)
+	for _, keyword := range curation.RemoveKeywords {
+		if _, ok := emojiMap[keyword]; !ok {
+			g.logf("override removes unknown keyword '%s'\n", keyword)
+		}
+	}
+	curation.Apply(emojiMap)
+
+	// All sources are read before the first output is written,
+	// so a failing download does not leave the outputs out of sync.
+	var tables []PropertyTable
+	if opts.PropertiesGoOutputPath != "" {
+		var propertySources []goemoji.SourceMetadata
+		if tables, propertySources, err = g.buildPropertyTables(ctx, opts); err != nil {
+			return err
+		}
+		sources = append(sources, propertySources...)
+	}
+	localeMaps, err := g.buildLocaleMaps(ctx, opts, emojis)
+	if err != nil {
+		return err
+	}
+
+	if opts.ReportPath != "" {
+		previous, err := loadPreviousMap(opts)
+		if err != nil {
+			return err
+		}
+		if err := storeReport(compareMaps(previous, emojiLists(emojiMap)), opts.ReportPath, opts.ReportFormat); err != nil {
+			return err
+		}
+		g.logf("change report stored at: %s\n", opts.ReportPath)
+	}
+	if err := g.storeOutputs(opts, emojis, emojiMap, tables); err != nil {
+		return err
+	}
+	if err := g.storeMetadata(opts, sources); err != nil {
+		return err
+	}
+
+	maxKeyLength, longestKey := getMaxWordsInKey(emojiMap)
+	g.logf("longest key '%s' was '%d' words long\n", longestKey, maxKeyLength)
+
+	return g.storeLocaleMaps(opts, localeMaps)
+}
+
+// storeOutputs stores the emoji map and the tables derived from the emojis at the paths configured in opts.
+func (g *Generator) storeOutputs(opts Options, emojis []Emoji, emojiMap map[string][]goemoji.WeightedEmoji,
+	tables []PropertyTable) error {
+	if err := storeMap(emojiMap, opts.OutputPath, opts.Format); err != nil {
+		return err
+	}
+	g.logf("emoji map generated and stored at: %s\n", opts.OutputPath)
+
+	if opts.GoOutputPath != "" {
+		if err := storeMapToGoSource(emojiMap, opts.GoOutputPath); err != nil {
+			return err
+		}
+		g.logf("emoji map Go source stored at: %s\n", opts.GoOutputPath)
+	}
+
+	if opts.VariantsGoOutputPath != "" {
+		if err := storeVariantsGoSource(BuildVariants(emojis), opts.VariantsGoOutputPath); err != nil {
+			return err
+		}
+		g.logf("emoji variants Go source stored at: %s\n", opts.VariantsGoOutputPath)
+	}
+
+	if opts.CountriesGoOutputPath != "" {
+		if err := storeCountriesGoSource(BuildCountries(emojis), opts.CountriesGoOutputPath); err != nil {
+			return err
+		}
+		g.logf("countries Go source stored at: %s\n", opts.CountriesGoOutputPath)
+	}
+
+	if opts.PropertiesGoOutputPath != "" {
+		if err := storePropertyTablesGoSource(tables, opts.PropertiesGoOutputPath); err != nil {
+			return err
+		}
+		g.logf("Unicode property tables Go source stored at: %s\n", opts.PropertiesGoOutputPath)
+	}
+	return nil
+}
+
+func (g *Generator) logf(format string, args ...any) {
+	if g.Logger != nil {
+		g.Logger.Printf(format, args...)
+	}
+}
+
+// WriteJSON writes the emoji map as indented JSON.
+func WriteJSON(w io.Writer, emojiMap map[string][]goemoji.WeightedEmoji) error {
+	data, err := json.MarshalIndent(emojiMap, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling emoji map: %w", err)
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteGoSource writes the emoji map as sorted static tables of the goemoji package,
+// so the library does not need to parse the map at runtime.
+func WriteGoSource(w io.Writer, emojiMap map[string][]goemoji.WeightedEmoji) error {
+	return writeFormattedSource(w, renderGoSource(emojiMap))
+}
+
+func writeFormattedSource(w io.Writer, source []byte) error {
+	data, err := format.Source(source)
+	if err != nil {
+		return fmt.Errorf("error formatting Go source: %w", err)
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+func storeMapToJSON(emojiMap map[string][]goemoji.WeightedEmoji, filePath string) error {
+	return writeFile(filePath, func(w io.Writer) error { return WriteJSON(w, emojiMap) })
+}
+
+func storeMapToGoSource(emojiMap map[string][]goemoji.WeightedEmoji, filePath string) error {
+	return writeFile(filePath, func(w io.Writer) error { return WriteGoSource(w, emojiMap) })
+}
+
+// writeFile renders the file content completely before writing, so a failed
+// rendering does not leave a partially written file behind.
+func writeFile(filePath string, render func(w io.Writer) error) error {
+	var buffer bytes.Buffer
+	if err := render(&buffer); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filePath, buffer.Bytes(), filePermissions); err != nil {
+		return fmt.Errorf("error writing file: %w", err)
+	}
+	return nil
+}
+
+func renderGoSource(emojiMap map[string][]goemoji.WeightedEmoji) []byte {
+	maxWords, _ := getMaxWordsInKey(emojiMap)
+
+	var buffer bytes.Buffer
+	writeGoSourceHeader(&buffer)
+	fmt.Fprintf(&buffer, "// defaultMaxPhraseLength is the number of words of the longest keyword in defaultEntries.\n")
+	fmt.Fprintf(&buffer, "const defaultMaxPhraseLength = %d\n\n", maxWords)
+	fmt.Fprintf(&buffer, "// defaultEntries maps keywords to emojis, sorted by keyword.\n")
+	fmt.Fprintf(&buffer, "var defaultEntries = []dictionaryEntry{\n")
+	writeEntries(&buffer, emojiMap)
+	fmt.Fprintf(&buffer, "}\n\n")
+	fmt.Fprintf(&buffer, "// defaultEmojis contains every emoji of defaultEntries, sorted.\n")
+	fmt.Fprintf(&buffer, "var defaultEmojis = []string{\n")
+	writeEmojis(&buffer, emojiMap)
+	fmt.Fprintf(&buffer, "}\n")
+
+	return buffer.Bytes()
+}
+
+func writeGoSourceHeader(buffer *bytes.Buffer) {
+	fmt.Fprintf(buffer, "// Code generated by internal/main.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buffer, "package %s\n\n", goPackageName)
+}
+
+// writeEntries writes the dictionaryEntry literals of the map, sorted by keyword.
+func writeEntries(buffer *bytes.Buffer, emojiMap map[string][]goemoji.WeightedEmoji) {
+	for _, keyword := range sortedKeys(emojiMap) {
+		literals := make([]string, len(emojiMap[keyword]))
+		for i, emoji := range emojiMap[keyword] {
+			literals[i] = fmt.Sprintf("{%s, %s, %d}", strconv.Quote(emoji.Emoji), goSourceName(emoji.Source), emoji.Weight)
+		}
+		fmt.Fprintf(buffer, "{%s, []WeightedEmoji{%s}},\n", strconv.Quote(keyword), strings.Join(literals, ", "))
+	}
+}
+
+// goSourceName returns the name of the KeywordSource constant of the goemoji package.
+func goSourceName(source goemoji.KeywordSource) string {
+	name := source.String()
+	return "Source" + strings.ToUpper(name[:1]) + name[1:]
+}
+
+// writeEmojis writes every emoji of the map once, sorted.
+func writeEmojis(buffer *bytes.Buffer, emojiMap map[string][]goemoji.WeightedEmoji) {
+	emojiSet := make(map[string]bool)
+	for _, emojis := range emojiMap {
+		for _, emoji := range emojis {
+			emojiSet[emoji.Emoji] = true
+		}
+	}
+
+	emojis := make([]string, 0, len(emojiSet))
+	for emoji := range emojiSet {
+		emojis = append(emojis, emoji)
+	}
+	sort.Strings(emojis)
+
+	for _, emoji := range emojis {
+		fmt.Fprintf(buffer, "%s,\n", strconv.Quote(emoji))
+	}
+}
+
+// readInput reads the local file if a path is given and downloads the URL otherwise.
+func (g *Generator) readInput(ctx context.Context, filePath, url string) ([]byte, error) {
+	if filePath != "" && url != "" {
+		return nil, fmt.Errorf("input '%s' and '%s' cannot be used together", filePath, url)
+	}
+	if filePath != "" {
+		return readFile(filePath)
+	}
+	return g.fetchURL(ctx, url)
+}
+
+// fetchURL downloads the URL. It returns an error wrapping ErrNotFound if the URL does not exist.
+func (g *Generator) fetchURL(ctx context.Context, url string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	resp, err := g.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching data: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("error fetching '%s': %w", url, ErrNotFound)
+	}
+	// Check HTTP status code for security
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP request failed with status: %d", resp.StatusCode)
+	}
+
+	data, err := readLimited(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+	return data, nil
+}
+
+func readFile(filePath string) ([]byte, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening input file: %w", err)
+	}
+	defer file.Close()
+
+	data, err := readLimited(file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading input file: %w", err)
+	}
+	return data, nil
+}
+
+// readLimited reads at most maxResponseSize bytes to prevent memory exhaustion
+// and fails on larger inputs instead of silently truncating them.
+func readLimited(reader io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, maxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxResponseSize {
+		return nil, fmt.Errorf("input exceeds the limit of %d bytes", maxResponseSize)
+	}
+	return data, nil
+}
+
+func parseGemoji(data []byte) ([]Emoji, error) {
+	var emojis []Emoji
+	err := json.Unmarshal(data, &emojis)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling JSON: %w", err)
+	}
+
+	return emojis, nil
+}
+
+// BuildEmojiMap maps the lowercased descriptions, aliases and tags of the emojis to the emojis.
+// The emojis of each keyword are weighted by the source of the keyword and ordered by descending weight.
+func BuildEmojiMap(emojis []Emoji) map[string][]goemoji.WeightedEmoji {
+	return buildEmojiMapWithCase(emojis, strings.ToLower)
+}
+
+// buildEmojiMapWithCase builds the emoji map with the lowercasing of a locale.
+func buildEmojiMapWithCase(emojis []Emoji, toLower func(string) string) map[string][]goemoji.WeightedEmoji {
+	emojiMap := make(map[string][]goemoji.WeightedEmoji)
+	for _, emoji := range emojis {
+		addToMap(emojiMap, toLower(emoji.Description), emoji.Emoji, goemoji.SourceDescription)
+		for _, alias := range emoji.Aliases {
+			addToMap(emojiMap, toLower(alias), emoji.Emoji, goemoji.SourceAlias)
+		}
+		for _, tag := range emoji.Tags {
+			addToMap(emojiMap, toLower(tag), emoji.Emoji, goemoji.SourceTag)
+		}
+	}
+	for _, weighted := range emojiMap {
+		goemoji.SortByWeight(weighted)
+	}
+
+	return emojiMap
+}
+
+func getMaxWordsInKey[V any](emojiMap map[string]V) (maxWords int, longestKey string) {
+	maxWords = 0
+	longestKey = ""
+
+	for key := range emojiMap {
+		numberOfWords := phrase.Length(key)
+		if numberOfWords > maxWords {
+			maxWords = numberOfWords
+			longestKey = key
+		}
+	}
+	return maxWords, longestKey
+}
+
+// addToMap adds the emoji to the key with the default weight of the source. Names of emojis are
+// prepended and tags appended. An emoji which is added again keeps the higher weight.
+func addToMap(m map[string][]goemoji.WeightedEmoji, key, emoji string, source goemoji.KeywordSource) {
+	sanitizedKey := strings.TrimSpace(strings.ReplaceAll(key, "_", " "))
+	if sanitizedKey == "" {
+		return
+	}
+
+	if _, ok := m[sanitizedKey]; !ok {
+		m[sanitizedKey] = []goemoji.WeightedEmoji{}
+	}
+
+	weighted := goemoji.WeightedEmoji{Emoji: emoji, Source: source, Weight: source.DefaultWeight()}
+	for i, existing := range m[sanitizedKey] {
+		if existing.Emoji == emoji {
+			if weighted.Weight > existing.Weight {
+				m[sanitizedKey][i] = weighted
+			}
+			return
+		}
+	}
+
+	if source == goemoji.SourceTag {
+		m[sanitizedKey] = append(m[sanitizedKey], weighted)
+	} else {
+		m[sanitizedKey] = append([]goemoji.WeightedEmoji{weighted}, m[sanitizedKey]...)
+	}
+}
+
+// emojiLists returns the emojis of each keyword without their weights.
+func emojiLists(emojiMap map[string][]goemoji.WeightedEmoji) map[string][]string {
+	result := make(map[string][]string, len(emojiMap))
+	for keyword, weighted := range emojiMap {
+		result[keyword] = goemoji.EmojiStrings(weighted)
+	}
+	return result
+}
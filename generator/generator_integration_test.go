@@ -0,0 +1,146 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+const testInputPath = "testdata/emoji.json"
+
+func Test_Generate(t *testing.T) {
+	outputDir := t.TempDir()
+	filePath := path.Join(outputDir, "emoji_map.json")
+	goFilePath := path.Join(outputDir, "dictionary_data.go")
+
+	opts := Options{InputPath: testInputPath, OutputPath: filePath, GoOutputPath: goFilePath}
+	err := New(nil).Generate(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for _, outputPath := range []string{filePath, goFilePath} {
+		_, err := os.Stat(outputPath)
+		if err != nil {
+			t.Errorf("Expected output file to exist, but got error: %v", err)
+		}
+	}
+}
+
+func Test_Generate_Errors(t *testing.T) {
+	outputDir := t.TempDir()
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{name: "missing output path", opts: Options{InputPath: testInputPath}},
+		{name: "missing overrides", opts: Options{
+			InputPath:     testInputPath,
+			OutputPath:    path.Join(outputDir, "emoji_map.json"),
+			OverridesPath: "testdata/missing.json",
+		}},
+		{name: "unknown report format", opts: Options{
+			InputPath:    testInputPath,
+			OutputPath:   path.Join(outputDir, "emoji_map.json"),
+			ReportPath:   path.Join(outputDir, "report.txt"),
+			ReportFormat: "xml",
+		}},
+		{name: "unknown format", opts: Options{
+			InputPath:  testInputPath,
+			OutputPath: path.Join(outputDir, "emoji_map.xml"),
+			Format:     "xml",
+		}},
+		{name: "unreadable previous format", opts: Options{
+			InputPath:  testInputPath,
+			OutputPath: path.Join(outputDir, "emoji_map.csv"),
+			Format:     FormatCSV,
+			ReportPath: path.Join(outputDir, "report.txt"),
+		}},
+		{name: "missing locale", opts: Options{
+			InputPath:  testInputPath,
+			OutputPath: path.Join(outputDir, "emoji_map.json"),
+			Locales:    "xx",
+			CLDRDir:    testCLDRDir,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := New(nil).Generate(context.Background(), tt.opts); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func Test_storeMapToJSON_ValidMap(t *testing.T) {
+	emojiMap := weightedMap(map[string][]string{
+		"smile": {"😄", "😃"},
+		"laugh": {"🤣"},
+	})
+	filePath := path.Join(t.TempDir(), "unittest_emoji_map.json")
+
+	if err := storeMapToJSON(emojiMap, filePath); err != nil {
+		t.Fatalf("Error storing map: %v", err)
+	}
+
+	var actualMap map[string][]goemoji.WeightedEmoji
+	err := json.Unmarshal(mustReadFile(t, filePath), &actualMap)
+	if err != nil {
+		t.Errorf("Error unmarshaling JSON: %v", err)
+	}
+
+	if !reflect.DeepEqual(emojiMap, actualMap) {
+		t.Errorf("Expected emoji map: %v, got: %v", emojiMap, actualMap)
+	}
+}
+
+func Test_storeMapToJSON_InvalidPath(t *testing.T) {
+	filePath := path.Join(t.TempDir(), "missing", "emoji_map.json")
+
+	if err := storeMapToJSON(map[string][]goemoji.WeightedEmoji{}, filePath); err == nil {
+		t.Error("Expected an error for a missing directory")
+	}
+}
+
+func Test_storeMapToGoSource(t *testing.T) {
+	emojiMap := weightedMap(map[string][]string{
+		"smile":        {"😄", "😃"},
+		"laugh":        {"🤣"},
+		"woman artist": {"👩\u200d🎨"},
+	})
+	filePath := path.Join(t.TempDir(), "unittest_dictionary_data.go")
+
+	if err := storeMapToGoSource(emojiMap, filePath); err != nil {
+		t.Fatalf("Error storing Go source: %v", err)
+	}
+
+	data := mustReadFile(t, filePath)
+	if _, err := parser.ParseFile(token.NewFileSet(), filePath, data, 0); err != nil {
+		t.Errorf("Generated source does not parse: %v", err)
+	}
+
+	source := string(data)
+	expectedLines := []string{
+		"package goemoji",
+		"const defaultMaxPhraseLength = 2",
+		`{"laugh", []WeightedEmoji{{"🤣", SourceTag, 10}}},`,
+		`{"smile", []WeightedEmoji{{"😄", SourceTag, 10}, {"😃", SourceTag, 10}}},`,
+		`{"woman artist", []WeightedEmoji{{"👩\u200d🎨", SourceTag, 10}}},`,
+	}
+	for _, expected := range expectedLines {
+		if !strings.Contains(source, expected) {
+			t.Errorf("Expected generated source to contain '%s', got:\n%s", expected, source)
+		}
+	}
+	if strings.Index(source, `"laugh"`) > strings.Index(source, `"smile"`) {
+		t.Error("Expected keywords to be sorted")
+	}
+}
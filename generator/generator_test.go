@@ -0,0 +1,231 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+const testGemoji = `
+[
+	{
+		"emoji": "😀",
+		"description": "grinning face",
+		"category": "Smileys & Emotion",
+		"aliases": [
+			"grinning_face"
+		],
+		"tags": [
+			"smile",
+			"happy"
+		],
+		"unicode_version": "6.1",
+		"ios_version": "6.0"
+	},
+	{
+		"emoji": "🍽️",
+		"description": "fork and knife with plate",
+		"category": "Food & Drink",
+		"aliases": [
+			"plate_with_cutlery"
+		],
+		"tags": [
+			"dining",
+			"dinner"
+		],
+		"unicode_version": "7.0",
+		"ios_version": "9.1"
+	}
+]`
+
+func mustReadFile(t *testing.T, filePath string) []byte {
+	t.Helper()
+	data, err := readFile(filePath)
+	if err != nil {
+		t.Fatalf("Error reading file: %v", err)
+	}
+	return data
+}
+
+// weightedMap weights all emojis of the map as tags.
+func weightedMap(emojiMap map[string][]string) map[string][]goemoji.WeightedEmoji {
+	result := make(map[string][]goemoji.WeightedEmoji, len(emojiMap))
+	for keyword, emojis := range emojiMap {
+		for _, emoji := range emojis {
+			result[keyword] = append(result[keyword],
+				goemoji.WeightedEmoji{Emoji: emoji, Source: goemoji.SourceTag, Weight: goemoji.WeightTag})
+		}
+	}
+	return result
+}
+
+func mustParseGemoji(t *testing.T, filePath string) []Emoji {
+	t.Helper()
+	emojis, err := parseGemoji(mustReadFile(t, filePath))
+	if err != nil {
+		t.Fatalf("Error parsing gemoji: %v", err)
+	}
+	return emojis
+}
+
+func Test_LoadEmojis_URL(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(testGemoji))
+		if err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+
+	opts := Options{InputURL: ts.URL, InputSHA256: testSHA256(testGemoji)}
+	emojis, err := New(ts.Client()).LoadEmojis(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	result := BuildEmojiMap(emojis)
+	expectedNumberOfElements := 7
+	if len(result) != expectedNumberOfElements {
+		t.Errorf("Expected %d emojis, got %d", expectedNumberOfElements, len(result))
+	}
+
+	notAllowedCharacter := "_"
+	for key := range result {
+		if strings.Contains(key, notAllowedCharacter) {
+			t.Errorf("key '%s' should not contain '%s'", key, notAllowedCharacter)
+		}
+	}
+}
+
+func Test_LoadEmojis_URLErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{name: "bad status", status: http.StatusInternalServerError, wantErr: "status: 500"},
+		{name: "not found", status: http.StatusNotFound, wantErr: ErrNotFound.Error()},
+		{name: "oversized body", status: http.StatusOK, body: strings.Repeat(" ", maxResponseSize+1),
+			wantErr: "exceeds the limit"},
+		{name: "malformed JSON", status: http.StatusOK, body: `[{"emoji": `, wantErr: "error unmarshaling JSON"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer ts.Close()
+
+			opts := Options{InputURL: ts.URL, InputSHA256: testSHA256(tt.body)}
+			_, err := New(ts.Client()).LoadEmojis(context.Background(), opts)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing '%s', got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func Test_LoadEmojis_CanceledContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testGemoji))
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := New(ts.Client()).LoadEmojis(ctx, Options{InputURL: ts.URL, InputSHA256: testSHA256(testGemoji)})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func Test_LoadEmojis_File(t *testing.T) {
+	emojis, err := New(nil).LoadEmojis(context.Background(), Options{InputPath: testInputPath})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	result := emojiLists(BuildEmojiMap(emojis))
+
+	expected := map[string][]string{
+		"smile":              {"😄", "😀"},
+		"happy":              {"😀", "😄"},
+		"+1":                 {"👍"},
+		"plate with cutlery": {"🍽️"},
+	}
+	for keyword, emojis := range expected {
+		if !reflect.DeepEqual(result[keyword], emojis) {
+			t.Errorf("Expected '%s' to map to %v, got %v", keyword, emojis, result[keyword])
+		}
+	}
+}
+
+func Test_LoadEmojis_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{name: "unknown source", opts: Options{Sources: "unknown"}},
+		{name: "path and URL", opts: Options{InputPath: testInputPath, InputURL: "http://localhost"}},
+		{name: "missing file", opts: Options{InputPath: "testdata/missing.json"}},
+		{name: "unicode without input", opts: Options{Sources: "unicode"}},
+		{name: "cldr without input", opts: Options{Sources: "cldr"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(nil).LoadEmojis(context.Background(), tt.opts); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func Test_BuildEmojiMap_Weights(t *testing.T) {
+	emojiMap := BuildEmojiMap([]Emoji{
+		{Emoji: "😀", Description: "grinning face", Tags: []string{"smile"}},
+		{Emoji: "😄", Description: "grinning face with smiling eyes", Aliases: []string{"smile"}},
+		{Emoji: "😺", Description: "grinning cat", Tags: []string{"grinning face"}},
+	})
+
+	tests := []struct {
+		keyword string
+		want    []goemoji.WeightedEmoji
+	}{
+		{keyword: "smile", want: []goemoji.WeightedEmoji{
+			{Emoji: "😄", Source: goemoji.SourceAlias, Weight: goemoji.WeightAlias},
+			{Emoji: "😀", Source: goemoji.SourceTag, Weight: goemoji.WeightTag},
+		}},
+		{keyword: "grinning face", want: []goemoji.WeightedEmoji{
+			{Emoji: "😀", Source: goemoji.SourceDescription, Weight: goemoji.WeightDescription},
+			{Emoji: "😺", Source: goemoji.SourceTag, Weight: goemoji.WeightTag},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.keyword, func(t *testing.T) {
+			if got := emojiMap[tt.keyword]; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_getMaxWordsInKey(t *testing.T) {
+	emojiMap := map[string][]string{
+		"green apple": {"🍏"},
+		"ラーメン":        {"🍜"},
+		"寿司":          {"🍣"},
+	}
+
+	maxWords, longestKey := getMaxWordsInKey(emojiMap)
+	if maxWords != 4 || longestKey != "ラーメン" {
+		t.Errorf("Expected 'ラーメン' with 4 words, got '%s' with %d", longestKey, maxWords)
+	}
+}
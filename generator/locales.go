@@ -0,0 +1,150 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jo-hoe/goemoji"
+	"github.com/jo-hoe/goemoji/internal/casing"
+)
+
+const (
+	// DefaultCLDRBaseURL is the URL of the CLDR 'common' directory of release 44, which covers Unicode 15.1.
+	DefaultCLDRBaseURL      = "https://raw.githubusercontent.com/unicode-org/cldr/release-44/common"
+	cldrAnnotationsDir      = "annotations"
+	cldrDerivedDir          = "annotationsDerived"
+	localeOutputFilePattern = "emoji_map_%s.json"
+)
+
+// buildLocaleMaps creates the dictionaries of all locales in opts.Locales.
+func (g *Generator) buildLocaleMaps(ctx context.Context, opts Options,
+	emojis []Emoji) (map[string]map[string][]goemoji.WeightedEmoji, error) {
+	localeMaps := make(map[string]map[string][]goemoji.WeightedEmoji)
+	for _, locale := range strings.Split(opts.Locales, ",") {
+		if locale = strings.TrimSpace(locale); locale != "" {
+			localeMap, err := g.GenerateLocaleMap(ctx, opts, locale, emojis)
+			if err != nil {
+				return nil, err
+			}
+			localeMaps[casing.CanonicalLocale(locale)] = localeMap
+		}
+	}
+	return localeMaps, nil
+}
+
+// storeLocaleMaps stores the dictionaries of the locales at the paths configured in opts.
+func (g *Generator) storeLocaleMaps(opts Options, localeMaps map[string]map[string][]goemoji.WeightedEmoji) error {
+	if opts.LocaleOutputDir != "" {
+		for locale, localeMap := range localeMaps {
+			filePath := filepath.Join(opts.LocaleOutputDir, fmt.Sprintf(localeOutputFilePattern, locale))
+			if err := storeMapToJSON(localeMap, filePath); err != nil {
+				return err
+			}
+			g.logf("emoji map of locale '%s' stored at: %s\n", locale, filePath)
+		}
+	}
+	if opts.LocaleGoOutputPath != "" {
+		err := writeFile(opts.LocaleGoOutputPath, func(w io.Writer) error { return WriteLocalesGoSource(w, localeMaps) })
+		if err != nil {
+			return err
+		}
+		g.logf("locale emoji maps Go source stored at: %s\n", opts.LocaleGoOutputPath)
+	}
+	return nil
+}
+
+// GenerateLocaleMap builds the emoji map of a locale from its CLDR annotations.
+// Only the given emojis are included, using their representation.
+func (g *Generator) GenerateLocaleMap(ctx context.Context, opts Options, locale string,
+	emojis []Emoji) (map[string][]goemoji.WeightedEmoji, error) {
+	representations := make(map[string]string, len(emojis))
+	for _, emoji := range emojis {
+		representations[emojiKey(emoji.Emoji)] = emoji.Emoji
+	}
+
+	localeEmojis := make([]Emoji, 0)
+	for _, dir := range []string{cldrAnnotationsDir, cldrDerivedDir} {
+		data, err := g.readCLDRFile(ctx, opts, dir, locale)
+		if errors.Is(err, ErrNotFound) && dir == cldrDerivedDir {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading CLDR annotations of locale '%s': %w", locale, err)
+		}
+
+		_, annotated, err := parseCLDRAnnotations(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, emoji := range annotated {
+			if representation, ok := representations[emojiKey(emoji.Emoji)]; ok {
+				emoji.Emoji = representation
+				localeEmojis = append(localeEmojis, emoji)
+			}
+		}
+	}
+
+	locale = casing.CanonicalLocale(locale)
+	return buildEmojiMapWithCase(localeEmojis, func(text string) string { return casing.ToLower(locale, text) }), nil
+}
+
+// readCLDRFile reads the annotation file of the locale from the CLDR directory or URL.
+// It returns an error wrapping ErrNotFound if the file does not exist.
+func (g *Generator) readCLDRFile(ctx context.Context, opts Options, dir, locale string) ([]byte, error) {
+	fileName := locale + ".xml"
+	if opts.CLDRDir != "" {
+		filePath := filepath.Join(opts.CLDRDir, dir, fileName)
+		if _, err := os.Stat(filePath); errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("error reading '%s': %w", filePath, ErrNotFound)
+		}
+		return readFile(filePath)
+	}
+
+	baseURL := opts.CLDRBaseURL
+	if baseURL == "" {
+		baseURL = DefaultCLDRBaseURL
+	}
+	return g.fetchURL(ctx, strings.Join([]string{strings.TrimSuffix(baseURL, "/"), dir, fileName}, "/"))
+}
+
+// WriteLocalesGoSource writes the locale emoji maps as static tables of the goemoji package.
+func WriteLocalesGoSource(w io.Writer, localeMaps map[string]map[string][]goemoji.WeightedEmoji) error {
+	return writeFormattedSource(w, renderLocalesGoSource(localeMaps))
+}
+
+func renderLocalesGoSource(localeMaps map[string]map[string][]goemoji.WeightedEmoji) []byte {
+	locales := make([]string, 0, len(localeMaps))
+	for locale := range localeMaps {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	var buffer bytes.Buffer
+	writeGoSourceHeader(&buffer)
+	fmt.Fprintf(&buffer, "// localeTables maps locales to their generated dictionaries.\n")
+	fmt.Fprintf(&buffer, "var localeTables = map[string]*tableDictionary{\n")
+	for _, locale := range locales {
+		maxWords, _ := getMaxWordsInKey(localeMaps[locale])
+		fmt.Fprintf(&buffer, "%s: {\n", strconv.Quote(locale))
+		fmt.Fprintf(&buffer, "entries: []dictionaryEntry{\n")
+		writeEntries(&buffer, localeMaps[locale])
+		fmt.Fprintf(&buffer, "},\n")
+		fmt.Fprintf(&buffer, "emojis: []string{\n")
+		writeEmojis(&buffer, localeMaps[locale])
+		fmt.Fprintf(&buffer, "},\n")
+		fmt.Fprintf(&buffer, "maxPhraseLength: %d,\n", maxWords)
+		fmt.Fprintf(&buffer, "},\n")
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	return buffer.Bytes()
+}
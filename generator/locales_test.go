@@ -0,0 +1,82 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+const testCLDRDir = "testdata/cldr"
+
+func Test_generateLocaleMap(t *testing.T) {
+	emojis := mustParseGemoji(t, testInputPath)
+
+	localeMap, err := New(nil).GenerateLocaleMap(context.Background(), Options{CLDRDir: testCLDRDir}, "de", emojis)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	result := emojiLists(localeMap)
+
+	expected := map[string][]string{
+		"grinsendes gesicht":          {"😀"},
+		"daumen hoch":                 {"👍"},
+		"apfel":                       {"🍎"},
+		"gabel und messer mit teller": {"🍽️"},
+		"flagge: deutschland":         {"🇩🇪"},
+	}
+	for keyword, want := range expected {
+		if !reflect.DeepEqual(result[keyword], want) {
+			t.Errorf("Expected '%s' to map to %v, got %v", keyword, want, result[keyword])
+		}
+	}
+	if _, ok := result["partyknaller"]; ok {
+		t.Error("Expected emoji unknown to the main sources to be skipped")
+	}
+}
+
+func Test_buildLocaleMaps(t *testing.T) {
+	outputDir := t.TempDir()
+	goFilePath := path.Join(outputDir, "dictionary_locales.go")
+
+	g := New(nil)
+	opts := Options{
+		Locales:            "de",
+		CLDRDir:            testCLDRDir,
+		LocaleOutputDir:    outputDir,
+		LocaleGoOutputPath: goFilePath,
+	}
+	localeMaps, err := g.buildLocaleMaps(context.Background(), opts, mustParseGemoji(t, testInputPath))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := g.storeLocaleMaps(opts, localeMaps); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var localeMap map[string][]goemoji.WeightedEmoji
+	if err := json.Unmarshal(mustReadFile(t, path.Join(outputDir, "emoji_map_de.json")), &localeMap); err != nil {
+		t.Fatalf("Error unmarshaling JSON: %v", err)
+	}
+	if !reflect.DeepEqual(emojiLists(localeMap)["apfel"], []string{"🍎"}) {
+		t.Errorf("Expected 'apfel' in locale map, got %v", localeMap["apfel"])
+	}
+
+	data, err := os.ReadFile(goFilePath)
+	if err != nil {
+		t.Fatalf("Error reading file: %v", err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), goFilePath, data, 0); err != nil {
+		t.Errorf("Generated source does not parse: %v", err)
+	}
+	if !strings.Contains(string(data), `"de": {`) {
+		t.Errorf("Expected generated source to contain locale 'de', got:\n%s", data)
+	}
+}
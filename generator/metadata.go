@@ -0,0 +1,133 @@
+package generator
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+// sourceMetadata records where the data of a source was read from and its checksum.
+func sourceMetadata(name, filePath, url string, data []byte) goemoji.SourceMetadata {
+	checksum := sha256.Sum256(data)
+	metadata := goemoji.SourceMetadata{Name: name, SHA256: hex.EncodeToString(checksum[:])}
+	if filePath != "" {
+		metadata.Path = filePath
+	} else {
+		metadata.URL = url
+	}
+	return metadata
+}
+
+// verifyChecksum fails if an expected checksum is given and the source does not match it.
+func verifyChecksum(metadata goemoji.SourceMetadata, expected string) error {
+	if expected == "" || strings.EqualFold(expected, metadata.SHA256) {
+		return nil
+	}
+	return fmt.Errorf("checksum mismatch of source '%s': expected %s, got %s", metadata.Name, expected, metadata.SHA256)
+}
+
+// readMetadata reads the metadata of the previous generation. It returns empty metadata
+// if no path is given or the file does not exist yet.
+func readMetadata(filePath string) (goemoji.DictionaryMetadata, error) {
+	var metadata goemoji.DictionaryMetadata
+	if filePath == "" {
+		return metadata, nil
+	}
+	data, err := readFile(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return metadata, nil
+	}
+	if err != nil {
+		return metadata, err
+	}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return metadata, fmt.Errorf("error unmarshaling metadata '%s': %w", filePath, err)
+	}
+	return metadata, nil
+}
+
+// storeMetadata writes the metadata of the sources to the paths configured in opts.
+// The time of the previous generation is kept if the sources did not change.
+func (g *Generator) storeMetadata(opts Options, sources []goemoji.SourceMetadata) error {
+	metadata := goemoji.DictionaryMetadata{Sources: sources, GeneratedAt: g.now().UTC().Truncate(time.Second)}
+	previous, err := readMetadata(opts.MetadataPath)
+	if err != nil {
+		return err
+	}
+	if slices.Equal(previous.Sources, sources) && !previous.GeneratedAt.IsZero() {
+		metadata.GeneratedAt = previous.GeneratedAt
+	}
+	if opts.MetadataPath != "" {
+		err := writeFile(opts.MetadataPath, func(w io.Writer) error { return WriteMetadataJSON(w, metadata) })
+		if err != nil {
+			return err
+		}
+		g.logf("metadata stored at: %s\n", opts.MetadataPath)
+	}
+	if opts.MetadataGoOutputPath != "" {
+		err := writeFile(opts.MetadataGoOutputPath, func(w io.Writer) error { return WriteMetadataGoSource(w, metadata) })
+		if err != nil {
+			return err
+		}
+		g.logf("metadata Go source stored at: %s\n", opts.MetadataGoOutputPath)
+	}
+	return nil
+}
+
+// WriteMetadataJSON writes the metadata as indented JSON.
+func WriteMetadataJSON(w io.Writer, metadata goemoji.DictionaryMetadata) error {
+	data, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling metadata: %w", err)
+	}
+
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteMetadataGoSource writes the metadata as the info of the default dictionary of the goemoji package.
+func WriteMetadataGoSource(w io.Writer, metadata goemoji.DictionaryMetadata) error {
+	return writeFormattedSource(w, renderMetadataGoSource(metadata))
+}
+
+func renderMetadataGoSource(metadata goemoji.DictionaryMetadata) []byte {
+	var buffer bytes.Buffer
+	writeGoSourceHeader(&buffer)
+	if !metadata.GeneratedAt.IsZero() {
+		fmt.Fprintf(&buffer, "import \"time\"\n\n")
+	}
+	fmt.Fprintf(&buffer, "// defaultDictionaryInfo describes the sources of defaultEntries.\n")
+	fmt.Fprintf(&buffer, "var defaultDictionaryInfo = DictionaryMetadata{\n")
+	fmt.Fprintf(&buffer, "Sources: []SourceMetadata{\n")
+	for _, source := range metadata.Sources {
+		fields := []string{"Name: " + strconv.Quote(source.Name)}
+		for _, field := range []struct{ name, value string }{
+			{"URL", source.URL}, {"Path", source.Path}, {"Ref", source.Ref}, {"SHA256", source.SHA256},
+		} {
+			if field.value != "" {
+				fields = append(fields, field.name+": "+strconv.Quote(field.value))
+			}
+		}
+		fmt.Fprintf(&buffer, "{%s},\n", strings.Join(fields, ", "))
+	}
+	fmt.Fprintf(&buffer, "},\n")
+	if generatedAt := metadata.GeneratedAt.UTC(); !generatedAt.IsZero() {
+		fmt.Fprintf(&buffer, "GeneratedAt: time.Date(%d, %d, %d, %d, %d, %d, 0, time.UTC),\n",
+			generatedAt.Year(), generatedAt.Month(), generatedAt.Day(),
+			generatedAt.Hour(), generatedAt.Minute(), generatedAt.Second())
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	return buffer.Bytes()
+}
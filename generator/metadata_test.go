@@ -0,0 +1,211 @@
+package generator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+const testInputSHA256 = "7194aac097d526442c752e3de56ccd9919536decda17ef21ab3c7cf28c1c7eff"
+
+func Test_Generate_Metadata(t *testing.T) {
+	outputDir := t.TempDir()
+	metadataPath := path.Join(outputDir, "emoji_map_info.json")
+	goFilePath := path.Join(outputDir, "dictionary_info.go")
+
+	g := New(nil)
+	g.now = func() time.Time { return time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC) }
+	err := g.Generate(context.Background(), Options{
+		InputPath:            testInputPath,
+		InputSHA256:          strings.ToUpper(testInputSHA256),
+		OutputPath:           path.Join(outputDir, "emoji_map.json"),
+		MetadataPath:         metadataPath,
+		MetadataGoOutputPath: goFilePath,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var metadata goemoji.DictionaryMetadata
+	if err := json.Unmarshal(mustReadFile(t, metadataPath), &metadata); err != nil {
+		t.Fatalf("Error unmarshaling metadata: %v", err)
+	}
+	expected := goemoji.SourceMetadata{Name: sourceGemoji, Path: testInputPath, SHA256: testInputSHA256}
+	if len(metadata.Sources) != 1 || metadata.Sources[0] != expected {
+		t.Errorf("Expected sources [%+v], got %+v", expected, metadata.Sources)
+	}
+
+	data := mustReadFile(t, goFilePath)
+	if _, err := parser.ParseFile(token.NewFileSet(), goFilePath, data, 0); err != nil {
+		t.Errorf("Generated source does not parse: %v", err)
+	}
+	for _, want := range []string{`SHA256: "` + testInputSHA256 + `"`, "time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Expected generated source to contain '%s', got:\n%s", want, data)
+		}
+	}
+}
+
+func Test_LoadEmojis_Checksum(t *testing.T) {
+	_, err := New(nil).LoadEmojis(context.Background(), Options{InputPath: testInputPath, InputSHA256: "0000"})
+	if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("Expected checksum mismatch, got %v", err)
+	}
+}
+
+func Test_loadEmojis_Ref(t *testing.T) {
+	requestedPath := ""
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		_, _ = w.Write([]byte(testGemoji))
+	}))
+	defer ts.Close()
+
+	opts := Options{InputURL: ts.URL + "/v4.1.0", InputRef: "v4.1.0", InputSHA256: testSHA256(testGemoji)}
+	_, sources, err := New(ts.Client()).loadEmojis(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if requestedPath != "/v4.1.0" || sources[0].Ref != "v4.1.0" || sources[0].URL != ts.URL+"/v4.1.0" {
+		t.Errorf("Expected pinned ref to be recorded, got %+v", sources[0])
+	}
+}
+
+func Test_loadEmojis_DownloadChecksum(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(testGemoji))
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		name         string
+		checksum     string
+		wantError    string
+		wantRequests int
+	}{
+		{name: "no checksum", checksum: "", wantError: "no checksum is known", wantRequests: 0},
+		{name: "other checksum", checksum: "0000", wantError: "checksum mismatch", wantRequests: 1},
+		{name: "matching checksum", checksum: testSHA256(testGemoji), wantRequests: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = 0
+			opts := Options{InputURL: ts.URL + "/emoji.json", InputRef: "v4.1.0", InputSHA256: tt.checksum}
+			_, _, err := New(ts.Client()).loadEmojis(context.Background(), opts)
+			if tt.wantError == "" && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.wantError != "" && (err == nil || !strings.Contains(err.Error(), tt.wantError)) {
+				t.Errorf("Expected error containing '%s', got %v", tt.wantError, err)
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("Expected %d requests, got %d", tt.wantRequests, requests)
+			}
+		})
+	}
+}
+
+func Test_Generate_ReadsSourcesBeforeWriting(t *testing.T) {
+	outputDir := t.TempDir()
+	opts := Options{
+		InputPath:    testInputPath,
+		OutputPath:   path.Join(outputDir, "emoji_map.json"),
+		MetadataPath: path.Join(outputDir, "emoji_map_info.json"),
+		Locales:      "xx",
+		CLDRDir:      testCLDRDir,
+	}
+	if err := New(nil).Generate(context.Background(), opts); err == nil {
+		t.Fatal("Expected an error for a missing locale")
+	}
+	for _, filePath := range []string{opts.OutputPath, opts.MetadataPath} {
+		if _, err := os.Stat(filePath); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Expected '%s' not to be written, got %v", filePath, err)
+		}
+	}
+}
+
+func Test_Generate_MetadataPropertySources(t *testing.T) {
+	outputDir := t.TempDir()
+	opts := Options{
+		InputPath:              testInputPath,
+		EmojiDataPath:          testEmojiDataPath,
+		EastAsianWidthPath:     testEastAsianWidthPath,
+		OutputPath:             path.Join(outputDir, "emoji_map.json"),
+		PropertiesGoOutputPath: path.Join(outputDir, "unicode_properties.go"),
+		MetadataPath:           path.Join(outputDir, "emoji_map_info.json"),
+	}
+	if err := New(nil).Generate(context.Background(), opts); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	metadata, err := readMetadata(opts.MetadataPath)
+	if err != nil {
+		t.Fatalf("Error reading metadata: %v", err)
+	}
+	names := make([]string, len(metadata.Sources))
+	for i, source := range metadata.Sources {
+		names[i] = source.Name
+	}
+	if want := []string{sourceGemoji, sourceEmojiData, sourceEastAsianWidth}; !slices.Equal(names, want) {
+		t.Errorf("Expected sources %v, got %v", want, names)
+	}
+}
+
+func Test_Generate_MetadataKeepsGeneratedAt(t *testing.T) {
+	outputDir := t.TempDir()
+	metadataPath := path.Join(outputDir, "emoji_map_info.json")
+	generatedAt := time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC)
+	recorded := goemoji.DictionaryMetadata{
+		Sources:     []goemoji.SourceMetadata{{Name: sourceGemoji, Path: testInputPath, SHA256: testInputSHA256}},
+		GeneratedAt: generatedAt,
+	}
+	if err := storeTestMetadata(metadataPath, recorded); err != nil {
+		t.Fatalf("Error storing metadata: %v", err)
+	}
+
+	g := New(nil)
+	g.now = func() time.Time { return generatedAt.AddDate(0, 6, 0) }
+	opts := Options{
+		InputPath:    testInputPath,
+		OutputPath:   path.Join(outputDir, "emoji_map.json"),
+		MetadataPath: metadataPath,
+	}
+	if err := g.Generate(context.Background(), opts); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	metadata, err := readMetadata(metadataPath)
+	if err != nil {
+		t.Fatalf("Error reading metadata: %v", err)
+	}
+	if !metadata.GeneratedAt.Equal(generatedAt) {
+		t.Errorf("Expected unchanged sources to keep the time %v, got %v", generatedAt, metadata.GeneratedAt)
+	}
+}
+
+func testSHA256(data string) string {
+	checksum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(checksum[:])
+}
+
+func storeTestMetadata(filePath string, metadata goemoji.DictionaryMetadata) error {
+	return writeFile(filePath, func(w io.Writer) error { return WriteMetadataJSON(w, metadata) })
+}
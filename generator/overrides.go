@@ -0,0 +1,84 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+// Overrides are manual corrections of the generated emoji map which are
+// applied on every generation, so curation survives updates of the sources.
+type Overrides struct {
+	// RemoveKeywords are keywords which are dropped from the map.
+	RemoveKeywords []string `json:"removeKeywords"`
+	// PinPrimary maps keywords to the emoji which is moved to the front of their emojis.
+	PinPrimary map[string]string `json:"pinPrimary"`
+	// AddKeywords maps keywords to emojis which are added to their emojis.
+	AddKeywords map[string][]string `json:"addKeywords"`
+	// BlacklistEmojis are emojis which are removed from all keywords, including localized ones.
+	BlacklistEmojis []string `json:"blacklistEmojis"`
+}
+
+// LoadOverrides reads overrides from a JSON file.
+func LoadOverrides(filePath string) (Overrides, error) {
+	var result Overrides
+	data, err := readFile(filePath)
+	if err != nil {
+		return result, err
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return result, fmt.Errorf("error unmarshaling overrides: %w", err)
+	}
+
+	return result, nil
+}
+
+// FilterEmojis removes the blacklisted emojis from the source emojis.
+func (o Overrides) FilterEmojis(emojis []Emoji) []Emoji {
+	blacklist := make(map[string]bool, len(o.BlacklistEmojis))
+	for _, emoji := range o.BlacklistEmojis {
+		blacklist[emojiKey(emoji)] = true
+	}
+
+	result := make([]Emoji, 0, len(emojis))
+	for _, emoji := range emojis {
+		if !blacklist[emojiKey(emoji.Emoji)] {
+			result = append(result, emoji)
+		}
+	}
+	return result
+}
+
+// Apply changes the keywords of the emoji map. Keywords are added before
+// primary emojis are pinned, so added keywords can be pinned as well.
+// Added and pinned emojis get goemoji.SourceCustom and its default weight, so added
+// emojis rank before the upstream emojis of a keyword and pinned emojis before both.
+func (o Overrides) Apply(emojiMap map[string][]goemoji.WeightedEmoji) {
+	for _, keyword := range o.RemoveKeywords {
+		delete(emojiMap, keyword)
+	}
+
+	for _, keyword := range sortedKeys(o.AddKeywords) {
+		for _, emoji := range o.AddKeywords[keyword] {
+			if !slices.ContainsFunc(emojiMap[keyword], func(e goemoji.WeightedEmoji) bool { return e.Emoji == emoji }) {
+				emojiMap[keyword] = append(emojiMap[keyword],
+					goemoji.WeightedEmoji{Emoji: emoji, Source: goemoji.SourceCustom, Weight: goemoji.WeightCustom})
+			}
+		}
+		goemoji.SortByWeight(emojiMap[keyword])
+	}
+
+	for keyword, emoji := range o.PinPrimary {
+		emojis := make([]goemoji.WeightedEmoji, 0, len(emojiMap[keyword])+1)
+		emojis = append(emojis,
+			goemoji.WeightedEmoji{Emoji: emoji, Source: goemoji.SourceCustom, Weight: goemoji.WeightCustom})
+		for _, existing := range emojiMap[keyword] {
+			if existing.Emoji != emoji {
+				emojis = append(emojis, existing)
+			}
+		}
+		emojiMap[keyword] = emojis
+	}
+}
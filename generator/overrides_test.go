@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+const testOverridesPath = "testdata/overrides.json"
+
+func TestOverrides_Apply(t *testing.T) {
+	curation, err := LoadOverrides(testOverridesPath)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	emojis := curation.FilterEmojis(mustParseGemoji(t, testInputPath))
+	weighted := BuildEmojiMap(emojis)
+	curation.Apply(weighted)
+	emojiMap := emojiLists(weighted)
+
+	added := goemoji.WeightedEmoji{Emoji: "😀", Source: goemoji.SourceCustom, Weight: goemoji.SourceCustom.DefaultWeight()}
+	if got := weighted["grin"][0]; got != added {
+		t.Errorf("Expected added keyword to be %v, got %v", added, got)
+	}
+
+	tests := []struct {
+		keyword string
+		want    []string
+	}{
+		{keyword: "smile", want: nil},
+		{keyword: "happy", want: []string{"😄", "😀"}},
+		{keyword: "artist", want: []string{"🎨", "🧑‍🎨"}},
+		{keyword: "grin", want: []string{"😀"}},
+		{keyword: "thumbs up", want: []string{"👍"}},
+		{keyword: "germany", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.keyword, func(t *testing.T) {
+			if got := emojiMap[tt.keyword]; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected '%s' to map to %v, got %v", tt.keyword, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOverrides_FilterEmojis(t *testing.T) {
+	curation := Overrides{BlacklistEmojis: []string{"🍽"}}
+	emojis := []Emoji{{Emoji: "🍽️"}, {Emoji: "🍎"}}
+
+	if got := curation.FilterEmojis(emojis); len(got) != 1 || got[0].Emoji != "🍎" {
+		t.Errorf("Expected blacklisted emoji to be removed regardless of variation selectors, got %v", got)
+	}
+}
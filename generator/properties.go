@@ -0,0 +1,235 @@
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+const (
+	// DefaultEmojiDataURL is the URL of the Unicode emoji-data.txt the property tables are generated from.
+	DefaultEmojiDataURL = "https://www.unicode.org/Public/15.1.0/ucd/emoji/emoji-data.txt"
+	// DefaultEastAsianWidthURL is the URL of the Unicode EastAsianWidth.txt the width table is generated from.
+	DefaultEastAsianWidthURL = "https://www.unicode.org/Public/15.1.0/ucd/EastAsianWidth.txt"
+
+	sourceEmojiData      = "emoji-data"
+	sourceEastAsianWidth = "east-asian-width"
+)
+
+// CodePointRange is an inclusive range of code points.
+type CodePointRange struct {
+	Lo rune
+	Hi rune
+}
+
+// PropertyTable is a Unicode property which is written as a unicode.RangeTable.
+type PropertyTable struct {
+	Name    string
+	Comment string
+	Ranges  []CodePointRange
+}
+
+// emojiPropertyTables maps the properties of emoji-data.txt and the widths of
+// EastAsianWidth.txt to the tables of the goemoji package.
+var emojiPropertyTables = []struct {
+	properties []string
+	name       string
+	comment    string
+}{
+	{[]string{"Emoji"}, "emojiCharacters",
+		"emojiCharacters contains the characters with the Emoji property, including those displayed as text by default."},
+	{[]string{"Extended_Pictographic"}, "extendedPictographic",
+		"extendedPictographic contains the pictographic characters of emojis and the code points reserved for them."},
+	{[]string{"Emoji_Presentation"}, "emojiPresentation",
+		"emojiPresentation contains the emojis which are displayed as emoji without U+FE0F."},
+	{[]string{"Emoji_Modifier_Base"}, "emojiModifierBase",
+		"emojiModifierBase contains the characters which can be followed by a skin tone modifier."},
+	{[]string{"W", "F"}, "eastAsianWide",
+		"eastAsianWide contains the wide and fullwidth characters, which take two terminal cells."},
+}
+
+// buildPropertyTables reads the Unicode emoji-data.txt and EastAsianWidth.txt and returns
+// the property tables of the goemoji package with the metadata of both files.
+func (g *Generator) buildPropertyTables(ctx context.Context, opts Options) ([]PropertyTable,
+	[]goemoji.SourceMetadata, error) {
+	properties, emojiData, err := g.readProperties(ctx, sourceEmojiData,
+		opts.EmojiDataPath, opts.EmojiDataURL, DefaultEmojiDataURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	widths, eastAsianWidth, err := g.readProperties(ctx, sourceEastAsianWidth,
+		opts.EastAsianWidthPath, opts.EastAsianWidthURL, DefaultEastAsianWidthURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	maps.Copy(properties, widths)
+
+	tables := make([]PropertyTable, 0, len(emojiPropertyTables))
+	for _, table := range emojiPropertyTables {
+		var ranges []CodePointRange
+		for _, property := range table.properties {
+			propertyRanges, ok := properties[property]
+			if !ok {
+				return nil, nil, fmt.Errorf("property '%s' not found in the Unicode data", property)
+			}
+			ranges = append(ranges, propertyRanges...)
+		}
+		tables = append(tables, PropertyTable{Name: table.name, Comment: table.comment, Ranges: mergeRanges(ranges)})
+	}
+	return tables, []goemoji.SourceMetadata{emojiData, eastAsianWidth}, nil
+}
+
+// readProperties reads and parses a Unicode property file from the path or URL,
+// falling back to the default URL if neither is set.
+func (g *Generator) readProperties(ctx context.Context, name, path, url,
+	defaultURL string) (map[string][]CodePointRange, goemoji.SourceMetadata, error) {
+	if path == "" && url == "" {
+		url = defaultURL
+	}
+	data, err := g.readInput(ctx, path, url)
+	if err != nil {
+		return nil, goemoji.SourceMetadata{}, err
+	}
+	properties, err := parseProperties(data)
+	return properties, sourceMetadata(name, path, url, data), err
+}
+
+// parseProperties reads a Unicode property file like emoji-data.txt, whose lines have the
+// format "1F600..1F64F ; Emoji_Presentation # comment", and returns the merged ranges of
+// each property value.
+func parseProperties(data []byte) (map[string][]CodePointRange, error) {
+	properties := make(map[string][]CodePointRange)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		codePoints, property, found := strings.Cut(line, ";")
+		if !found {
+			continue
+		}
+
+		codePointRange, err := parseCodePointRange(strings.TrimSpace(codePoints))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing line %d: %w", lineNumber, err)
+		}
+		property = strings.TrimSpace(property)
+		properties[property] = append(properties[property], codePointRange)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading properties: %w", err)
+	}
+
+	for property, ranges := range properties {
+		properties[property] = mergeRanges(ranges)
+	}
+	return properties, nil
+}
+
+// parseCodePointRange parses a code point like "1F600" or a range like "1F600..1F64F".
+func parseCodePointRange(text string) (CodePointRange, error) {
+	lo, hi, isRange := strings.Cut(text, "..")
+	if !isRange {
+		hi = lo
+	}
+	first, err := strconv.ParseUint(lo, 16, 32)
+	if err != nil {
+		return CodePointRange{}, fmt.Errorf("invalid code point '%s'", lo)
+	}
+	last, err := strconv.ParseUint(hi, 16, 32)
+	if err != nil || last < first {
+		return CodePointRange{}, fmt.Errorf("invalid code point range '%s'", text)
+	}
+	return CodePointRange{Lo: rune(first), Hi: rune(last)}, nil
+}
+
+// mergeRanges sorts the ranges and merges overlapping and adjacent ones.
+func mergeRanges(ranges []CodePointRange) []CodePointRange {
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b CodePointRange) int {
+		return int(a.Lo - b.Lo)
+	})
+
+	merged := make([]CodePointRange, 0, len(sorted))
+	for _, r := range sorted {
+		if last := len(merged) - 1; last >= 0 && r.Lo <= merged[last].Hi+1 {
+			merged[last].Hi = max(merged[last].Hi, r.Hi)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+func storePropertyTablesGoSource(tables []PropertyTable, filePath string) error {
+	return writeFile(filePath, func(w io.Writer) error { return WritePropertyTablesGoSource(w, tables) })
+}
+
+// WritePropertyTablesGoSource writes the tables as unicode.RangeTable variables of the goemoji package.
+func WritePropertyTablesGoSource(w io.Writer, tables []PropertyTable) error {
+	return writeFormattedSource(w, renderPropertyTablesGoSource(tables))
+}
+
+func renderPropertyTablesGoSource(tables []PropertyTable) []byte {
+	var buffer bytes.Buffer
+	writeGoSourceHeader(&buffer)
+	fmt.Fprintf(&buffer, "import \"unicode\"\n\n")
+	for _, table := range tables {
+		writeRangeTable(&buffer, table)
+	}
+	return buffer.Bytes()
+}
+
+func writeRangeTable(buffer *bytes.Buffer, table PropertyTable) {
+	var r16, r32 []string
+	latinOffset := 0
+	for _, r := range mergeRanges(table.Ranges) {
+		if r.Lo <= unicode.MaxLatin1 && r.Hi > unicode.MaxLatin1 {
+			// ranges which are partly Latin-1 are split, so LatinOffset stays exact
+			r16 = append(r16, fmt.Sprintf("{0x%04X, 0x%04X, 1}", r.Lo, unicode.MaxLatin1))
+			latinOffset++
+			r.Lo = unicode.MaxLatin1 + 1
+		}
+		if r.Lo <= 0xFFFF && r.Hi > 0xFFFF {
+			r16 = append(r16, fmt.Sprintf("{0x%04X, 0xFFFF, 1}", r.Lo))
+			r.Lo = 0x10000
+		}
+		switch {
+		case r.Hi <= unicode.MaxLatin1:
+			latinOffset++
+			r16 = append(r16, fmt.Sprintf("{0x%04X, 0x%04X, 1}", r.Lo, r.Hi))
+		case r.Hi <= 0xFFFF:
+			r16 = append(r16, fmt.Sprintf("{0x%04X, 0x%04X, 1}", r.Lo, r.Hi))
+		default:
+			r32 = append(r32, fmt.Sprintf("{0x%05X, 0x%05X, 1}", r.Lo, r.Hi))
+		}
+	}
+
+	fmt.Fprintf(buffer, "// %s\n", table.Comment)
+	fmt.Fprintf(buffer, "var %s = &unicode.RangeTable{\n", table.Name)
+	fmt.Fprintf(buffer, "R16: []unicode.Range16{\n")
+	writeRanges(buffer, r16)
+	fmt.Fprintf(buffer, "},\n")
+	fmt.Fprintf(buffer, "R32: []unicode.Range32{\n")
+	writeRanges(buffer, r32)
+	fmt.Fprintf(buffer, "},\n")
+	fmt.Fprintf(buffer, "LatinOffset: %d,\n", latinOffset)
+	fmt.Fprintf(buffer, "}\n\n")
+}
+
+// writeRanges writes the range literals with a few ranges per line.
+func writeRanges(buffer *bytes.Buffer, ranges []string) {
+	const rangesPerLine = 4
+	for start := 0; start < len(ranges); start += rangesPerLine {
+		end := min(start+rangesPerLine, len(ranges))
+		fmt.Fprintf(buffer, "%s,\n", strings.Join(ranges[start:end], ", "))
+	}
+}
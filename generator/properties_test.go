@@ -0,0 +1,110 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"go/parser"
+	"go/token"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const (
+	testEmojiDataPath      = "testdata/emoji-data.txt"
+	testEastAsianWidthPath = "testdata/EastAsianWidth.txt"
+)
+
+func Test_parseProperties(t *testing.T) {
+	properties, err := parseProperties(mustReadFile(t, testEmojiDataPath))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		property string
+		want     []CodePointRange
+	}{
+		{property: "Emoji_Presentation", want: []CodePointRange{{0x231A, 0x231B}, {0x1F600, 0x1F64F}}},
+		{property: "Emoji_Modifier_Base", want: []CodePointRange{{0x261D, 0x261D}, {0x1F645, 0x1F647}, {0x1F64B, 0x1F64F}}},
+		{property: "Extended_Pictographic", want: []CodePointRange{
+			{0x00A9, 0x00A9}, {0x00AE, 0x00AE}, {0x231A, 0x231B}, {0x1F600, 0x1F64F}, {0x1FC00, 0x1FFFD},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.property, func(t *testing.T) {
+			if got := properties[tt.property]; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_parseProperties_Invalid(t *testing.T) {
+	for _, data := range []string{"XYZ ; Emoji", "1F64F..1F600 ; Emoji", "1F600..ZZZ ; Emoji"} {
+		if _, err := parseProperties([]byte(data)); err == nil {
+			t.Errorf("Expected an error for '%s'", data)
+		}
+	}
+}
+
+func Test_WritePropertyTablesGoSource(t *testing.T) {
+	table := PropertyTable{
+		Name:    "testTable",
+		Comment: "testTable is a test.",
+		Ranges:  []CodePointRange{{0x41, 0x5A}, {0xE0, 0x2FF}, {0xFFF0, 0x10010}},
+	}
+
+	var buffer bytes.Buffer
+	if err := WritePropertyTablesGoSource(&buffer, []PropertyTable{table}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "", buffer.Bytes(), parser.AllErrors); err != nil {
+		t.Fatalf("Expected valid Go source, got %v", err)
+	}
+	for _, want := range []string{
+		"{0x0041, 0x005A, 1}, {0x00E0, 0x00FF, 1}, {0x0100, 0x02FF, 1}, {0xFFF0, 0xFFFF, 1},",
+		"{0x10000, 0x10010, 1},",
+		"LatinOffset: 2,",
+	} {
+		if !strings.Contains(buffer.String(), want) {
+			t.Errorf("Expected Go source to contain '%s', got %s", want, buffer.String())
+		}
+	}
+}
+
+func Test_buildPropertyTables(t *testing.T) {
+	outputPath := path.Join(t.TempDir(), "unicode_properties.go")
+	opts := Options{EmojiDataPath: testEmojiDataPath, EastAsianWidthPath: testEastAsianWidthPath}
+
+	tables, sources, err := New(nil).buildPropertyTables(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := storePropertyTablesGoSource(tables, outputPath); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	source := string(mustReadFile(t, outputPath))
+	for _, name := range []string{
+		"emojiCharacters", "extendedPictographic", "emojiPresentation", "emojiModifierBase", "eastAsianWide",
+	} {
+		if !strings.Contains(source, "var "+name+" = &unicode.RangeTable{") {
+			t.Errorf("Expected table '%s' in %s", name, source)
+		}
+	}
+	for _, want := range []string{"{0x3000, 0x3000, 1}", "{0x4E00, 0x9FFF, 1}", "{0xFF01, 0xFF03, 1}"} {
+		if !strings.Contains(source, want) {
+			t.Errorf("Expected wide range '%s' in %s", want, source)
+		}
+	}
+	if len(sources) != 2 || sources[0].Name != sourceEmojiData || sources[0].Path != testEmojiDataPath ||
+		sources[1].Name != sourceEastAsianWidth || len(sources[1].SHA256) != 64 {
+		t.Errorf("Expected the metadata of both Unicode files, got %+v", sources)
+	}
+
+	opts.EmojiDataPath = testEmojiTestPath
+	if _, _, err := New(nil).buildPropertyTables(context.Background(), opts); err == nil {
+		t.Error("Expected an error for data without properties")
+	}
+}
@@ -0,0 +1,225 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	// ReportFormatText is the human-readable format of the change report.
+	ReportFormatText = "text"
+	// ReportFormatJSON is the machine-readable format of the change report.
+	ReportFormatJSON = "json"
+)
+
+// mapReport lists the differences between two emoji maps.
+type mapReport struct {
+	AddedKeywords   []keywordEmojis `json:"addedKeywords"`
+	RemovedKeywords []keywordEmojis `json:"removedKeywords"`
+	ChangedKeywords []keywordChange `json:"changedKeywords"`
+}
+
+type keywordEmojis struct {
+	Keyword string   `json:"keyword"`
+	Emojis  []string `json:"emojis"`
+}
+
+// keywordChange describes how the emojis of a keyword changed.
+type keywordChange struct {
+	Keyword        string   `json:"keyword"`
+	OldEmojis      []string `json:"oldEmojis"`
+	NewEmojis      []string `json:"newEmojis"`
+	AddedEmojis    []string `json:"addedEmojis,omitempty"`
+	RemovedEmojis  []string `json:"removedEmojis,omitempty"`
+	OrderChanged   bool     `json:"orderChanged"`
+	PrimaryChanged bool     `json:"primaryChanged"`
+}
+
+// loadPreviousMap reads the emoji map to compare against. A missing map is treated as empty.
+func loadPreviousMap(opts Options) (map[string][]string, error) {
+	filePath := opts.DiffAgainst
+	if filePath == "" {
+		filePath = opts.OutputPath
+	}
+	if _, err := os.Stat(filePath); errors.Is(err, fs.ErrNotExist) {
+		return map[string][]string{}, nil
+	}
+
+	data, err := readFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	dictionary, err := readDictionary(data)
+	if err != nil {
+		return nil, fmt.Errorf("error reading previous emoji map: %w", err)
+	}
+	emojiMap := make(map[string][]string)
+	dictionary.Range(func(keyword string, emojis []string) bool {
+		emojiMap[keyword] = emojis
+		return true
+	})
+	return emojiMap, nil
+}
+
+// compareMaps reports the keywords and emojis which were added, removed or reordered.
+func compareMaps(oldMap, newMap map[string][]string) mapReport {
+	report := mapReport{
+		AddedKeywords:   []keywordEmojis{},
+		RemovedKeywords: []keywordEmojis{},
+		ChangedKeywords: []keywordChange{},
+	}
+
+	for _, keyword := range sortedKeys(newMap) {
+		oldEmojis, ok := oldMap[keyword]
+		if !ok {
+			report.AddedKeywords = append(report.AddedKeywords, keywordEmojis{Keyword: keyword, Emojis: newMap[keyword]})
+			continue
+		}
+		if change, changed := compareEmojis(keyword, oldEmojis, newMap[keyword]); changed {
+			report.ChangedKeywords = append(report.ChangedKeywords, change)
+		}
+	}
+	for _, keyword := range sortedKeys(oldMap) {
+		if _, ok := newMap[keyword]; !ok {
+			report.RemovedKeywords = append(report.RemovedKeywords, keywordEmojis{Keyword: keyword, Emojis: oldMap[keyword]})
+		}
+	}
+
+	return report
+}
+
+func compareEmojis(keyword string, oldEmojis, newEmojis []string) (change keywordChange, changed bool) {
+	change = keywordChange{
+		Keyword:       keyword,
+		OldEmojis:     oldEmojis,
+		NewEmojis:     newEmojis,
+		AddedEmojis:   difference(newEmojis, oldEmojis),
+		RemovedEmojis: difference(oldEmojis, newEmojis),
+	}
+	// the order changed if the emojis both lists have in common appear in a different order
+	change.OrderChanged = strings.Join(intersection(oldEmojis, newEmojis), "") !=
+		strings.Join(intersection(newEmojis, oldEmojis), "")
+	change.PrimaryChanged = len(oldEmojis) > 0 && len(newEmojis) > 0 && oldEmojis[0] != newEmojis[0]
+
+	changed = len(change.AddedEmojis) > 0 || len(change.RemovedEmojis) > 0 || change.OrderChanged
+	return change, changed
+}
+
+// difference returns the values of a which are not in b.
+func difference(a, b []string) []string {
+	result := make([]string, 0)
+	for _, value := range a {
+		if !contains(b, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// intersection returns the values of a which are also in b, in the order of a.
+func intersection(a, b []string) []string {
+	result := make([]string, 0)
+	for _, value := range a {
+		if contains(b, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](emojiMap map[string]V) []string {
+	keys := make([]string, 0, len(emojiMap))
+	for key := range emojiMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func storeReport(report mapReport, filePath, format string) error {
+	return writeFile(filePath, func(w io.Writer) error { return writeReport(w, report, format) })
+}
+
+// writeReport writes the report in the format 'text' or 'json'.
+func writeReport(w io.Writer, report mapReport, format string) error {
+	var data []byte
+	switch format {
+	case ReportFormatText, "":
+		data = renderTextReport(report)
+	case ReportFormatJSON:
+		var err error
+		data, err = json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling report: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown report format '%s'", format)
+	}
+
+	_, err := w.Write(data)
+	return err
+}
+
+func renderTextReport(report mapReport) []byte {
+	primaryChanges := 0
+	for _, change := range report.ChangedKeywords {
+		if change.PrimaryChanged {
+			primaryChanges++
+		}
+	}
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "%d keywords added, %d keywords removed, %d keywords changed (%d with a new primary emoji)\n",
+		len(report.AddedKeywords), len(report.RemovedKeywords), len(report.ChangedKeywords), primaryChanges)
+
+	if len(report.AddedKeywords) > 0 {
+		fmt.Fprintf(&buffer, "\nAdded keywords:\n")
+		for _, added := range report.AddedKeywords {
+			fmt.Fprintf(&buffer, "+ %s: %s\n", added.Keyword, strings.Join(added.Emojis, " "))
+		}
+	}
+	if len(report.RemovedKeywords) > 0 {
+		fmt.Fprintf(&buffer, "\nRemoved keywords:\n")
+		for _, removed := range report.RemovedKeywords {
+			fmt.Fprintf(&buffer, "- %s: %s\n", removed.Keyword, strings.Join(removed.Emojis, " "))
+		}
+	}
+	if len(report.ChangedKeywords) > 0 {
+		fmt.Fprintf(&buffer, "\nChanged keywords:\n")
+		for _, change := range report.ChangedKeywords {
+			details := make([]string, 0)
+			for _, emoji := range change.AddedEmojis {
+				details = append(details, "+"+emoji)
+			}
+			for _, emoji := range change.RemovedEmojis {
+				details = append(details, "-"+emoji)
+			}
+			if change.OrderChanged {
+				details = append(details, "reordered")
+			}
+			if change.PrimaryChanged {
+				details = append(details, "new primary")
+			}
+			fmt.Fprintf(&buffer, "~ %s: %s -> %s (%s)\n", change.Keyword,
+				strings.Join(change.OldEmojis, " "), strings.Join(change.NewEmojis, " "), strings.Join(details, ", "))
+		}
+	}
+
+	return buffer.Bytes()
+}
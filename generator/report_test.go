@@ -0,0 +1,109 @@
+package generator
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var (
+	testOldMap = map[string][]string{
+		"smile":   {"😄", "😃"},
+		"happy":   {"😀", "😄"},
+		"removed": {"🗑️"},
+		"same":    {"🟰"},
+	}
+	testNewMap = map[string][]string{
+		"smile": {"😄", "😺"},
+		"happy": {"😄", "😀"},
+		"added": {"➕"},
+		"same":  {"🟰"},
+	}
+)
+
+func Test_compareMaps(t *testing.T) {
+	report := compareMaps(testOldMap, testNewMap)
+
+	expected := mapReport{
+		AddedKeywords:   []keywordEmojis{{Keyword: "added", Emojis: []string{"➕"}}},
+		RemovedKeywords: []keywordEmojis{{Keyword: "removed", Emojis: []string{"🗑️"}}},
+		ChangedKeywords: []keywordChange{
+			{
+				Keyword:        "happy",
+				OldEmojis:      []string{"😀", "😄"},
+				NewEmojis:      []string{"😄", "😀"},
+				AddedEmojis:    []string{},
+				RemovedEmojis:  []string{},
+				OrderChanged:   true,
+				PrimaryChanged: true,
+			},
+			{
+				Keyword:       "smile",
+				OldEmojis:     []string{"😄", "😃"},
+				NewEmojis:     []string{"😄", "😺"},
+				AddedEmojis:   []string{"😺"},
+				RemovedEmojis: []string{"😃"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(report, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, report)
+	}
+}
+
+func Test_storeReport(t *testing.T) {
+	report := compareMaps(testOldMap, testNewMap)
+	outputDir := t.TempDir()
+
+	textPath := path.Join(outputDir, "report.txt")
+	if err := storeReport(report, textPath, ReportFormatText); err != nil {
+		t.Fatalf("Error storing report: %v", err)
+	}
+	text := string(mustReadFile(t, textPath))
+	expectedLines := []string{
+		"1 keywords added, 1 keywords removed, 2 keywords changed (1 with a new primary emoji)",
+		"+ added: ➕",
+		"- removed: 🗑️",
+		"~ happy: 😀 😄 -> 😄 😀 (reordered, new primary)",
+		"~ smile: 😄 😃 -> 😄 😺 (+😺, -😃)",
+	}
+	for _, expected := range expectedLines {
+		if !strings.Contains(text, expected) {
+			t.Errorf("Expected report to contain '%s', got:\n%s", expected, text)
+		}
+	}
+
+	jsonPath := path.Join(outputDir, "report.json")
+	if err := storeReport(report, jsonPath, ReportFormatJSON); err != nil {
+		t.Fatalf("Error storing report: %v", err)
+	}
+	var decoded mapReport
+	if err := json.Unmarshal(mustReadFile(t, jsonPath), &decoded); err != nil {
+		t.Fatalf("Error unmarshaling JSON report: %v", err)
+	}
+	if len(decoded.ChangedKeywords) != 2 {
+		t.Errorf("Expected 2 changed keywords in JSON report, got %d", len(decoded.ChangedKeywords))
+	}
+}
+
+func Test_loadPreviousMap(t *testing.T) {
+	outputDir := t.TempDir()
+	missing, err := loadPreviousMap(Options{OutputPath: path.Join(outputDir, "missing.json")})
+	if err != nil || len(missing) != 0 {
+		t.Errorf("Expected empty map for missing file, got %v", missing)
+	}
+
+	filePath := path.Join(outputDir, "emoji_map.json")
+	if err := storeMapToJSON(weightedMap(testOldMap), filePath); err != nil {
+		t.Fatalf("Error storing map: %v", err)
+	}
+	if previous, _ := loadPreviousMap(Options{DiffAgainst: filePath}); !reflect.DeepEqual(previous, testOldMap) {
+		t.Errorf("Expected %v, got %v", testOldMap, previous)
+	}
+	if _, err := os.Stat(filePath); err != nil {
+		t.Errorf("Expected previous map to be kept, got %v", err)
+	}
+}
@@ -0,0 +1,201 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+const (
+	sourceGemoji   = "gemoji"
+	sourceUnicode  = "unicode"
+	sourceCLDR     = "cldr"
+	defaultSources = sourceGemoji
+
+	variationSelector16 = "\uFE0F"
+)
+
+// emojiSource is the list of emojis provided by one source.
+type emojiSource struct {
+	name   string
+	emojis []Emoji
+	// enrichOnly sources add names and keywords to emojis of other sources,
+	// but do not add emojis on their own unless they are the only sources.
+	enrichOnly bool
+	metadata   goemoji.SourceMetadata
+}
+
+// LoadEmojis reads the sources listed in opts.Sources and merges them in that order of precedence.
+func (g *Generator) LoadEmojis(ctx context.Context, opts Options) ([]Emoji, error) {
+	emojis, _, err := g.loadEmojis(ctx, opts)
+	return emojis, err
+}
+
+// loadEmojis reads and merges the sources and returns the metadata of each source.
+func (g *Generator) loadEmojis(ctx context.Context, opts Options) ([]Emoji, []goemoji.SourceMetadata, error) {
+	if opts.Sources == "" {
+		opts.Sources = defaultSources
+	}
+	names := strings.Split(opts.Sources, ",")
+	sources := make([]emojiSource, 0, len(names))
+	for _, name := range names {
+		loaded, err := g.loadSource(ctx, opts, strings.TrimSpace(name))
+		if err != nil {
+			return nil, nil, err
+		}
+		sources = append(sources, loaded...)
+	}
+
+	metadata := make([]goemoji.SourceMetadata, len(sources))
+	for i, source := range sources {
+		metadata[i] = source.metadata
+	}
+	return mergeEmojis(sources), metadata, nil
+}
+
+func (g *Generator) loadSource(ctx context.Context, opts Options, name string) ([]emojiSource, error) {
+	switch name {
+	case sourceGemoji:
+		ref := opts.InputRef
+		if ref == "" && opts.InputPath == "" && opts.InputURL == "" {
+			ref = DefaultRef
+		}
+		inputURL := opts.InputURL
+		if opts.InputPath == "" && inputURL == "" {
+			inputURL = fmt.Sprintf(gemojiURLPattern, ref)
+		}
+		if opts.InputPath == "" && opts.InputSHA256 == "" {
+			return nil, fmt.Errorf("no checksum is known for '%s', the expected SHA-256 is required to download it",
+				inputURL)
+		}
+		data, err := g.readInput(ctx, opts.InputPath, inputURL)
+		if err != nil {
+			return nil, err
+		}
+		metadata := sourceMetadata(sourceGemoji, opts.InputPath, inputURL, data)
+		metadata.Ref = ref
+		if err := verifyChecksum(metadata, opts.InputSHA256); err != nil {
+			return nil, err
+		}
+		emojis, err := parseGemoji(data)
+		if err != nil {
+			return nil, err
+		}
+		return []emojiSource{{name: sourceGemoji, emojis: emojis, metadata: metadata}}, nil
+	case sourceUnicode:
+		if opts.EmojiTestPath == "" && opts.EmojiTestURL == "" {
+			return nil, errors.New("source 'unicode' requires an emoji-test.txt path or URL")
+		}
+		data, err := g.readInput(ctx, opts.EmojiTestPath, opts.EmojiTestURL)
+		if err != nil {
+			return nil, err
+		}
+		metadata := sourceMetadata(sourceUnicode, opts.EmojiTestPath, opts.EmojiTestURL, data)
+		return []emojiSource{{name: sourceUnicode, emojis: parseEmojiTest(data), metadata: metadata}}, nil
+	case sourceCLDR:
+		if opts.CLDRPaths == "" {
+			return nil, errors.New("source 'cldr' requires CLDR annotation paths")
+		}
+		sources := make([]emojiSource, 0)
+		for _, cldrPath := range strings.Split(opts.CLDRPaths, ",") {
+			cldrPath = strings.TrimSpace(cldrPath)
+			data, err := readFile(cldrPath)
+			if err != nil {
+				return nil, err
+			}
+			_, emojis, err := parseCLDRAnnotations(data)
+			if err != nil {
+				return nil, err
+			}
+			metadata := sourceMetadata(sourceCLDR, cldrPath, "", data)
+			sources = append(sources, emojiSource{name: sourceCLDR, emojis: emojis, enrichOnly: true, metadata: metadata})
+		}
+		return sources, nil
+	default:
+		return nil, fmt.Errorf("unknown source '%s'", name)
+	}
+}
+
+// mergeEmojis combines the emojis of all sources, which are ordered by precedence.
+// Emojis are identified independently of variation selectors. The first source
+// with a description provides it, descriptions of other sources become aliases.
+// Aliases and tags of all sources are combined.
+func mergeEmojis(sources []emojiSource) []Emoji {
+	onlyEnrichSources := true
+	representations := make(map[string]string)
+	for _, source := range sources {
+		if source.enrichOnly {
+			continue
+		}
+		onlyEnrichSources = false
+		for _, emoji := range source.emojis {
+			if _, ok := representations[emojiKey(emoji.Emoji)]; !ok {
+				representations[emojiKey(emoji.Emoji)] = emoji.Emoji
+			}
+		}
+	}
+
+	merged := make([]Emoji, 0)
+	indices := make(map[string]int)
+	for _, source := range sources {
+		for _, emoji := range source.emojis {
+			key := emojiKey(emoji.Emoji)
+			representation, known := representations[key]
+			if !known {
+				if !onlyEnrichSources {
+					continue
+				}
+				representation = emoji.Emoji
+			}
+
+			i, ok := indices[key]
+			if !ok {
+				i = len(merged)
+				indices[key] = i
+				merged = append(merged, Emoji{Emoji: representation})
+			}
+			mergeEmoji(&merged[i], emoji)
+		}
+	}
+
+	return merged
+}
+
+func mergeEmoji(target *Emoji, emoji Emoji) {
+	switch {
+	case target.Description == "":
+		target.Description = emoji.Description
+	case emoji.Description != "" && !strings.EqualFold(target.Description, emoji.Description):
+		target.Aliases = appendUnique(target.Aliases, emoji.Description)
+	}
+	if target.Category == "" {
+		target.Category = emoji.Category
+	}
+	target.Aliases = appendUnique(target.Aliases, emoji.Aliases...)
+	target.Tags = appendUnique(target.Tags, emoji.Tags...)
+	target.SkinTones = target.SkinTones || emoji.SkinTones
+}
+
+func appendUnique(values []string, additions ...string) []string {
+	for _, addition := range additions {
+		exists := false
+		for _, value := range values {
+			if value == addition {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			values = append(values, addition)
+		}
+	}
+	return values
+}
+
+// emojiKey identifies an emoji regardless of its qualification.
+func emojiKey(emoji string) string {
+	return strings.ReplaceAll(emoji, variationSelector16, "")
+}
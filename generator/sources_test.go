@@ -0,0 +1,97 @@
+package generator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func Test_mergeEmojis(t *testing.T) {
+	gemoji := emojiSource{name: sourceGemoji, emojis: []Emoji{
+		{Emoji: "🍽️", Description: "plate with cutlery", Aliases: []string{"plate_with_cutlery"}, Tags: []string{"dinner"}},
+	}}
+	unicode := emojiSource{name: sourceUnicode, emojis: []Emoji{
+		{Emoji: "🍽️", Description: "fork and knife with plate", Category: "Food & Drink"},
+		{Emoji: "🍎", Description: "red apple", Category: "Food & Drink"},
+	}}
+	cldr := emojiSource{name: sourceCLDR, enrichOnly: true, emojis: []Emoji{
+		{Emoji: "🍽", Description: "fork and knife with plate", Tags: []string{"fork", "dinner"}},
+		{Emoji: "🎉", Description: "party popper", Tags: []string{"party"}},
+	}}
+
+	tests := []struct {
+		name     string
+		sources  []emojiSource
+		expected []Emoji
+	}{
+		{
+			name:    "gemoji first",
+			sources: []emojiSource{gemoji, unicode, cldr},
+			expected: []Emoji{
+				{
+					Emoji:       "🍽️",
+					Description: "plate with cutlery",
+					Category:    "Food & Drink",
+					Aliases:     []string{"plate_with_cutlery", "fork and knife with plate"},
+					Tags:        []string{"dinner", "fork"},
+				},
+				{Emoji: "🍎", Description: "red apple", Category: "Food & Drink"},
+			},
+		},
+		{
+			name:    "cldr first keeps qualified representation",
+			sources: []emojiSource{cldr, unicode},
+			expected: []Emoji{
+				{
+					Emoji:       "🍽️",
+					Description: "fork and knife with plate",
+					Category:    "Food & Drink",
+					Tags:        []string{"fork", "dinner"},
+				},
+				{Emoji: "🍎", Description: "red apple", Category: "Food & Drink"},
+			},
+		},
+		{
+			name:    "only cldr",
+			sources: []emojiSource{cldr},
+			expected: []Emoji{
+				{Emoji: "🍽", Description: "fork and knife with plate", Tags: []string{"fork", "dinner"}},
+				{Emoji: "🎉", Description: "party popper", Tags: []string{"party"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeEmojis(tt.sources); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func Test_loadEmojis(t *testing.T) {
+	emojis, err := New(nil).LoadEmojis(context.Background(), Options{
+		Sources:       "unicode,gemoji,cldr",
+		InputPath:     testInputPath,
+		EmojiTestPath: testEmojiTestPath,
+		CLDRPaths:     testCLDRPath,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(emojis) != 7 {
+		t.Fatalf("Expected 7 emojis, got %d: %v", len(emojis), emojis)
+	}
+	if emojis[0].Description != "grinning face" {
+		t.Errorf("Expected description of unicode source, got '%s'", emojis[0].Description)
+	}
+
+	emojiMap := emojiLists(BuildEmojiMap(emojis))
+	if !reflect.DeepEqual(emojiMap["tada"], []string(nil)) {
+		t.Errorf("Expected emoji missing in gemoji and unicode to be skipped, got %v", emojiMap["tada"])
+	}
+	if !reflect.DeepEqual(emojiMap["grin"], []string{"😀"}) {
+		t.Errorf("Expected CLDR keyword 'grin', got %v", emojiMap["grin"])
+	}
+}
@@ -0,0 +1,96 @@
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+)
+
+const (
+	emojiTestGroupPrefix    = "# group:"
+	emojiTestFullyQualified = "fully-qualified"
+	skinToneModifierFirst   = 0x1F3FB
+	skinToneModifierLast    = 0x1F3FF
+)
+
+// parseEmojiTest reads the fully-qualified emojis of a Unicode emoji-test.txt file.
+// Sequences with skin tone modifiers are skipped, as they are variants of their base emoji,
+// which is marked to support skin tones instead.
+func parseEmojiTest(data []byte) []Emoji {
+	emojis := make([]Emoji, 0)
+	tonedEmojis := make(map[string]bool)
+	group := ""
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, emojiTestGroupPrefix) {
+			group = strings.TrimSpace(strings.TrimPrefix(line, emojiTestGroupPrefix))
+			continue
+		}
+
+		emoji, status, name, ok := parseEmojiTestLine(line)
+		if !ok || status != emojiTestFullyQualified {
+			continue
+		}
+		if hasSkinToneModifier(emoji) {
+			tonedEmojis[emojiKey(removeSkinToneModifiers(emoji))] = true
+			continue
+		}
+		emojis = append(emojis, Emoji{Emoji: emoji, Description: name, Category: group})
+	}
+
+	for i := range emojis {
+		emojis[i].SkinTones = tonedEmojis[emojiKey(emojis[i].Emoji)]
+	}
+
+	return emojis
+}
+
+// parseEmojiTestLine parses a line of the format
+// "1F600 ; fully-qualified # 😀 E1.0 grinning face".
+func parseEmojiTestLine(line string) (emoji, status, name string, ok bool) {
+	codePoints, rest, found := strings.Cut(line, ";")
+	if !found || strings.HasPrefix(line, "#") {
+		return "", "", "", false
+	}
+	status, comment, found := strings.Cut(rest, "#")
+	if !found {
+		return "", "", "", false
+	}
+
+	var builder strings.Builder
+	for _, codePoint := range strings.Fields(codePoints) {
+		value, err := strconv.ParseUint(codePoint, 16, 32)
+		if err != nil {
+			return "", "", "", false
+		}
+		builder.WriteRune(rune(value))
+	}
+
+	// the comment consists of the emoji, the version it was introduced in and its name
+	fields := strings.Fields(comment)
+	if len(fields) < 3 {
+		return "", "", "", false
+	}
+
+	return builder.String(), strings.TrimSpace(status), strings.Join(fields[2:], " "), true
+}
+
+func hasSkinToneModifier(emoji string) bool {
+	return strings.ContainsFunc(emoji, isSkinToneModifier)
+}
+
+func removeSkinToneModifiers(emoji string) string {
+	return strings.Map(func(r rune) rune {
+		if isSkinToneModifier(r) {
+			return -1
+		}
+		return r
+	}, emoji)
+}
+
+func isSkinToneModifier(r rune) bool {
+	return r >= skinToneModifierFirst && r <= skinToneModifierLast
+}
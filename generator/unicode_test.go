@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testEmojiTestPath = "testdata/emoji-test.txt"
+
+func Test_parseEmojiTest(t *testing.T) {
+	emojis := parseEmojiTest(mustReadFile(t, testEmojiTestPath))
+
+	expected := []Emoji{
+		{Emoji: "😀", Description: "grinning face", Category: "Smileys & Emotion"},
+		{Emoji: "😄", Description: "grinning face with smiling eyes", Category: "Smileys & Emotion"},
+		{Emoji: "👍", Description: "thumbs up", Category: "People & Body", SkinTones: true},
+		{Emoji: "🧑‍🎨", Description: "artist", Category: "People & Body"},
+		{Emoji: "🍎", Description: "red apple", Category: "Food & Drink"},
+		{Emoji: "🍽️", Description: "fork and knife with plate", Category: "Food & Drink"},
+		{Emoji: "🇩🇪", Description: "flag: Germany", Category: "Flags"},
+	}
+	if !reflect.DeepEqual(emojis, expected) {
+		t.Errorf("Expected %v, got %v", expected, emojis)
+	}
+}
+
+func Test_parseEmojiTestLine(t *testing.T) {
+	tests := []struct {
+		name       string
+		line       string
+		wantEmoji  string
+		wantStatus string
+		wantName   string
+		wantOk     bool
+	}{
+		{
+			name:       "fully-qualified",
+			line:       "1F44D 1F3FB ; fully-qualified # 👍🏻 E1.0 thumbs up: light skin tone",
+			wantEmoji:  "👍🏻",
+			wantStatus: "fully-qualified",
+			wantName:   "thumbs up: light skin tone",
+			wantOk:     true,
+		},
+		{
+			name:       "unqualified",
+			line:       "263A ; unqualified # ☺ E0.6 smiling face",
+			wantEmoji:  "☺",
+			wantStatus: "unqualified",
+			wantName:   "smiling face",
+			wantOk:     true,
+		},
+		{
+			name: "comment",
+			line: "# subgroup: face-smiling",
+		},
+		{
+			name: "invalid code point",
+			line: "XYZ ; fully-qualified # ? E1.0 invalid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emoji, status, name, ok := parseEmojiTestLine(tt.line)
+			if emoji != tt.wantEmoji || status != tt.wantStatus || name != tt.wantName || ok != tt.wantOk {
+				t.Errorf("parseEmojiTestLine() = %q, %q, %q, %v, want %q, %q, %q, %v",
+					emoji, status, name, ok, tt.wantEmoji, tt.wantStatus, tt.wantName, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jo-hoe/goemoji"
+)
+
+// Validate checks the emoji map at opts.OutputPath, writes the issues found to w
+// and reports whether the map is free of errors. If an emoji-test.txt is given,
+// emojis which are not fully-qualified are reported as well.
+func (g *Generator) Validate(ctx context.Context, opts Options, w io.Writer) (bool, error) {
+	if opts.OutputPath == "" {
+		return false, errors.New("output path is required")
+	}
+
+	data, err := readFile(opts.OutputPath)
+	if err != nil {
+		return false, err
+	}
+	dictionary, err := readDictionary(data)
+	if err != nil {
+		return false, fmt.Errorf("error reading emoji map: %w", err)
+	}
+
+	validationOptions := make([]goemoji.ValidationOption, 0)
+	if opts.EmojiTestPath != "" || opts.EmojiTestURL != "" {
+		emojiTest, err := g.readInput(ctx, opts.EmojiTestPath, opts.EmojiTestURL)
+		if err != nil {
+			return false, err
+		}
+		validationOptions = append(validationOptions, goemoji.WithQualifiedEmojis(fullyQualifiedEmojis(emojiTest)))
+	}
+
+	// the embedded map is used with any minimum word length, so only the tokenizer of the
+	// default strategy is checked
+	tokenizer := goemoji.ReplaceSubstring{}.Pipeline().Tokenizer
+	issues := goemoji.ValidateDictionary(dictionary, 0, tokenizer, validationOptions...)
+	errorCount := 0
+	for _, issue := range issues {
+		if issue.Severity == goemoji.SeverityError {
+			errorCount++
+		}
+		fmt.Fprintln(w, issue)
+	}
+	fmt.Fprintf(w, "%d issues found, %d errors\n", len(issues), errorCount)
+
+	return !goemoji.HasErrors(issues), nil
+}
+
+// fullyQualifiedEmojis returns every fully-qualified emoji of a Unicode emoji-test.txt file,
+// including sequences with skin tone modifiers.
+func fullyQualifiedEmojis(data []byte) []string {
+	emojis := make([]string, 0)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		emoji, status, _, ok := parseEmojiTestLine(strings.TrimSpace(scanner.Text()))
+		if ok && status == emojiTestFullyQualified {
+			emojis = append(emojis, emoji)
+		}
+	}
+
+	return emojis
+}
@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestGenerator_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		emojiMap map[string][]string
+		want     bool
+		output   string
+	}{
+		{
+			name:     "valid map",
+			emojiMap: map[string][]string{"grinning": {"😀"}, "thumbs up": {"👍", "👍🏻"}},
+			want:     true,
+			output:   "0 issues found, 0 errors",
+		},
+		{
+			name:     "warnings only",
+			emojiMap: map[string][]string{"+1": {"👍", "👍"}},
+			want:     true,
+			output:   "warning: keyword '+1' lists emoji '👍' more than once",
+		},
+		{
+			name:     "unqualified emoji",
+			emojiMap: map[string][]string{"plate": {"🍽"}},
+			want:     false,
+			output:   "error: keyword 'plate' has emoji '🍽' which is not fully-qualified",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputPath := path.Join(t.TempDir(), "emoji_map.json")
+			if err := storeMapToJSON(weightedMap(tt.emojiMap), outputPath); err != nil {
+				t.Fatalf("Error storing map: %v", err)
+			}
+
+			var output bytes.Buffer
+			got, err := New(nil).Validate(context.Background(),
+				Options{OutputPath: outputPath, EmojiTestPath: testEmojiTestPath}, &output)
+			if err != nil || got != tt.want {
+				t.Errorf("Validate() = %v, %v, want %v", got, err, tt.want)
+			}
+			if !strings.Contains(output.String(), tt.output) {
+				t.Errorf("Expected output to contain '%s', got:\n%s", tt.output, output.String())
+			}
+		})
+	}
+}
+
+func Test_fullyQualifiedEmojis(t *testing.T) {
+	emojis := fullyQualifiedEmojis(mustReadFile(t, testEmojiTestPath))
+
+	expectedNumberOfEmojis := 12
+	if len(emojis) != expectedNumberOfEmojis {
+		t.Errorf("Expected %d emojis, got %d: %v", expectedNumberOfEmojis, len(emojis), emojis)
+	}
+	for _, emoji := range emojis {
+		if emoji == "🍽" || emoji == "🏻" {
+			t.Errorf("Expected '%s' to be skipped", emoji)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	zeroWidthJoiner = "\u200d"
+	femaleSign      = "\u2640\uFE0F"
+	maleSign        = "\u2642\uFE0F"
+	personEmoji     = "\U0001F9D1"
+	womanEmoji      = "\U0001F469"
+	manEmoji        = "\U0001F468"
+)
+
+// Variant records which skin tone and gender variants of an emoji exist.
+type Variant struct {
+	Emoji     string
+	SkinTones bool
+	// Female and Male are the gendered forms of the emoji, empty if they do not exist.
+	Female string
+	Male   string
+}
+
+// BuildVariants returns the variants of all emojis which support skin tones or
+// have gendered forms in the list, sorted by emoji. Gendered forms are sequences
+// with a female or male sign, like 🏃‍♀️ for 🏃, or which replace a leading
+// person by a woman or man, like 👩‍🎨 for 🧑‍🎨.
+func BuildVariants(emojis []Emoji) []Variant {
+	representations := make(map[string]string, len(emojis))
+	for _, emoji := range emojis {
+		representations[emojiKey(emoji.Emoji)] = emoji.Emoji
+	}
+
+	variants := make([]Variant, 0)
+	for _, emoji := range emojis {
+		variant := Variant{
+			Emoji:     emoji.Emoji,
+			SkinTones: emoji.SkinTones,
+			Female:    genderedForm(emoji.Emoji, femaleSign, womanEmoji, representations),
+			Male:      genderedForm(emoji.Emoji, maleSign, manEmoji, representations),
+		}
+		if variant.SkinTones || variant.Female != "" || variant.Male != "" {
+			variants = append(variants, variant)
+		}
+	}
+
+	sort.Slice(variants, func(i, j int) bool {
+		return variants[i].Emoji < variants[j].Emoji
+	})
+	return variants
+}
+
+// genderedForm returns the form of the emoji with the gender sign or gendered person
+// if it is one of the known emojis.
+func genderedForm(emoji, sign, person string, representations map[string]string) string {
+	candidates := []string{emoji + zeroWidthJoiner + sign}
+	if rest, ok := strings.CutPrefix(emoji, personEmoji); ok {
+		candidates = append(candidates, person+rest)
+	}
+
+	for _, candidate := range candidates {
+		if representation, ok := representations[emojiKey(candidate)]; ok && representation != emoji {
+			return representation
+		}
+	}
+	return ""
+}
+
+// WriteVariantsGoSource writes the variants as the variant table of the goemoji package.
+func WriteVariantsGoSource(w io.Writer, variants []Variant) error {
+	return writeFormattedSource(w, renderVariantsGoSource(variants))
+}
+
+func renderVariantsGoSource(variants []Variant) []byte {
+	var buffer bytes.Buffer
+	writeGoSourceHeader(&buffer)
+	fmt.Fprintf(&buffer, "// defaultVariants lists the skin tone and gender variants of emojis, sorted by emoji.\n")
+	fmt.Fprintf(&buffer, "var defaultVariants = []emojiVariant{\n")
+	for _, variant := range variants {
+		fmt.Fprintf(&buffer, "{%s, %t, %s, %s},\n", strconv.Quote(variant.Emoji), variant.SkinTones,
+			strconv.Quote(variant.Female), strconv.Quote(variant.Male))
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	return buffer.Bytes()
+}
+
+func storeVariantsGoSource(variants []Variant, filePath string) error {
+	return writeFile(filePath, func(w io.Writer) error { return WriteVariantsGoSource(w, variants) })
+}
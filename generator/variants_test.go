@@ -0,0 +1,49 @@
+package generator
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_BuildVariants(t *testing.T) {
+	emojis := []Emoji{
+		{Emoji: "🏃", Description: "person running", SkinTones: true},
+		{Emoji: "🏃\u200d♀️", Description: "woman running", SkinTones: true},
+		{Emoji: "🏃\u200d♂", Description: "man running", SkinTones: true},
+		{Emoji: "🧑\u200d🎨", Description: "artist", SkinTones: true},
+		{Emoji: "👩\u200d🎨", Description: "woman artist", SkinTones: true},
+		{Emoji: "👍", Description: "thumbs up", SkinTones: true},
+		{Emoji: "🍎", Description: "red apple"},
+	}
+
+	expected := []Variant{
+		{Emoji: "🏃", SkinTones: true, Female: "🏃\u200d♀️", Male: "🏃\u200d♂"},
+		{Emoji: "🏃\u200d♀️", SkinTones: true},
+		{Emoji: "🏃\u200d♂", SkinTones: true},
+		{Emoji: "👍", SkinTones: true},
+		{Emoji: "👩\u200d🎨", SkinTones: true},
+		{Emoji: "🧑\u200d🎨", SkinTones: true, Female: "👩\u200d🎨"},
+	}
+	if got := BuildVariants(emojis); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func Test_WriteVariantsGoSource(t *testing.T) {
+	variants := []Variant{{Emoji: "🏃", SkinTones: true, Female: "🏃\u200d♀️", Male: "🏃\u200d♂️"}}
+
+	var buffer bytes.Buffer
+	if err := WriteVariantsGoSource(&buffer, variants); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "", buffer.Bytes(), parser.AllErrors); err != nil {
+		t.Fatalf("Expected valid Go source, got %v", err)
+	}
+	if want := `{"🏃", true, "🏃\u200d♀️", "🏃\u200d♂️"},`; !strings.Contains(buffer.String(), want) {
+		t.Errorf("Expected Go source to contain %s, got %s", want, buffer.String())
+	}
+}
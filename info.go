@@ -0,0 +1,32 @@
+package goemoji
+
+import (
+	"slices"
+	"time"
+)
+
+// DictionaryMetadata describes the sources a dictionary was generated from.
+type DictionaryMetadata struct {
+	Sources []SourceMetadata `json:"sources"`
+	// GeneratedAt is the time of the generation, zero if unknown.
+	GeneratedAt time.Time `json:"generatedAt"`
+}
+
+// SourceMetadata identifies the exact version of a source of a dictionary.
+type SourceMetadata struct {
+	Name string `json:"name"`
+	// URL or Path is the location the source was read from.
+	URL  string `json:"url,omitempty"`
+	Path string `json:"path,omitempty"`
+	// Ref is the pinned upstream version, e.g. a git tag or commit.
+	Ref string `json:"ref,omitempty"`
+	// SHA256 is the hex encoded checksum of the source data, empty if unknown.
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// DictionaryInfo returns the metadata of the embedded default dictionary.
+func DictionaryInfo() DictionaryMetadata {
+	info := defaultDictionaryInfo
+	info.Sources = slices.Clone(info.Sources)
+	return info
+}
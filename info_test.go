@@ -0,0 +1,23 @@
+package goemoji
+
+import "testing"
+
+func TestDictionaryInfo(t *testing.T) {
+	info := DictionaryInfo()
+	if len(info.Sources) == 0 || info.Sources[0].Name == "" {
+		t.Fatalf("Expected at least one named source, got %+v", info)
+	}
+	for _, source := range info.Sources {
+		if source.URL == "" && source.Path == "" {
+			t.Errorf("Expected the location of source '%s', got %+v", source.Name, source)
+		}
+		if source.SHA256 != "" && len(source.SHA256) != 64 {
+			t.Errorf("Expected an empty or SHA-256 checksum of source '%s', got %+v", source.Name, source)
+		}
+	}
+
+	info.Sources[0].Name = "changed"
+	if DictionaryInfo().Sources[0].Name == "changed" {
+		t.Error("Expected DictionaryInfo to return a copy")
+	}
+}
@@ -0,0 +1,59 @@
+// Package casing holds the locale specific lowercasing shared by the library and the generator.
+package casing
+
+import (
+	"strings"
+	"unicode"
+)
+
+// LocaleSeparator separates the subtags of canonical locales.
+const LocaleSeparator = "-"
+
+// CanonicalLocale converts locale identifiers like "de_CH" to the form "de-ch".
+func CanonicalLocale(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	return strings.ReplaceAll(locale, "_", LocaleSeparator)
+}
+
+// LowerFunc returns the lowercasing function of the canonical locale or nil
+// if the locale uses the default Unicode lowercasing.
+func LowerFunc(locale string) func(string) string {
+	language, _, _ := strings.Cut(locale, LocaleSeparator)
+	switch language {
+	case "tr":
+		return func(text string) string { return strings.ToLowerSpecial(unicode.TurkishCase, text) }
+	case "az":
+		return func(text string) string { return strings.ToLowerSpecial(unicode.AzeriCase, text) }
+	case "el":
+		return toLowerGreek
+	default:
+		return nil
+	}
+}
+
+// ToLower lowercases the text according to the rules of the canonical locale.
+func ToLower(locale, text string) string {
+	if lower := LowerFunc(locale); lower != nil {
+		return lower(text)
+	}
+	return strings.ToLower(text)
+}
+
+// toLowerGreek lowercases the text and uses the final form of sigma at the end of words.
+func toLowerGreek(text string) string {
+	const (
+		sigma      = 'σ'
+		finalSigma = 'ς'
+	)
+
+	lower := []rune(strings.ToLower(text))
+	for i, r := range lower {
+		if r != sigma || i == 0 || !unicode.IsLetter(lower[i-1]) {
+			continue
+		}
+		if i == len(lower)-1 || !unicode.IsLetter(lower[i+1]) {
+			lower[i] = finalSigma
+		}
+	}
+	return string(lower)
+}
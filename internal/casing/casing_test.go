@@ -0,0 +1,31 @@
+package casing
+
+import "testing"
+
+func TestCanonicalLocale(t *testing.T) {
+	if got := CanonicalLocale(" de_CH "); got != "de-ch" {
+		t.Errorf("CanonicalLocale() = %s, want de-ch", got)
+	}
+}
+
+func TestToLower(t *testing.T) {
+	tests := []struct {
+		locale string
+		input  string
+		want   string
+	}{
+		{locale: "de", input: "Daumen HOCH", want: "daumen hoch"},
+		{locale: "tr", input: "KIRMIZI İğne", want: "kırmızı iğne"},
+		{locale: "tr-cy", input: "I", want: "ı"},
+		{locale: "az", input: "I", want: "ı"},
+		{locale: "el", input: "ΟΔΟΣ ΣΟΦΟΣ", want: "οδος σοφος"},
+		{locale: "en", input: "I", want: "i"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			if got := ToLower(tt.locale, tt.input); got != tt.want {
+				t.Errorf("ToLower(%s, %s) = %s, want %s", tt.locale, tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,135 +1,91 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
 	"flag"
-	"io"
 	"log"
 	"net/http"
 	"os"
 	"strings"
-)
+	"time"
 
-const (
-	defaultURL      = "https://raw.githubusercontent.com/github/gemoji/master/db/emoji.json"
-	outputFileName  = "emoji_map.json"
-	filePermissions = 0600
-	maxResponseSize = 10 << 20 // 10MB
+	"github.com/jo-hoe/goemoji/generator"
 )
 
-type Emoji struct {
-	Emoji       string   `json:"emoji"`
-	Description string   `json:"description"`
-	Aliases     []string `json:"aliases"`
-	Tags        []string `json:"tags"`
-}
+const httpTimeout = 5 * time.Minute
 
 func main() {
-	outputPath := flag.String("output-path", "", "defines where the emoji map will be stored")
+	var opts generator.Options
+	var validate bool
+	flag.StringVar(&opts.Sources, "sources", "gemoji",
+		"comma-separated list of the sources 'gemoji', 'unicode' and 'cldr' in order of precedence")
+	flag.StringVar(&opts.InputPath, "input-path", "", "reads the gemoji emoji.json from a local file instead of a URL")
+	flag.StringVar(&opts.InputURL, "input-url", "",
+		"defines the URL of the gemoji emoji.json (default is the URL of '-input-ref')")
+	flag.StringVar(&opts.InputRef, "input-ref", "",
+		"pins the gemoji version, e.g. a tag or commit (default \""+generator.DefaultRef+"\")")
+	flag.StringVar(&opts.InputSHA256, "input-sha256", "",
+		"defines the expected SHA-256 checksum of the gemoji emoji.json, which is required to download it")
+	flag.StringVar(&opts.EmojiTestPath, "emoji-test-path", "", "reads the Unicode emoji-test.txt from a local file")
+	flag.StringVar(&opts.EmojiTestURL, "emoji-test-url", "", "defines the URL of the Unicode emoji-test.txt")
+	flag.StringVar(&opts.CLDRPaths, "cldr-path", "", "comma-separated list of CLDR annotation XML files")
+	flag.StringVar(&opts.OutputPath, "output-path", "", "defines where the emoji map will be stored")
+	flag.StringVar(&opts.Format, "format", generator.FormatJSON,
+		"defines the format of the emoji map at '-output-path', one of "+strings.Join(generator.Formats, ", "))
+	flag.StringVar(&opts.GoOutputPath, "go-output-path", "",
+		"defines where the emoji map will be stored as Go source (optional)")
+	flag.StringVar(&opts.Locales, "locales", "",
+		"comma-separated list of CLDR locales, e.g. 'de,de_CH,fr', to generate dictionaries for")
+	flag.StringVar(&opts.CLDRDir, "cldr-dir", "", "reads locale annotations from a local CLDR 'common' directory")
+	flag.StringVar(&opts.CLDRBaseURL, "cldr-base-url", generator.DefaultCLDRBaseURL,
+		"defines the URL of the CLDR 'common' directory")
+	flag.StringVar(&opts.LocaleOutputDir, "locale-output-dir", "",
+		"defines where the locale emoji maps will be stored (optional)")
+	flag.StringVar(&opts.LocaleGoOutputPath, "locale-go-output-path", "",
+		"defines where the locale emoji maps will be stored as Go source (optional)")
+	flag.StringVar(&opts.OverridesPath, "overrides-path", "",
+		"reads curated overrides of the emoji map from a JSON file (optional)")
+	flag.StringVar(&opts.VariantsGoOutputPath, "variants-go-output-path", "",
+		"defines where the skin tone and gender variants of the emojis will be stored as Go source (optional)")
+	flag.StringVar(&opts.CountriesGoOutputPath, "countries-go-output-path", "",
+		"defines where the countries of the flag emojis will be stored as Go source (optional)")
+	flag.StringVar(&opts.EmojiDataPath, "emoji-data-path", "", "reads the Unicode emoji-data.txt from a local file")
+	flag.StringVar(&opts.EmojiDataURL, "emoji-data-url", "",
+		"defines the URL of the Unicode emoji-data.txt (default \""+generator.DefaultEmojiDataURL+"\")")
+	flag.StringVar(&opts.EastAsianWidthPath, "east-asian-width-path", "",
+		"reads the Unicode EastAsianWidth.txt from a local file")
+	flag.StringVar(&opts.EastAsianWidthURL, "east-asian-width-url", "",
+		"defines the URL of the Unicode EastAsianWidth.txt (default \""+generator.DefaultEastAsianWidthURL+"\")")
+	flag.StringVar(&opts.PropertiesGoOutputPath, "properties-go-output-path", "",
+		"defines where the Unicode emoji property tables will be stored as Go source (optional)")
+	flag.StringVar(&opts.MetadataPath, "metadata-path", "",
+		"defines where the sources and checksums of the emoji map will be stored (optional)")
+	flag.StringVar(&opts.MetadataGoOutputPath, "metadata-go-output-path", "",
+		"defines where the sources and checksums of the emoji map will be stored as Go source (optional)")
+	flag.StringVar(&opts.DiffAgainst, "diff-against", "",
+		"defines the previous emoji map for the change report (default is the existing file at '-output-path')")
+	flag.StringVar(&opts.ReportPath, "report-path", "", "defines where the change report will be stored (optional)")
+	flag.StringVar(&opts.ReportFormat, "report-format", generator.ReportFormatText,
+		"defines the format of the change report, 'text' or 'json'")
+	flag.BoolVar(&validate, "validate", false,
+		"validates the emoji map at '-output-path' instead of generating it and fails on errors")
 	flag.Parse()
-	generateMap(*outputPath)
-}
-
-func generateMap(outputPath string) {
-	if !isOutputPathValid(outputPath) {
-		log.Fatal("'-output-path' flag is required")
-	}
-	emojiMap := generateEmojiMap(defaultURL)
-	storeMapToJSON(emojiMap, outputPath)
-	log.Printf("emoji map generated and stored at: %s\n", outputPath)
-
-	maxKeyLength, longestKey := getMaxWordsInKey(emojiMap)
-	log.Printf("longest key '%s' was '%d' words long\n", longestKey, maxKeyLength)
-}
-
-func isOutputPathValid(outputPath string) bool {
-	return outputPath != ""
-}
-
-func storeMapToJSON(emojiMap map[string][]string, filePath string) {
-	data, err := json.MarshalIndent(emojiMap, "", "  ")
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	err = os.WriteFile(filePath, data, filePermissions)
-	if err != nil {
-		log.Fatal(err)
-	}
-}
-
-func generateEmojiMap(url string) map[string][]string {
-	resp, err := http.Get(url) //nolint:noctx // This is a CLI tool, context not needed
-	if err != nil {
-		log.Fatalf("error fetching data: %v\n", err)
-	}
-
-	// Check HTTP status code for security
-	if resp.StatusCode != http.StatusOK {
-		resp.Body.Close()
-		log.Fatalf("HTTP request failed with status: %d", resp.StatusCode)
-	}
 
-	// Limit response size to prevent memory exhaustion attacks
-	limitedReader := io.LimitReader(resp.Body, maxResponseSize)
+	g := generator.New(&http.Client{Timeout: httpTimeout})
+	g.Logger = log.Default()
+	ctx := context.Background()
 
-	data, err := io.ReadAll(limitedReader)
-	resp.Body.Close() // Close immediately after reading
-	if err != nil {
-		log.Fatalf("error reading response body: %v\n", err)
-	}
-
-	var emojis []Emoji
-	err = json.Unmarshal(data, &emojis)
-	if err != nil {
-		log.Fatalf("error unmarshaling JSON: %v\n", err)
-	}
-
-	emojiMap := make(map[string][]string)
-	for _, emoji := range emojis {
-		addToMap(emojiMap, strings.ToLower(emoji.Description), emoji.Emoji, true)
-		for _, alias := range emoji.Aliases {
-			addToMap(emojiMap, strings.ToLower(alias), emoji.Emoji, true)
-		}
-		for _, tag := range emoji.Tags {
-			addToMap(emojiMap, strings.ToLower(tag), emoji.Emoji, false)
+	if validate {
+		valid, err := g.Validate(ctx, opts, os.Stdout)
+		if err != nil {
+			log.Fatal(err)
 		}
-	}
-
-	return emojiMap
-}
-
-func getMaxWordsInKey(emojiMap map[string][]string) (maxWords int, longestKey string) {
-	maxWords = 0
-	longestKey = ""
-
-	for key := range emojiMap {
-		words := strings.Split(key, " ")
-		numberOfWords := len(words)
-		if numberOfWords > maxWords {
-			maxWords = numberOfWords
-			longestKey = key
+		if !valid {
+			os.Exit(1)
 		}
+		return
 	}
-	return maxWords, longestKey
-}
-
-func addToMap(m map[string][]string, key, emoji string, prepend bool) {
-	sanitizedKey := strings.ReplaceAll(key, "_", " ")
-
-	if _, ok := m[sanitizedKey]; !ok {
-		m[sanitizedKey] = []string{}
-	}
-
-	for _, alias := range m[sanitizedKey] {
-		if alias == emoji {
-			return
-		}
-	}
-
-	if prepend {
-		m[sanitizedKey] = append([]string{emoji}, m[sanitizedKey]...)
-	} else {
-		m[sanitizedKey] = append(m[sanitizedKey], emoji)
+	if err := g.Generate(ctx, opts); err != nil {
+		log.Fatal(err)
 	}
 }
@@ -0,0 +1,47 @@
+// Package phrase counts the tokens of keywords for the library and the generator.
+package phrase
+
+import (
+	"strings"
+	"unicode"
+)
+
+// unspacedScripts are scripts which are written without spaces between words.
+var unspacedScripts = []*unicode.RangeTable{
+	unicode.Han,
+	unicode.Hiragana,
+	unicode.Katakana,
+	unicode.Thai,
+	unicode.Lao,
+	unicode.Khmer,
+	unicode.Myanmar,
+}
+
+const katakanaProlongedSoundMark = 'ー'
+
+// IsUnspacedScript reports whether the character belongs to a script which is written
+// without spaces between words, like Chinese, Japanese or Thai.
+func IsUnspacedScript(r rune) bool {
+	return r == katakanaProlongedSoundMark || unicode.In(r, unspacedScripts...)
+}
+
+// Length returns the maximum number of tokens a keyword is split into. Words count
+// as one token, except characters of unspaced scripts which are tokens of their own.
+// It is the phrase length counted by MaxPhraseLength of the dictionaries.
+func Length(keyword string) int {
+	length := 0
+	for _, word := range strings.Fields(keyword) {
+		inRun := false
+		for _, r := range word {
+			switch {
+			case IsUnspacedScript(r):
+				length++
+				inRun = false
+			case !inRun:
+				length++
+				inRun = true
+			}
+		}
+	}
+	return length
+}
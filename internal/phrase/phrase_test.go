@@ -0,0 +1,23 @@
+package phrase
+
+import "testing"
+
+func TestLength(t *testing.T) {
+	tests := []struct {
+		keyword string
+		want    int
+	}{
+		{keyword: "green apple", want: 2},
+		{keyword: "a button (blood type)", want: 4},
+		{keyword: "寿司", want: 2},
+		{keyword: "ラーメン", want: 4},
+		{keyword: "寿司 bar", want: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.keyword, func(t *testing.T) {
+			if got := Length(tt.keyword); got != tt.want {
+				t.Errorf("Length() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package goemoji
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/jo-hoe/goemoji/internal/casing"
+)
+
+const defaultLocale = "en"
+
+var (
+	localesMutex sync.RWMutex
+	// registeredLocales holds dictionaries registered at runtime.
+	// They take precedence over the generated localeTables.
+	registeredLocales = make(map[string]Dictionary)
+)
+
+// RegisterLocale makes a dictionary available for WithLocale.
+// It replaces any dictionary registered or generated for the locale before.
+func RegisterLocale(locale string, dictionary Dictionary) error {
+	locale = casing.CanonicalLocale(locale)
+	if locale == "" {
+		return fmt.Errorf("locale cannot be empty")
+	}
+	if dictionary == nil {
+		return fmt.Errorf("dictionary of locale '%s' cannot be nil", locale)
+	}
+
+	localesMutex.Lock()
+	defer localesMutex.Unlock()
+	registeredLocales[locale] = dictionary
+	return nil
+}
+
+// Locales returns the locales which have a dictionary, including the default locale.
+func Locales() []string {
+	localesMutex.RLock()
+	defer localesMutex.RUnlock()
+
+	result := []string{defaultLocale}
+	for locale := range localeTables {
+		result = appendLocale(result, locale)
+	}
+	for locale := range registeredLocales {
+		result = appendLocale(result, locale)
+	}
+	return result
+}
+
+func appendLocale(locales []string, locale string) []string {
+	for _, existing := range locales {
+		if existing == locale {
+			return locales
+		}
+	}
+	return append(locales, locale)
+}
+
+// WithLocale selects the dictionary of the locale and applies its lowercasing rules.
+// Locales without a dictionary fall back to their parent locale and finally to English,
+// e.g. "de-CH" falls back to "de" and then to "en", and use the lowercasing rules of
+// the locale they fall back to. A dictionary set with WithDictionary takes precedence
+// over the dictionary of the locale and is lowercased by the rules of the locale.
+func WithLocale(locale string) Option {
+	return func(e *Emojifier) {
+		e.locale = casing.CanonicalLocale(locale)
+	}
+}
+
+// withLocaleTables replaces the generated locale dictionaries, e.g. by tables of a test.
+func withLocaleTables(tables map[string]*tableDictionary) Option {
+	return func(e *Emojifier) {
+		e.localeTables = tables
+	}
+}
+
+// localeDictionary returns the dictionary of the first locale in the fallback chain of the locale
+// together with that locale. Registered dictionaries take precedence over the tables.
+func localeDictionary(locale string, tables map[string]*tableDictionary) (Dictionary, string) {
+	localesMutex.RLock()
+	defer localesMutex.RUnlock()
+
+	for _, candidate := range localeFallbacks(locale) {
+		if dictionary, ok := registeredLocales[candidate]; ok {
+			return dictionary, candidate
+		}
+		if table, ok := tables[candidate]; ok {
+			return table, candidate
+		}
+	}
+	return defaultDictionary, defaultLocale
+}
+
+// localeFallbacks returns the locale followed by its parent locales and the default locale.
+func localeFallbacks(locale string) []string {
+	fallbacks := make([]string, 0)
+	for locale != "" {
+		fallbacks = append(fallbacks, locale)
+		i := strings.LastIndex(locale, casing.LocaleSeparator)
+		if i < 0 {
+			break
+		}
+		locale = locale[:i]
+	}
+	if len(fallbacks) == 0 || fallbacks[len(fallbacks)-1] != defaultLocale {
+		fallbacks = append(fallbacks, defaultLocale)
+	}
+	return fallbacks
+}
+
+// caseMapper is implemented by dictionaries whose keywords need locale specific lowercasing.
+type caseMapper interface {
+	ToLower(text string) string
+}
+
+// toLower lowercases the text according to the rules of the dictionary's locale.
+func toLower(dictionary Dictionary, text string) string {
+	if mapper, ok := dictionary.(caseMapper); ok {
+		return mapper.ToLower(text)
+	}
+	return strings.ToLower(text)
+}
+
+// localizedDictionary adds locale specific lowercasing to a Dictionary.
+type localizedDictionary struct {
+	Dictionary
+	toLower func(string) string
+}
+
+func (l localizedDictionary) ToLower(text string) string {
+	return l.toLower(text)
+}
+
+func (l localizedDictionary) LookupWeighted(keyword string) ([]WeightedEmoji, bool) {
+	return lookupWeighted(l.Dictionary, keyword)
+}
@@ -0,0 +1,137 @@
+package goemoji
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWithLocale(t *testing.T) {
+	german := NewMapDictionary(map[string][]string{"apfel": {"🍎"}, "roter apfel": {"🍎"}})
+	if err := RegisterLocale("de", german); err != nil {
+		t.Fatalf("RegisterLocale() error = %v", err)
+	}
+	defer delete(registeredLocales, "de")
+
+	tests := []struct {
+		name   string
+		locale string
+		input  string
+		want   string
+	}{
+		{name: "registered locale", locale: "de", input: "Ein roter Apfel", want: "ein 🍎"},
+		{name: "fallback to parent locale", locale: "de_CH", input: "Ein Apfel", want: "ein 🍎"},
+		{name: "fallback to default locale", locale: "fr", input: "une apple", want: "une 🍎"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emojifier, err := NewEmojifier(ReplaceSubstring{}, 1, WithLocale(tt.locale))
+			if err != nil {
+				t.Fatalf("NewEmojifier() error = %v", err)
+			}
+			if got := emojifier.Emojify(tt.input); got != tt.want {
+				t.Errorf("Emojify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithLocale_Lowercasing(t *testing.T) {
+	dictionary := NewMapDictionary(map[string][]string{"kırmızı elma": {"🍎"}, "οδός": {"🛣️"}})
+
+	turkish, err := NewEmojifier(ReplaceSubstring{}, 1, WithDictionary(dictionary), WithLocale("tr"))
+	if err != nil {
+		t.Fatalf("NewEmojifier() error = %v", err)
+	}
+	if got, want := turkish.Emojify("KIRMIZI ELMA"), "🍎"; got != want {
+		t.Errorf("Emojify() = %v, want %v", got, want)
+	}
+
+	greek, err := NewEmojifier(ReplaceSubstring{}, 1, WithDictionary(dictionary), WithLocale("el"))
+	if err != nil {
+		t.Fatalf("NewEmojifier() error = %v", err)
+	}
+	if got, want := greek.Emojify("ΟΔΌΣ"), "🛣️"; got != want {
+		t.Errorf("Emojify() = %v, want %v", got, want)
+	}
+	if err := greek.AddKeyword("ΣΟΦΟΣ", "🦉"); err != nil {
+		t.Fatalf("AddKeyword() error = %v", err)
+	}
+	if _, ok := greek.Dictionary().Lookup("σοφος"); !ok {
+		t.Error("AddKeyword() did not apply the lowercasing of the locale")
+	}
+}
+
+func TestWithLocale_GeneratedTable(t *testing.T) {
+	tables := map[string]*tableDictionary{
+		"de": {
+			entries: []dictionaryEntry{
+				{keyword: "apfel", emojis: []WeightedEmoji{{Emoji: "🍎", Source: SourceDescription, Weight: WeightDescription}}},
+			},
+			emojis:          []string{"🍎"},
+			maxPhraseLength: 1,
+		},
+	}
+
+	for _, locale := range []string{"de", "de-CH"} {
+		t.Run(locale, func(t *testing.T) {
+			emojifier, err := NewEmojifier(ReplaceSubstring{}, 1, WithLocale(locale), withLocaleTables(tables))
+			if err != nil {
+				t.Fatalf("NewEmojifier() error = %v", err)
+			}
+			if got, want := emojifier.Emojify("Ein Apfel"), "ein 🍎"; got != want {
+				t.Errorf("Emojify() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestWithLocale_FallbackLowercasing(t *testing.T) {
+	emojifier, err := NewEmojifier(ReplaceSubstring{}, 1, WithLocale("tr"))
+	if err != nil {
+		t.Fatalf("NewEmojifier() error = %v", err)
+	}
+	if got, want := emojifier.Emojify("ICE"), "🧊"; got != want {
+		t.Errorf("Emojify() = %v, want %v", got, want)
+	}
+}
+
+func TestRegisterLocale_ValidationErrors(t *testing.T) {
+	if err := RegisterLocale(" ", testDictionary); err == nil {
+		t.Error("RegisterLocale() with empty locale error = nil")
+	}
+	if err := RegisterLocale("de", nil); err == nil {
+		t.Error("RegisterLocale() with nil dictionary error = nil")
+	}
+}
+
+func TestLocales(t *testing.T) {
+	if err := RegisterLocale("nl", testDictionary); err != nil {
+		t.Fatalf("RegisterLocale() error = %v", err)
+	}
+	defer delete(registeredLocales, "nl")
+
+	locales := Locales()
+	if locales[0] != defaultLocale || !strings.Contains(strings.Join(locales, ","), "nl") {
+		t.Errorf("Locales() = %v, want default locale and 'nl'", locales)
+	}
+}
+
+func Test_localeFallbacks(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   []string
+	}{
+		{locale: "de-ch", want: []string{"de-ch", "de", "en"}},
+		{locale: "zh-hant-tw", want: []string{"zh-hant-tw", "zh-hant", "zh", "en"}},
+		{locale: "en", want: []string{"en"}},
+		{locale: "", want: []string{"en"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			if got := localeFallbacks(tt.locale); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("localeFallbacks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package goemoji
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NormalizationForm selects the changes Normalize makes to the emojis of a text.
+// Forms can be combined, e.g. NormalizeFullyQualified | NormalizeStripSkinTones.
+type NormalizationForm uint8
+
+const (
+	// NormalizeFullyQualified converts emojis to their fully-qualified form, e.g. the text
+	// presentation sequence ❤︎ becomes ❤️ and ✌🏽 without skin tone becomes ✌️. Only characters
+	// with a variation selector or within a sequence are changed, so © and ™ in prose are kept.
+	NormalizeFullyQualified NormalizationForm = 1 << iota
+	// NormalizeStripVariationSelectors removes the text and emoji presentation selectors
+	// U+FE0E and U+FE0F. It is applied after NormalizeFullyQualified.
+	NormalizeStripVariationSelectors
+	// NormalizeStripSkinTones removes skin tone modifiers, e.g. 👍🏽 becomes 👍.
+	NormalizeStripSkinTones
+	// NormalizeStripGender replaces gendered emojis by their gender neutral form,
+	// e.g. 🏃‍♀️ becomes 🏃 and 👩‍🎨 becomes 🧑‍🎨.
+	NormalizeStripGender
+)
+
+const (
+	variationSelector15      = '\uFE0E'
+	combiningEnclosingKeycap = '\u20E3'
+	tagFirst                 = '\U000E0020'
+	tagLast                  = '\U000E007F'
+	regionalIndicatorFirst   = '\U0001F1E6'
+	regionalIndicatorLast    = '\U0001F1FF'
+	// keycapBases are the characters of keycap sequences like #️⃣.
+	keycapBases = "0123456789#*"
+)
+
+// neutralForms maps the gendered forms of defaultVariants to their gender neutral emoji.
+var neutralForms = sync.OnceValue(func() map[string]string {
+	forms := make(map[string]string)
+	for _, variant := range defaultVariants {
+		for _, gendered := range []string{variant.female, variant.male} {
+			if gendered != "" {
+				forms[gendered] = variant.emoji
+			}
+		}
+	}
+	return forms
+})
+
+// Normalize converts the emojis of the text to the form, so the same emoji sent by
+// different clients compares equal. Other text is kept as it is.
+func Normalize(text string, form NormalizationForm) string {
+	return normalize(text, form, false)
+}
+
+// normalizeEmoji is Normalize for text which consists of emojis only, so single
+// characters like ❤ are qualified as well.
+func normalizeEmoji(emoji string, form NormalizationForm) string {
+	return normalize(emoji, form, true)
+}
+
+func normalize(text string, form NormalizationForm, qualifyCharacters bool) string {
+	var builder strings.Builder
+	for len(text) > 0 {
+		length := emojiSequenceLength(text)
+		sequence, sequenceForm := text[:length], form
+		if !qualifyCharacters && utf8.RuneCountInString(sequence) == 1 {
+			sequenceForm &^= NormalizeFullyQualified
+		}
+		builder.WriteString(normalizeSequence(sequence, sequenceForm))
+		text = text[length:]
+	}
+	return builder.String()
+}
+
+// EqualEmoji reports whether a and b are the same emoji regardless of their qualification.
+// The form adds further differences to ignore, e.g. NormalizeStripSkinTones to treat 👍
+// and 👍🏽 as equal.
+func EqualEmoji(a, b string, form NormalizationForm) bool {
+	form |= NormalizeFullyQualified
+	return normalizeEmoji(a, form) == normalizeEmoji(b, form)
+}
+
+// emojiSequenceLength returns the length in bytes of the flag or character at the start of the
+// text together with the selectors, modifiers, keycaps, tags and joined characters which follow it.
+func emojiSequenceLength(text string) int {
+	first, length := utf8.DecodeRuneInString(text)
+	second, size := utf8.DecodeRuneInString(text[length:])
+	if isRegionalIndicator(first) && isRegionalIndicator(second) {
+		return length + size
+	}
+	for length < len(text) {
+		r, size := utf8.DecodeRuneInString(text[length:])
+		switch {
+		case r == variationSelector15 || r == variationSelector16 || isSkinToneModifier(r) ||
+			r == combiningEnclosingKeycap || (r >= tagFirst && r <= tagLast):
+			length += size
+		case string(r) == zeroWidthJoiner && length+size < len(text):
+			_, next := utf8.DecodeRuneInString(text[length+size:])
+			length += size + next
+		default:
+			return length
+		}
+	}
+	return length
+}
+
+func normalizeSequence(sequence string, form NormalizationForm) string {
+	if form&NormalizeStripSkinTones != 0 {
+		sequence = strings.Map(func(r rune) rune {
+			if isSkinToneModifier(r) {
+				return -1
+			}
+			return r
+		}, sequence)
+	}
+	if form&NormalizeStripGender != 0 {
+		sequence = neutralForm(sequence)
+	}
+	if form&NormalizeFullyQualified != 0 {
+		sequence = fullyQualify(sequence)
+	}
+	if form&NormalizeStripVariationSelectors != 0 {
+		sequence = removeVariationSelectors(sequence)
+	}
+	return sequence
+}
+
+// neutralForm returns the gender neutral form of the emoji with the same skin tone
+// or the emoji itself if it has no gender neutral form.
+func neutralForm(emoji string) string {
+	tone := SkinToneDefault
+	untoned := strings.Map(func(r rune) rune {
+		if isSkinToneModifier(r) {
+			tone = SkinToneLight + SkinTone(r-skinToneModifierLight)
+			return -1
+		}
+		return r
+	}, emoji)
+
+	neutral, ok := neutralForms()[fullyQualify(untoned)]
+	if !ok {
+		return emoji
+	}
+	return ApplySkinTone(neutral, tone)
+}
+
+// fullyQualify replaces the variation selectors of the emoji by those of its fully-qualified form.
+func fullyQualify(emoji string) string {
+	emoji = removeVariationSelectors(emoji)
+
+	var builder strings.Builder
+	for i, r := range emoji {
+		builder.WriteRune(r)
+		next, _ := utf8.DecodeRuneInString(emoji[i+utf8.RuneLen(r):])
+		isKeycap := next == combiningEnclosingKeycap && strings.ContainsRune(keycapBases, r)
+		if isKeycap || (isTextDefault(r) && !isSkinToneModifier(next)) {
+			builder.WriteRune(variationSelector16)
+		}
+	}
+	return builder.String()
+}
+
+// isTextDefault reports whether the character is an emoji which is displayed as text unless
+// followed by U+FE0F. The digits, # and * are left out as they are emojis only in keycap sequences.
+func isTextDefault(r rune) bool {
+	return unicode.Is(emojiCharacters, r) && !unicode.Is(emojiPresentation, r) && !strings.ContainsRune(keycapBases, r)
+}
+
+func removeVariationSelectors(emoji string) string {
+	return strings.Map(func(r rune) rune {
+		if r == variationSelector15 || r == variationSelector16 {
+			return -1
+		}
+		return r
+	}, emoji)
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= regionalIndicatorFirst && r <= regionalIndicatorLast
+}
+
+func isSkinToneModifier(r rune) bool {
+	return r >= skinToneModifierLight && r < skinToneModifierLight+rune(SkinToneDark)
+}
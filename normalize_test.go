@@ -0,0 +1,101 @@
+package goemoji
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		form NormalizationForm
+		want string
+	}{
+		{name: "single character", text: "I ❤ Go", form: NormalizeFullyQualified, want: "I ❤ Go"},
+		{name: "prose", text: "© 2024 ACME™", form: NormalizeFullyQualified, want: "© 2024 ACME™"},
+		{name: "text presentation", text: "❤\uFE0E", form: NormalizeFullyQualified, want: "❤️"},
+		{name: "already qualified", text: "👍 ❤️", form: NormalizeFullyQualified, want: "👍 ❤️"},
+		{name: "minimally-qualified sequence", text: "🏌\u200d♀️", form: NormalizeFullyQualified,
+			want: "🏌️\u200d♀️"},
+		{name: "keycap", text: "#⃣ 2024", form: NormalizeFullyQualified, want: "#️⃣ 2024"},
+		{name: "skin tone keeps selector off", text: "✌🏽", form: NormalizeFullyQualified, want: "✌🏽"},
+		{name: "strip variation selectors", text: "❤️ ❤\uFE0E", form: NormalizeStripVariationSelectors,
+			want: "❤ ❤"},
+		{name: "strip skin tones", text: "👍🏽 🧑🏿\u200d🎨", form: NormalizeStripSkinTones, want: "👍 🧑\u200d🎨"},
+		{name: "strip skin tone and qualify", text: "✌🏽", form: NormalizeStripSkinTones | NormalizeFullyQualified,
+			want: "✌️"},
+		{name: "strip gender sign", text: "🏃\u200d♀️", form: NormalizeStripGender, want: "🏃"},
+		{name: "strip gendered person", text: "👩\u200d🎨", form: NormalizeStripGender, want: "🧑\u200d🎨"},
+		{name: "strip gender keeps skin tone", text: "🏃🏽\u200d♂️", form: NormalizeStripGender, want: "🏃🏽"},
+		{name: "strip gender of unqualified", text: "🏃\u200d♀", form: NormalizeStripGender, want: "🏃"},
+		{name: "family stays", text: "👩\u200d👧", form: NormalizeStripGender, want: "👩\u200d👧"},
+		{name: "flags", text: "🇩🇪 🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F",
+			form: NormalizeFullyQualified, want: "🇩🇪 🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F"},
+		{name: "plain text", text: "version 1.2 #3", form: NormalizeFullyQualified, want: "version 1.2 #3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.text, tt.form); got != tt.want {
+				t.Errorf("Normalize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmojiSequenceLength(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "🇩🇪🇫🇷", want: "🇩🇪"},
+		{text: "👩🏽\u200d🎨 artist", want: "👩🏽\u200d🎨"},
+		{text: "#️⃣1", want: "#️⃣"},
+		{text: "a\u200d", want: "a"},
+		{text: "abc", want: "a"},
+	}
+	for _, tt := range tests {
+		if got := tt.text[:emojiSequenceLength(tt.text)]; got != tt.want {
+			t.Errorf("emojiSequenceLength(%q) covers %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func Test_isTextDefault(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want bool
+	}{
+		{'©', true}, {'❤', true}, {'☺', true}, {'\U0001F321', true},
+		{'😀', false}, {'⌚', false}, {'1', false}, {'#', false}, {'a', false}, {'★', false},
+	}
+	for _, tt := range tests {
+		if got := isTextDefault(tt.r); got != tt.want {
+			t.Errorf("isTextDefault(%q) = %v, want %v", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestEqualEmoji(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		form NormalizationForm
+		want bool
+	}{
+		{name: "qualification", a: "❤", b: "❤️", want: true},
+		{name: "different emojis", a: "❤️", b: "💙", want: false},
+		{name: "skin tone", a: "👍", b: "👍🏽", want: false},
+		{name: "ignored skin tone", a: "👍", b: "👍🏽", form: NormalizeStripSkinTones, want: true},
+		{name: "ignored gender", a: "🏃\u200d♀️", b: "🏃\u200d♂", form: NormalizeStripGender, want: true},
+		{name: "ignored gender and skin tone", a: "👩🏻\u200d🎨", b: "🧑\u200d🎨",
+			form: NormalizeStripGender | NormalizeStripSkinTones, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualEmoji(tt.a, tt.b, tt.form); got != tt.want {
+				t.Errorf("EqualEmoji(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
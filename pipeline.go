@@ -0,0 +1,472 @@
+package goemoji
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	leadingTrimCharacters  = "\"'([{<«¿¡「『（"
+	trailingTrimCharacters = ".,;:!?\"')]}>»…。、！？」』）"
+	markdownTrimCharacters = "*_~#>"
+	codeFence              = "```"
+)
+
+// Token is a word of the input text. Start and End are byte offsets into the input.
+type Token struct {
+	Text  string
+	Start int
+	End   int
+}
+
+// Match is a phrase of the input text which has emoji candidates.
+// Start and End are byte offsets into the input.
+type Match struct {
+	Phrase     string
+	Start      int
+	End        int
+	Candidates []string
+	// Weight is the weight of the first candidate, see WeightedDictionary.
+	Weight int
+}
+
+// Selection is a match together with the emoji chosen for it.
+type Selection struct {
+	Match
+	Emoji string
+}
+
+// Tokenizer splits a text into tokens.
+type Tokenizer interface {
+	Tokenize(input string) []Token
+}
+
+// Matcher finds phrases with emoji candidates within tokens.
+// Implementations return non-overlapping matches ordered by their position.
+type Matcher interface {
+	Match(tokens []Token, minimumWordLength int, dictionary Dictionary) []Match
+}
+
+// Selector chooses the emoji for a match. An empty result discards the match.
+type Selector interface {
+	Select(match Match) string
+}
+
+// Renderer creates the output text from the input and the selected emojis.
+type Renderer interface {
+	Render(input string, selections []Selection) string
+}
+
+// Pipeline is an EmojifyStrategy composed of a Tokenizer, Matcher, Selector and Renderer.
+// Nil components fall back to WhitespaceTokenizer, PhraseMatcher, FirstCandidateSelector
+// and ReplaceRenderer.
+type Pipeline struct {
+	Tokenizer Tokenizer
+	Matcher   Matcher
+	Selector  Selector
+	Renderer  Renderer
+	// Lowercase lowercases the input before it is tokenized, so the whole output is
+	// lowercase like the one of ReplaceSubstring.
+	Lowercase bool
+}
+
+// Emojify runs the input text through all stages of the pipeline.
+func (p Pipeline) Emojify(
+	input string,
+	minimumWordLength int,
+	dictionary Dictionary,
+) (output string) {
+	if p.Lowercase {
+		input = toLower(dictionary, input)
+	}
+	tokens := p.tokenizer().Tokenize(input)
+	matches := p.matcher().Match(tokens, minimumWordLength, dictionary)
+
+	selector := p.selector()
+	selections := make([]Selection, 0, len(matches))
+	for _, match := range matches {
+		if emoji := selector.Select(match); emoji != "" {
+			selections = append(selections, Selection{Match: match, Emoji: emoji})
+		}
+	}
+
+	return p.renderer().Render(input, selections)
+}
+
+func (p Pipeline) tokenizer() Tokenizer {
+	if p.Tokenizer == nil {
+		return WhitespaceTokenizer{}
+	}
+	return p.Tokenizer
+}
+
+func (p Pipeline) matcher() Matcher {
+	if p.Matcher == nil {
+		return PhraseMatcher{}
+	}
+	return p.Matcher
+}
+
+func (p Pipeline) selector() Selector {
+	if p.Selector == nil {
+		return FirstCandidateSelector{}
+	}
+	return p.Selector
+}
+
+func (p Pipeline) renderer() Renderer {
+	if p.Renderer == nil {
+		return ReplaceRenderer{}
+	}
+	return p.Renderer
+}
+
+// WhitespaceTokenizer splits text at whitespace and strips surrounding punctuation from the words.
+type WhitespaceTokenizer struct{}
+
+// Tokenize splits the input into words.
+func (w WhitespaceTokenizer) Tokenize(input string) []Token {
+	return tokenizeWords(input, 0, len(input), "")
+}
+
+// SpaceTokenizer splits text at every single space and keeps punctuation, like the
+// strategies ReplaceSubstring, InsertBeforeString and InsertAfterString. Consecutive
+// spaces result in empty tokens, so phrases do not match across them.
+type SpaceTokenizer struct{}
+
+// Tokenize splits the input at spaces.
+func (s SpaceTokenizer) Tokenize(input string) []Token {
+	tokens := make([]Token, 0)
+	start := 0
+	for _, word := range strings.Split(input, " ") {
+		tokens = append(tokens, Token{Text: word, Start: start, End: start + len(word)})
+		start += len(word) + 1
+	}
+	return tokens
+}
+
+// MarkdownTokenizer splits Markdown text into words. Code blocks, inline code,
+// link targets and URLs are skipped and emphasis markers are stripped from the words.
+type MarkdownTokenizer struct{}
+
+// Tokenize splits the prose of the Markdown input into words.
+func (m MarkdownTokenizer) Tokenize(input string) []Token {
+	tokens := make([]Token, 0)
+	start := 0
+	for _, region := range markdownCodeRegions(input) {
+		tokens = append(tokens, tokenizeWords(input, start, region[0], markdownTrimCharacters)...)
+		start = region[1]
+	}
+	tokens = append(tokens, tokenizeWords(input, start, len(input), markdownTrimCharacters)...)
+
+	prose := tokens[:0]
+	for _, token := range tokens {
+		if !isURL(token.Text) {
+			prose = append(prose, token)
+		}
+	}
+	return prose
+}
+
+// markdownCodeRegions returns the byte ranges of fenced code blocks, inline code and link targets.
+func markdownCodeRegions(input string) [][2]int {
+	regions := make([][2]int, 0)
+	for pos := 0; pos < len(input); {
+		switch {
+		case strings.HasPrefix(input[pos:], codeFence):
+			end := strings.Index(input[pos+len(codeFence):], codeFence)
+			if end < 0 {
+				return append(regions, [2]int{pos, len(input)})
+			}
+			end += pos + 2*len(codeFence)
+			regions = append(regions, [2]int{pos, end})
+			pos = end
+		case input[pos] == '`':
+			end := strings.IndexByte(input[pos+1:], '`')
+			if end < 0 {
+				pos++
+				continue
+			}
+			end += pos + 2
+			regions = append(regions, [2]int{pos, end})
+			pos = end
+		case strings.HasPrefix(input[pos:], "]("):
+			end := strings.IndexByte(input[pos:], ')')
+			if end < 0 {
+				pos++
+				continue
+			}
+			end += pos + 1
+			regions = append(regions, [2]int{pos, end})
+			pos = end
+		default:
+			pos++
+		}
+	}
+	return regions
+}
+
+func isURL(word string) bool {
+	return strings.Contains(word, "://") || strings.HasPrefix(word, "www.")
+}
+
+// tokenizeWords splits input[start:end] at whitespace and trims punctuation and
+// the additional characters from the words.
+func tokenizeWords(input string, start, end int, trimCharacters string) []Token {
+	tokens := make([]Token, 0)
+	for pos := start; pos < end; {
+		wordStart := skipRunes(input[:end], pos, unicode.IsSpace)
+		wordEnd := skipRunes(input[:end], wordStart, func(r rune) bool { return !unicode.IsSpace(r) })
+		pos = wordEnd
+
+		for wordStart < wordEnd {
+			r, size := utf8.DecodeRuneInString(input[wordStart:wordEnd])
+			if !strings.ContainsRune(leadingTrimCharacters+trimCharacters, r) {
+				break
+			}
+			wordStart += size
+		}
+		for wordStart < wordEnd {
+			r, size := utf8.DecodeLastRuneInString(input[wordStart:wordEnd])
+			if !strings.ContainsRune(trailingTrimCharacters+trimCharacters, r) {
+				break
+			}
+			wordEnd -= size
+		}
+		if wordStart < wordEnd {
+			tokens = append(tokens, Token{Text: input[wordStart:wordEnd], Start: wordStart, End: wordEnd})
+		}
+	}
+	return tokens
+}
+
+// PhraseMatcher matches phrases of consecutive tokens which are keywords.
+// Matching is case-insensitive and the minimum word length is counted in characters.
+// Overlapping phrases are resolved by the weight of their first candidate, so with a
+// WeightedDictionary a description of an emoji wins over a loosely associated tag.
+type PhraseMatcher struct{}
+
+// Match finds keyword phrases, preferring phrases with a higher weight. Phrases of equal
+// weight are chosen from left to right, preferring longer phrases.
+func (p PhraseMatcher) Match(tokens []Token, minimumWordLength int, dictionary Dictionary) []Match {
+	type candidate struct {
+		match       Match
+		first, last int
+	}
+
+	// candidates are collected by position and then by descending length
+	candidates := make([]candidate, 0)
+	for i := range tokens {
+		for n := min(dictionary.MaxPhraseLength(), len(tokens)-i); n > 0; n-- {
+			if match, ok := phraseMatch(tokens[i:i+n], minimumWordLength, dictionary, utf8.RuneCountInString); ok {
+				candidates = append(candidates, candidate{match: match, first: i, last: i + n - 1})
+			}
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return b.match.Weight - a.match.Weight
+	})
+
+	matched := make([]bool, len(tokens))
+	matches := make([]Match, 0)
+	for _, c := range candidates {
+		if slices.Contains(matched[c.first:c.last+1], true) {
+			continue
+		}
+		for i := c.first; i <= c.last; i++ {
+			matched[i] = true
+		}
+		matches = append(matches, c.match)
+	}
+	slices.SortFunc(matches, func(a, b Match) int {
+		return a.Start - b.Start
+	})
+	return matches
+}
+
+// phraseMatch looks up the tokens as phrase if its length is at least minimumWordLength.
+func phraseMatch(
+	tokens []Token,
+	minimumWordLength int,
+	dictionary Dictionary,
+	length func(string) int,
+) (match Match, ok bool) {
+	phrase := joinTokens(tokens, dictionary)
+	if length(phrase) < minimumWordLength {
+		return Match{}, false
+	}
+	emojis, ok := lookupWeighted(dictionary, phrase)
+	if !ok || len(emojis) == 0 {
+		return Match{}, false
+	}
+	return Match{
+		Phrase:     phrase,
+		Start:      tokens[0].Start,
+		End:        tokens[len(tokens)-1].End,
+		Candidates: EmojiStrings(emojis),
+		Weight:     emojis[0].Weight,
+	}, true
+}
+
+// LongestPhraseMatcher matches phrases like ReplaceSubstring: longer phrases are matched
+// first, phrases of equal length from left to right. Matching is case-insensitive, the
+// minimum word length is counted in bytes and the weights of a WeightedDictionary are ignored.
+type LongestPhraseMatcher struct {
+	// IncludeEmojis also matches the emojis of the dictionary which are already in the
+	// tokens, so renderers which collect the emojis repeat them like InsertBeforeString.
+	IncludeEmojis bool
+}
+
+// Match finds keyword phrases, longest first.
+func (l LongestPhraseMatcher) Match(tokens []Token, minimumWordLength int, dictionary Dictionary) []Match {
+	matched := make([]bool, len(tokens))
+	matches := make([]Match, 0)
+	for n := min(dictionary.MaxPhraseLength(), len(tokens)); n > 0; n-- {
+		for i := 0; i+n <= len(tokens); i++ {
+			if slices.Contains(matched[i:i+n], true) {
+				continue
+			}
+			match, ok := phraseMatch(tokens[i:i+n], minimumWordLength, dictionary, byteLength)
+			if !ok {
+				continue
+			}
+			for j := i; j < i+n; j++ {
+				matched[j] = true
+			}
+			matches = append(matches, match)
+		}
+	}
+
+	if l.IncludeEmojis {
+		for i, token := range tokens {
+			if !matched[i] {
+				matches = append(matches, emojiMatches(token, dictionary)...)
+			}
+		}
+	}
+	slices.SortFunc(matches, func(a, b Match) int {
+		return a.Start - b.Start
+	})
+	return matches
+}
+
+func byteLength(text string) int {
+	return len(text)
+}
+
+// emojiMatches returns the emojis of the token which belong to the dictionary as matches of themselves.
+func emojiMatches(token Token, dictionary Dictionary) []Match {
+	matches := make([]Match, 0)
+	for _, segment := range Segment(token.Text) {
+		if segment.IsEmoji && dictionary.ContainsEmoji(segment.Text) {
+			matches = append(matches, Match{
+				Phrase:     segment.Text,
+				Start:      token.Start + segment.Start,
+				End:        token.Start + segment.End,
+				Candidates: []string{segment.Text},
+			})
+		}
+	}
+	return matches
+}
+
+// joinTokens lowercases the tokens and joins them with spaces. Tokens which are
+// adjacent in the input, like characters of unspaced scripts, are joined without space.
+func joinTokens(tokens []Token, dictionary Dictionary) string {
+	var builder strings.Builder
+	for i, token := range tokens {
+		if i > 0 && token.Start > tokens[i-1].End {
+			builder.WriteString(" ")
+		}
+		builder.WriteString(toLower(dictionary, token.Text))
+	}
+	return builder.String()
+}
+
+// FirstCandidateSelector selects the first candidate of each match.
+type FirstCandidateSelector struct{}
+
+// Select returns the first candidate of the match.
+func (f FirstCandidateSelector) Select(match Match) string {
+	if len(match.Candidates) == 0 {
+		return ""
+	}
+	return match.Candidates[0]
+}
+
+// ReplaceRenderer replaces the matched phrases with their emojis.
+type ReplaceRenderer struct{}
+
+// Render replaces every selected phrase in the input with its emoji.
+func (r ReplaceRenderer) Render(input string, selections []Selection) string {
+	return renderAtSelections(input, selections, func(builder *strings.Builder, selection Selection) {
+		builder.WriteString(selection.Emoji)
+	})
+}
+
+// InsertAfterWordRenderer inserts the emojis directly after the matched phrases.
+type InsertAfterWordRenderer struct{}
+
+// Render inserts the emoji of every selection after its phrase.
+func (r InsertAfterWordRenderer) Render(input string, selections []Selection) string {
+	return renderAtSelections(input, selections, func(builder *strings.Builder, selection Selection) {
+		builder.WriteString(input[selection.Start:selection.End])
+		builder.WriteString(" ")
+		builder.WriteString(selection.Emoji)
+	})
+}
+
+// InsertBeforeRenderer inserts all emojis before the input text.
+type InsertBeforeRenderer struct{}
+
+// Render prepends the selected emojis to the input.
+func (r InsertBeforeRenderer) Render(input string, selections []Selection) string {
+	if len(selections) == 0 {
+		return input
+	}
+	return joinSelections(selections) + " " + input
+}
+
+// InsertAfterRenderer inserts all emojis after the input text.
+type InsertAfterRenderer struct{}
+
+// Render appends the selected emojis to the input.
+func (r InsertAfterRenderer) Render(input string, selections []Selection) string {
+	if len(selections) == 0 {
+		return input
+	}
+	return input + " " + joinSelections(selections)
+}
+
+func joinSelections(selections []Selection) string {
+	emojis := make([]string, len(selections))
+	for i, selection := range selections {
+		emojis[i] = selection.Emoji
+	}
+	return strings.Join(emojis, "")
+}
+
+// renderAtSelections copies the input and lets write render the selected phrases.
+func renderAtSelections(input string, selections []Selection, write func(*strings.Builder, Selection)) string {
+	sorted := make([]Selection, len(selections))
+	copy(sorted, selections)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+
+	var builder strings.Builder
+	builder.Grow(len(input))
+	pos := 0
+	for _, selection := range sorted {
+		if selection.Start < pos {
+			continue
+		}
+		builder.WriteString(input[pos:selection.Start])
+		write(&builder, selection)
+		pos = selection.End
+	}
+	builder.WriteString(input[pos:])
+
+	return builder.String()
+}
@@ -0,0 +1,274 @@
+package goemoji
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPipeline_Emojify(t *testing.T) {
+	tests := []struct {
+		name       string
+		p          Pipeline
+		input      string
+		wantOutput string
+	}{
+		{
+			name:       "zero value replaces phrases",
+			p:          Pipeline{},
+			input:      "They ate an Apple and a green apple.",
+			wantOutput: "They ate an 🍎 and a 🍏.",
+		}, {
+			name:       "insert after word",
+			p:          Pipeline{Renderer: InsertAfterWordRenderer{}},
+			input:      "they ate a pineapple, then an apple",
+			wantOutput: "they ate a pineapple 🍍, then an apple 🍎",
+		}, {
+			name:       "insert before",
+			p:          Pipeline{Renderer: InsertBeforeRenderer{}},
+			input:      "they ate an apple and a pineapple",
+			wantOutput: "🍎🍍 they ate an apple and a pineapple",
+		}, {
+			name:       "insert after",
+			p:          Pipeline{Renderer: InsertAfterRenderer{}},
+			input:      "they ate an apple and a pineapple",
+			wantOutput: "they ate an apple and a pineapple 🍎🍍",
+		}, {
+			name:       "no match keeps input",
+			p:          Pipeline{Renderer: InsertAfterRenderer{}},
+			input:      "they ate a banana",
+			wantOutput: "they ate a banana",
+		}, {
+			name: "markdown with insert after word",
+			p: Pipeline{
+				Tokenizer: MarkdownTokenizer{},
+				Renderer:  InsertAfterWordRenderer{},
+			},
+			input:      "**apple** and `apple` in [pineapple](https://example.com/apple)",
+			wantOutput: "**apple 🍎** and `apple` in [pineapple 🍍](https://example.com/apple)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotOutput := tt.p.Emojify(tt.input, 1, testDictionary)
+			if gotOutput != tt.wantOutput {
+				t.Errorf("Pipeline.Emojify() = %v, want %v", gotOutput, tt.wantOutput)
+			}
+		})
+	}
+}
+
+func TestWhitespaceTokenizer_Tokenize(t *testing.T) {
+	want := []Token{
+		{Text: "Hello", Start: 1, End: 6},
+		{Text: "world", Start: 9, End: 14},
+	}
+	if got := (WhitespaceTokenizer{}).Tokenize("(Hello,  world!)"); !reflect.DeepEqual(got, want) {
+		t.Errorf("WhitespaceTokenizer.Tokenize() = %v, want %v", got, want)
+	}
+}
+
+func TestSpaceTokenizer_Tokenize(t *testing.T) {
+	want := []Token{
+		{Text: "(Hello,", Start: 0, End: 7},
+		{Text: "", Start: 8, End: 8},
+		{Text: "world!)", Start: 9, End: 16},
+	}
+	if got := (SpaceTokenizer{}).Tokenize("(Hello,  world!)"); !reflect.DeepEqual(got, want) {
+		t.Errorf("SpaceTokenizer.Tokenize() = %v, want %v", got, want)
+	}
+}
+
+func TestMarkdownTokenizer_Tokenize(t *testing.T) {
+	input := "# Title\n```go\ncode block\n```\nsee _this_ `inline` https://example.com"
+	want := []string{"Title", "see", "this"}
+
+	tokens := (MarkdownTokenizer{}).Tokenize(input)
+	got := make([]string, len(tokens))
+	for i, token := range tokens {
+		got[i] = token.Text
+		if input[token.Start:token.End] != token.Text {
+			t.Errorf("token %q does not match input range %d:%d", token.Text, token.Start, token.End)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MarkdownTokenizer.Tokenize() = %v, want %v", got, want)
+	}
+}
+
+func TestPhraseMatcher_Match(t *testing.T) {
+	tokens := (WhitespaceTokenizer{}).Tokenize("green apple apple")
+	want := []Match{
+		{Phrase: "green apple", Start: 0, End: 11, Candidates: []string{"🍏"}, Weight: WeightUnknown},
+		{Phrase: "apple", Start: 12, End: 17, Candidates: []string{"🍎", "🍏"}, Weight: WeightUnknown},
+	}
+	if got := (PhraseMatcher{}).Match(tokens, 1, testDictionary); !reflect.DeepEqual(got, want) {
+		t.Errorf("PhraseMatcher.Match() = %v, want %v", got, want)
+	}
+
+	if got := (PhraseMatcher{}).Match(tokens, 6, testDictionary); len(got) != 1 {
+		t.Errorf("PhraseMatcher.Match() with minimum word length = %v, want 1 match", got)
+	}
+}
+
+func TestLongestPhraseMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name              string
+		input             string
+		keywords          []string
+		minimumWordLength int
+		includeEmojis     bool
+		want              []string
+	}{
+		{name: "single word", input: "The quick brown fox", keywords: []string{"fox"}, want: []string{"fox"}},
+		{
+			name:     "multi-word",
+			input:    "The quick brown fox",
+			keywords: []string{"quick brown"},
+			want:     []string{"quick brown"},
+		}, {
+			name:     "non alphabetical",
+			input:    "Th- qu1ck br0wn f0x",
+			keywords: []string{"th- qu1ck", "br0wn f0x"},
+			want:     []string{"th- qu1ck", "br0wn f0x"},
+		}, {
+			name:     "longer than the input",
+			input:    "The quick brown fox",
+			keywords: []string{"the quick brown fox jumps"},
+			want:     []string{},
+		}, {
+			name:     "longer phrase first",
+			input:    "a b c d",
+			keywords: []string{"a b", "b c d"},
+			want:     []string{"b c d"},
+		}, {
+			name:              "minimum word length in bytes",
+			input:             "é e",
+			keywords:          []string{"é", "e"},
+			minimumWordLength: 2,
+			want:              []string{"é"},
+		}, {
+			name:          "include emojis",
+			input:         "a 🍎 apple",
+			keywords:      []string{"a"},
+			includeEmojis: true,
+			want:          []string{"a", "🍎"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emojiTags := make(map[string][]string)
+			for _, keyword := range tt.keywords {
+				emojiTags[keyword] = []string{"🍎"}
+			}
+			tokens := (SpaceTokenizer{}).Tokenize(tt.input)
+			matches := (LongestPhraseMatcher{IncludeEmojis: tt.includeEmojis}).Match(
+				tokens, tt.minimumWordLength, NewMapDictionary(emojiTags))
+
+			got := make([]string, len(matches))
+			for i, match := range matches {
+				got[i] = match.Phrase
+				if !strings.EqualFold(tt.input[match.Start:match.End], match.Phrase) {
+					t.Errorf("match %q does not match input range %d:%d", match.Phrase, match.Start, match.End)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LongestPhraseMatcher.Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrategyPipelines(t *testing.T) {
+	dictionary := NewMapDictionary(map[string][]string{
+		"apple":       {"🍎", "🍏"},
+		"green apple": {"🍏"},
+		"green":       {"💚"},
+		"pineapple":   {"🍍"},
+		"hot dog":     {"🌭"},
+		"dog":         {"🐶"},
+		"ice cream":   {"🍨"},
+	})
+	inputs := []string{
+		"they ate an apple and a green apple and a pineapple",
+		"They ate an Apple, then a PINEAPPLE!",
+		"a  green  apple",
+		"what a delicious 🍎 apple",
+		"ice cream and hot dog with the dog",
+		"apple🍎 green apple",
+	}
+	tests := []struct {
+		name     string
+		strategy interface{ Pipeline() Pipeline }
+		want     []string
+	}{
+		{
+			name:     "replace substring",
+			strategy: ReplaceSubstring{},
+			want: []string{
+				"they ate an 🍎 and a 🍏 and a 🍍",
+				"they ate an apple, then a pineapple!",
+				"a  💚  🍎",
+				"what a delicious 🍎 🍎",
+				"🍨 and 🌭 with the dog",
+				"apple🍎 🍏",
+			},
+		}, {
+			name:     "insert before string",
+			strategy: InsertBeforeString{},
+			want: []string{
+				"🍎🍏🍍 they ate an apple and a green apple and a pineapple",
+				"They ate an Apple, then a PINEAPPLE!",
+				"💚🍎 a  green  apple",
+				"🍎🍎 what a delicious 🍎 apple",
+				"🍨🌭 ice cream and hot dog with the dog",
+				"🍎🍏 apple🍎 green apple",
+			},
+		}, {
+			name:     "insert after string",
+			strategy: InsertAfterString{},
+			want: []string{
+				"they ate an apple and a green apple and a pineapple 🍎🍏🍍",
+				"They ate an Apple, then a PINEAPPLE!",
+				"a  green  apple 💚🍎",
+				"what a delicious 🍎 apple 🍎🍎",
+				"ice cream and hot dog with the dog 🍨🌭",
+				"apple🍎 green apple 🍎🍏",
+			},
+		},
+	}
+	for _, tt := range tests {
+		for i, input := range inputs {
+			t.Run(tt.name+"/"+input, func(t *testing.T) {
+				strategy := tt.strategy.(EmojifyStrategy)
+				if got := strategy.Emojify(input, 4, dictionary); got != tt.want[i] {
+					t.Errorf("%T.Emojify() = %q, want %q", strategy, got, tt.want[i])
+				}
+				if got := tt.strategy.Pipeline().Emojify(input, 4, dictionary); got != tt.want[i] {
+					t.Errorf("Pipeline.Emojify() = %q, want %q", got, tt.want[i])
+				}
+			})
+		}
+	}
+}
+
+func TestPhraseMatcher_MatchWeighted(t *testing.T) {
+	dictionary := NewWeightedMapDictionary(map[string][]WeightedEmoji{
+		"hot dog": {{Emoji: "🌭", Source: SourceDescription, Weight: WeightDescription}},
+		"dog bed": {{Emoji: "🛏️", Source: SourceTag, Weight: WeightTag}},
+		"bed":     {{Emoji: "🛏️", Source: SourceDescription, Weight: WeightDescription}},
+		"hot":     {{Emoji: "🔥", Source: SourceTag, Weight: WeightTag}},
+	})
+
+	tokens := (WhitespaceTokenizer{}).Tokenize("hot dog bed")
+	got := (PhraseMatcher{}).Match(tokens, 1, dictionary)
+	want := []string{"hot dog", "bed"}
+	if len(got) != len(want) {
+		t.Fatalf("PhraseMatcher.Match() = %v, want phrases %v", got, want)
+	}
+	for i, match := range got {
+		if match.Phrase != want[i] {
+			t.Errorf("PhraseMatcher.Match() = %v, want phrases %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package goemoji
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TextSegment is a part of a text which is either a single emoji or text without emojis.
+type TextSegment struct {
+	Text string
+	// Start and End are the byte offsets of the segment in the text.
+	Start   int
+	End     int
+	IsEmoji bool
+}
+
+// IsEmoji reports whether the text is exactly one emoji, including sequences like
+// flags, keycaps, skin tones and ZWJ sequences. Characters like © or ❤, which are
+// displayed as text unless followed by U+FE0F, are emojis only with U+FE0F.
+// Unlike Emojifier.ContainsEmoji, it does not depend on the keyword dictionary.
+func IsEmoji(text string) bool {
+	return text != "" && emojiSequenceLength(text) == len(text) && isEmojiSequence(text)
+}
+
+// Segment splits the text into emojis and the text between them.
+func Segment(text string) []TextSegment {
+	segments := make([]TextSegment, 0)
+	textStart := 0
+	for position := 0; position < len(text); {
+		length := emojiSequenceLength(text[position:])
+		if !isEmojiSequence(text[position : position+length]) {
+			position += length
+			continue
+		}
+
+		if textStart < position {
+			segments = append(segments, TextSegment{Text: text[textStart:position], Start: textStart, End: position})
+		}
+		segments = append(segments, TextSegment{
+			Text:    text[position : position+length],
+			Start:   position,
+			End:     position + length,
+			IsEmoji: true,
+		})
+		position += length
+		textStart = position
+	}
+	if textStart < len(text) {
+		segments = append(segments, TextSegment{Text: text[textStart:], Start: textStart, End: len(text)})
+	}
+	return segments
+}
+
+// Emojis returns all emojis of the text in order of appearance.
+func Emojis(text string) []string {
+	emojis := make([]string, 0)
+	for _, segment := range Segment(text) {
+		if segment.IsEmoji {
+			emojis = append(emojis, segment.Text)
+		}
+	}
+	return emojis
+}
+
+// isEmojiSequence reports whether the sequence found by emojiSequenceLength is displayed
+// as an emoji: a flag, a keycap, a ZWJ sequence of pictographs or a pictograph with
+// emoji presentation, U+FE0F, a skin tone modifier or tags.
+func isEmojiSequence(sequence string) bool {
+	first, size := utf8.DecodeRuneInString(sequence)
+	rest := sequence[size:]
+	switch {
+	case isRegionalIndicator(first):
+		return rest != "" || unicode.Is(emojiPresentation, first)
+	case strings.ContainsRune(keycapBases, first):
+		return strings.TrimPrefix(rest, string(variationSelector16)) == string(combiningEnclosingKeycap)
+	case !unicode.Is(extendedPictographic, first):
+		return false
+	}
+
+	elements := strings.Split(sequence, zeroWidthJoiner)
+	if len(elements) > 1 {
+		for _, element := range elements {
+			if r, _ := utf8.DecodeRuneInString(element); !unicode.Is(extendedPictographic, r) {
+				return false
+			}
+		}
+		return true
+	}
+
+	next, _ := utf8.DecodeRuneInString(rest)
+	switch {
+	case next == variationSelector15:
+		return false
+	case next == variationSelector16 || (next >= tagFirst && next <= tagLast):
+		return true
+	case isSkinToneModifier(next) && unicode.Is(emojiModifierBase, first):
+		return true
+	default:
+		return unicode.Is(emojiPresentation, first)
+	}
+}
@@ -0,0 +1,74 @@
+package goemoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsEmoji(t *testing.T) {
+	tests := []struct {
+		text string
+		want bool
+	}{
+		{text: "😀", want: true},
+		{text: "❤️", want: true},
+		{text: "❤", want: false},
+		{text: "❤\uFE0E", want: false},
+		{text: "👍🏽", want: true},
+		{text: "👩\u200d🎨", want: true},
+		{text: "👁\u200d🗨", want: true},
+		{text: "🇩🇪", want: true},
+		{text: "#️⃣", want: true},
+		{text: "#⃣", want: true},
+		{text: "🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", want: true},
+		{text: "🫨", want: true},
+		{text: "😀😀", want: false},
+		{text: "a", want: false},
+		{text: "1", want: false},
+		{text: "", want: false},
+	}
+	for _, tt := range tests {
+		if got := IsEmoji(tt.text); got != tt.want {
+			t.Errorf("IsEmoji(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestSegment(t *testing.T) {
+	text := "Hi 👋🏽, ship it 🚢🇩🇪!"
+
+	want := []TextSegment{
+		{Text: "Hi ", Start: 0, End: 3},
+		{Text: "👋🏽", Start: 3, End: 11, IsEmoji: true},
+		{Text: ", ship it ", Start: 11, End: 21},
+		{Text: "🚢", Start: 21, End: 25, IsEmoji: true},
+		{Text: "🇩🇪", Start: 25, End: 33, IsEmoji: true},
+		{Text: "!", Start: 33, End: 34},
+	}
+	if got := Segment(text); !reflect.DeepEqual(got, want) {
+		t.Errorf("Segment() = %v, want %v", got, want)
+	}
+	if got := Segment(""); len(got) != 0 {
+		t.Errorf("Segment() = %v, want no segments", got)
+	}
+}
+
+func TestEmojis(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "not in dictionary", text: "shaking 🫨 face", want: []string{"🫨"}},
+		{name: "sequences", text: "👨\u200d👩\u200d👧 at 🏳️\u200d🌈 parade", want: []string{"👨\u200d👩\u200d👧", "🏳️\u200d🌈"}},
+		{name: "text presentation", text: "© 2024 ❤", want: []string{}},
+		{name: "no emojis", text: "plain text", want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Emojis(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Emojis() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
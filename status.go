@@ -0,0 +1,187 @@
+package goemoji
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sort"
+)
+
+// TestResult is the outcome of a test.
+type TestResult int
+
+const (
+	// TestPassed marks a successful test.
+	TestPassed TestResult = iota
+	// TestFailed marks a failed test.
+	TestFailed
+	// TestSkipped marks a test which did not run.
+	TestSkipped
+)
+
+func (r TestResult) String() string {
+	switch r {
+	case TestPassed:
+		return "passed"
+	case TestFailed:
+		return "failed"
+	case TestSkipped:
+		return "skipped"
+	default:
+		return fmt.Sprintf("TestResult(%d)", int(r))
+	}
+}
+
+// LogLevelEmojis maps ranges of log levels to emojis. It is created by NewLogLevelEmojis.
+type LogLevelEmojis struct {
+	// thresholds are ordered by ascending level.
+	thresholds []levelThreshold
+}
+
+type levelThreshold struct {
+	level slog.Level
+	emoji string
+}
+
+// NewLogLevelEmojis maps the lowest level of each range to its emoji. A level uses the
+// emoji of the highest key which is not above it, levels below all keys the lowest one.
+func NewLogLevelEmojis(emojis map[slog.Level]string) LogLevelEmojis {
+	thresholds := make([]levelThreshold, 0, len(emojis))
+	for _, level := range slices.Sorted(maps.Keys(emojis)) {
+		thresholds = append(thresholds, levelThreshold{level: level, emoji: emojis[level]})
+	}
+	return LogLevelEmojis{thresholds: thresholds}
+}
+
+// StatusEmojis maps log levels, HTTP status codes and test results to emojis.
+type StatusEmojis struct {
+	LogLevels LogLevelEmojis
+	// HTTPStatusCodes maps single status codes. Other codes use the emoji of their class
+	// in HTTPStatusClasses, e.g. 5 for 5xx.
+	HTTPStatusCodes   map[int]string
+	HTTPStatusClasses map[int]string
+	TestResults       map[TestResult]string
+}
+
+// DefaultStatusEmojis returns the mapping used by ForLogLevel, ForHTTPStatus and ForTestResult.
+// The result is a copy which can be changed to create a custom mapping.
+func DefaultStatusEmojis() StatusEmojis {
+	return StatusEmojis{
+		LogLevels: NewLogLevelEmojis(map[slog.Level]string{
+			slog.LevelDebug: "🐛",
+			slog.LevelInfo:  "ℹ️",
+			slog.LevelWarn:  "⚠️",
+			slog.LevelError: "❌",
+		}),
+		HTTPStatusCodes: map[int]string{
+			401: "🔒",
+			403: "⛔",
+			404: "🔍",
+			418: "🫖",
+			429: "🚦",
+		},
+		HTTPStatusClasses: map[int]string{
+			1: "⏳",
+			2: "✅",
+			3: "↪️",
+			4: "⚠️",
+			5: "🔥",
+		},
+		TestResults: map[TestResult]string{
+			TestPassed:  "✅",
+			TestFailed:  "❌",
+			TestSkipped: "⏭️",
+		},
+	}
+}
+
+var defaultStatusEmojis = DefaultStatusEmojis()
+
+// ForLogLevel returns the default emoji of a log level, e.g. ⚠️ for slog.LevelWarn.
+func ForLogLevel(level slog.Level) string {
+	return defaultStatusEmojis.ForLogLevel(level)
+}
+
+// ForHTTPStatus returns the default emoji of an HTTP status code, e.g. ✅ for 200 or 🔥 for 503.
+// Returns an empty string for codes outside of 100 to 599.
+func ForHTTPStatus(code int) string {
+	return defaultStatusEmojis.ForHTTPStatus(code)
+}
+
+// ForTestResult returns the default emoji of a test result, e.g. ❌ for TestFailed.
+func ForTestResult(result TestResult) string {
+	return defaultStatusEmojis.ForTestResult(result)
+}
+
+// ForLogLevel returns the emoji of the log level or an empty string if no levels are mapped.
+func (s StatusEmojis) ForLogLevel(level slog.Level) string {
+	thresholds := s.LogLevels.thresholds
+	if len(thresholds) == 0 {
+		return ""
+	}
+	i := sort.Search(len(thresholds), func(i int) bool { return thresholds[i].level > level })
+	return thresholds[max(i-1, 0)].emoji
+}
+
+// ForHTTPStatus returns the emoji of the HTTP status code or an empty string if neither
+// the code nor its class is mapped.
+func (s StatusEmojis) ForHTTPStatus(code int) string {
+	if code < 100 || code > 599 {
+		return ""
+	}
+	if emoji, ok := s.HTTPStatusCodes[code]; ok {
+		return emoji
+	}
+	return s.HTTPStatusClasses[code/100]
+}
+
+// ForTestResult returns the emoji of the test result or an empty string if it is not mapped.
+func (s StatusEmojis) ForTestResult(result TestResult) string {
+	return s.TestResults[result]
+}
+
+// Validate checks the emojis of the mapping like ValidateDictionary checks those of a
+// dictionary and reports every value which is not a single emoji. The keyword of an
+// issue names the status, e.g. "http status 5xx".
+func (s StatusEmojis) Validate(options ...ValidationOption) []ValidationIssue {
+	v := validation{}
+	for _, option := range options {
+		option(&v)
+	}
+
+	issues := make([]ValidationIssue, 0)
+	report := func(severity Severity, keyword, format string, args ...any) {
+		issues = append(issues, ValidationIssue{Severity: severity, Keyword: keyword, Message: fmt.Sprintf(format, args...)})
+	}
+	validate := func(keyword, emoji string) {
+		v.validateEmojis(keyword, []string{emoji}, report)
+		if emoji != "" && !IsEmoji(emoji) {
+			report(SeverityError, keyword, "has '%s' which is not a single emoji", emoji)
+		}
+	}
+
+	for _, threshold := range s.LogLevels.thresholds {
+		validate("log level "+threshold.level.String(), threshold.emoji)
+	}
+	for code, emoji := range s.HTTPStatusCodes {
+		if code < 100 || code > 599 {
+			report(SeverityWarning, fmt.Sprintf("http status %d", code), "is not a valid status code")
+		}
+		validate(fmt.Sprintf("http status %d", code), emoji)
+	}
+	for class, emoji := range s.HTTPStatusClasses {
+		if class < 1 || class > 5 {
+			report(SeverityWarning, fmt.Sprintf("http status %dxx", class), "is not a valid status class")
+		}
+		validate(fmt.Sprintf("http status %dxx", class), emoji)
+	}
+	for result, emoji := range s.TestResults {
+		validate("test result "+result.String(), emoji)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Keyword < issues[j].Keyword
+	})
+	return issues
+}
@@ -0,0 +1,114 @@
+package goemoji
+
+import (
+	"log/slog"
+	"reflect"
+	"testing"
+)
+
+func TestForLogLevel(t *testing.T) {
+	tests := []struct {
+		level slog.Level
+		want  string
+	}{
+		{level: slog.LevelDebug, want: "🐛"},
+		{level: slog.LevelDebug - 4, want: "🐛"},
+		{level: slog.LevelInfo, want: "ℹ️"},
+		{level: slog.LevelInfo + 2, want: "ℹ️"},
+		{level: slog.LevelWarn, want: "⚠️"},
+		{level: slog.LevelError, want: "❌"},
+		{level: slog.LevelError + 4, want: "❌"},
+	}
+	for _, tt := range tests {
+		if got := ForLogLevel(tt.level); got != tt.want {
+			t.Errorf("ForLogLevel(%s) = %s, want %s", tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestForHTTPStatus(t *testing.T) {
+	tests := []struct {
+		code int
+		want string
+	}{
+		{code: 100, want: "⏳"},
+		{code: 200, want: "✅"},
+		{code: 204, want: "✅"},
+		{code: 301, want: "↪️"},
+		{code: 404, want: "🔍"},
+		{code: 400, want: "⚠️"},
+		{code: 503, want: "🔥"},
+		{code: 99, want: ""},
+		{code: 600, want: ""},
+	}
+	for _, tt := range tests {
+		if got := ForHTTPStatus(tt.code); got != tt.want {
+			t.Errorf("ForHTTPStatus(%d) = %s, want %s", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestForTestResult(t *testing.T) {
+	tests := []struct {
+		result TestResult
+		want   string
+	}{
+		{result: TestPassed, want: "✅"},
+		{result: TestFailed, want: "❌"},
+		{result: TestSkipped, want: "⏭️"},
+		{result: TestResult(42), want: ""},
+	}
+	for _, tt := range tests {
+		if got := ForTestResult(tt.result); got != tt.want {
+			t.Errorf("ForTestResult(%s) = %s, want %s", tt.result, got, tt.want)
+		}
+	}
+}
+
+func TestStatusEmojis_Custom(t *testing.T) {
+	statuses := DefaultStatusEmojis()
+	statuses.HTTPStatusClasses[5] = "💥"
+	statuses.TestResults[TestSkipped] = "🙈"
+
+	if got := statuses.ForHTTPStatus(500); got != "💥" {
+		t.Errorf("ForHTTPStatus() = %s, want 💥", got)
+	}
+	if got := statuses.ForTestResult(TestSkipped); got != "🙈" {
+		t.Errorf("ForTestResult() = %s, want 🙈", got)
+	}
+	if got := ForHTTPStatus(500); got != "🔥" {
+		t.Errorf("ForHTTPStatus() = %s, want the unchanged default 🔥", got)
+	}
+	statuses.LogLevels = NewLogLevelEmojis(map[slog.Level]string{slog.LevelInfo: "💬", slog.LevelError: "🚨"})
+	if got := statuses.ForLogLevel(slog.LevelWarn); got != "💬" {
+		t.Errorf("ForLogLevel() = %s, want 💬", got)
+	}
+	if got := (StatusEmojis{}).ForLogLevel(slog.LevelInfo); got != "" {
+		t.Errorf("ForLogLevel() = %s, want no emoji for an empty mapping", got)
+	}
+}
+
+func TestStatusEmojis_Validate(t *testing.T) {
+	if issues := DefaultStatusEmojis().Validate(); len(issues) != 0 {
+		t.Errorf("Validate() = %v, want no issues for the defaults", issues)
+	}
+
+	statuses := StatusEmojis{
+		LogLevels:         NewLogLevelEmojis(map[slog.Level]string{slog.LevelWarn: "warning"}),
+		HTTPStatusCodes:   map[int]string{42: "🤔"},
+		HTTPStatusClasses: map[int]string{5: ""},
+		TestResults:       map[TestResult]string{TestPassed: "✔"},
+	}
+	want := []ValidationIssue{
+		{Severity: SeverityWarning, Keyword: "http status 42", Message: "is not a valid status code"},
+		{Severity: SeverityError, Keyword: "http status 5xx", Message: "has an empty emoji"},
+		{Severity: SeverityError, Keyword: "log level WARN", Message: "has emoji 'warning' which is not fully-qualified"},
+		{Severity: SeverityError, Keyword: "log level WARN", Message: "has 'warning' which is not a single emoji"},
+		{Severity: SeverityError, Keyword: "test result passed", Message: "has emoji '✔' which is not fully-qualified"},
+		{Severity: SeverityError, Keyword: "test result passed", Message: "has '✔' which is not a single emoji"},
+	}
+	got := statuses.Validate(WithQualifiedEmojis([]string{"🤔", "✔️"}))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate() = %v, want %v", got, want)
+	}
+}
@@ -3,10 +3,8 @@ package goemoji
 import (
 	"fmt"
 	"strings"
-)
-
-const (
-	maxKeyLength = 8
+	"unicode"
+	"unicode/utf8"
 )
 
 // EmojifyStrategy defines the interface for different emoji insertion strategies.
@@ -14,111 +12,207 @@ type EmojifyStrategy interface {
 	Emojify(
 		input string,
 		minimumWordLength int,
-		emojiTags map[string][]string,
-		emojiSet map[string]bool,
+		dictionary Dictionary,
 	) (output string)
 }
 
 // ReplaceSubstring replaces words in the text with their corresponding emojis.
+// Phrases are separated by single spaces and the whole output is lowercase.
+// Overlapping phrases are resolved by length and ignore the weights of a WeightedDictionary;
+// a Pipeline with a PhraseMatcher prefers the keyword with the higher weight instead.
 type ReplaceSubstring struct{}
 
 // Emojify replaces matching words with emojis in the input text.
 func (r ReplaceSubstring) Emojify(
 	input string,
 	minimumWordLength int,
-	emojiTags map[string][]string,
-	emojiSet map[string]bool,
+	dictionary Dictionary,
 ) (output string) {
-	currentString := strings.ToLower(input)
-	for i := maxKeyLength; i > 0; i-- {
-		words := strings.Split(currentString, " ")
-		tokens := combineTokens(words, i)
-		for _, token := range tokens {
-			if len(token) < minimumWordLength {
-				continue
-			}
-			emoji, substring := getFirstEmoji(token, emojiTags)
-			if substring != "" {
-				currentString = strings.Replace(currentString, substring, emoji, 1)
-			}
-		}
-	}
-	return currentString
+	return r.Pipeline().Emojify(input, minimumWordLength, dictionary)
+}
+
+// Pipeline returns the Pipeline which emojifies like ReplaceSubstring. Its components
+// can be exchanged, e.g. the Tokenizer by a MarkdownTokenizer.
+func (r ReplaceSubstring) Pipeline() Pipeline {
+	return Pipeline{Tokenizer: SpaceTokenizer{}, Matcher: LongestPhraseMatcher{}, Lowercase: true}
 }
 
 // InsertBeforeString inserts emojis before the original text.
+// If no emojis are found, the text is returned unchanged.
+// Like ReplaceSubstring, it ignores the weights of a WeightedDictionary.
 type InsertBeforeString struct{}
 
 // Emojify inserts relevant emojis before the input text.
 func (i InsertBeforeString) Emojify(
 	input string,
 	minimumWordLength int,
-	emojiTags map[string][]string,
-	emojiSet map[string]bool,
+	dictionary Dictionary,
 ) string {
-	return fmt.Sprintf("%s %s", getEmojisString(input, minimumWordLength, emojiTags, emojiSet), input)
+	return i.Pipeline().Emojify(input, minimumWordLength, dictionary)
+}
+
+// Pipeline returns the Pipeline which emojifies like InsertBeforeString.
+func (i InsertBeforeString) Pipeline() Pipeline {
+	return Pipeline{
+		Tokenizer: SpaceTokenizer{},
+		Matcher:   LongestPhraseMatcher{IncludeEmojis: true},
+		Renderer:  InsertBeforeRenderer{},
+	}
 }
 
 // InsertAfterString inserts emojis after the original text.
+// If no emojis are found, the text is returned unchanged.
+// Like ReplaceSubstring, it ignores the weights of a WeightedDictionary.
 type InsertAfterString struct{}
 
 // Emojify inserts relevant emojis after the input text.
 func (i InsertAfterString) Emojify(
 	input string,
 	minimumWordLength int,
-	emojiTags map[string][]string,
-	emojiSet map[string]bool,
+	dictionary Dictionary,
 ) (output string) {
-	return fmt.Sprintf("%s %s", input, getEmojisString(input, minimumWordLength, emojiTags, emojiSet))
+	return i.Pipeline().Emojify(input, minimumWordLength, dictionary)
 }
 
-func getEmojisString(
+// Pipeline returns the Pipeline which emojifies like InsertAfterString.
+func (i InsertAfterString) Pipeline() Pipeline {
+	return Pipeline{
+		Tokenizer: SpaceTokenizer{},
+		Matcher:   LongestPhraseMatcher{IncludeEmojis: true},
+		Renderer:  InsertAfterRenderer{},
+	}
+}
+
+// InsertAtSentenceEnd appends the relevant emojis of each sentence to the end of that sentence.
+type InsertAtSentenceEnd struct {
+	// BeforePunctuation places the emojis in front of the terminal punctuation
+	// instead of after it.
+	BeforePunctuation bool
+}
+
+// Emojify inserts relevant emojis at the end of every sentence in the input text.
+func (i InsertAtSentenceEnd) Emojify(
 	input string,
 	minimumWordLength int,
-	emojiTags map[string][]string,
-	emojiSet map[string]bool,
-) string {
-	emojiString := ReplaceSubstring{}.Emojify(input, minimumWordLength, emojiTags, nil)
-	emojies := extractEmojis(emojiString, emojiSet)
-	return strings.Join(emojies, "")
+	dictionary Dictionary,
+) (output string) {
+	var builder strings.Builder
+	builder.Grow(len(input))
+
+	for _, s := range splitSentences(input) {
+		emojis := getEmojisString(s.text, minimumWordLength, dictionary)
+		switch {
+		case emojis == "":
+			builder.WriteString(s.text)
+			builder.WriteString(s.punctuation)
+		case i.BeforePunctuation:
+			builder.WriteString(fmt.Sprintf("%s %s%s", s.text, emojis, s.punctuation))
+		default:
+			builder.WriteString(fmt.Sprintf("%s%s %s", s.text, s.punctuation, emojis))
+		}
+		builder.WriteString(s.separator)
+	}
+
+	return builder.String()
 }
 
-func extractEmojis(input string, emojiSet map[string]bool) []string {
-	if input == "" || len(emojiSet) == 0 {
-		return []string{}
-	}
+// sentence is a part of a text. Concatenating text, punctuation and separator
+// of all sentences yields the original text.
+type sentence struct {
+	text        string
+	punctuation string
+	separator   string
+}
 
-	results := make([]string, 0)
-	for _, r := range input {
-		emoji := string(r)
-		if emojiSet[emoji] {
-			results = append(results, emoji)
+// splitSentences splits the input at terminal punctuation followed by whitespace
+// and at line breaks.
+func splitSentences(input string) []sentence {
+	sentences := make([]sentence, 0)
+	start := 0
+	for pos := 0; pos < len(input); {
+		r, size := utf8.DecodeRuneInString(input[pos:])
+		switch {
+		case isTerminalPunctuation(r):
+			punctuationEnd := skipRunes(input, pos, isTerminalPunctuation)
+			if punctuationEnd < len(input) {
+				next, _ := utf8.DecodeRuneInString(input[punctuationEnd:])
+				if !unicode.IsSpace(next) {
+					pos = punctuationEnd
+					continue
+				}
+			}
+			separatorEnd := skipRunes(input, punctuationEnd, unicode.IsSpace)
+			sentences = append(sentences, sentence{
+				text:        input[start:pos],
+				punctuation: input[pos:punctuationEnd],
+				separator:   input[punctuationEnd:separatorEnd],
+			})
+			start, pos = separatorEnd, separatorEnd
+		case unicode.IsSpace(r):
+			separatorEnd := skipRunes(input, pos, unicode.IsSpace)
+			if separatorEnd == len(input) || strings.ContainsRune(input[pos:separatorEnd], '\n') {
+				sentences = append(sentences, sentence{
+					text:      input[start:pos],
+					separator: input[pos:separatorEnd],
+				})
+				start = separatorEnd
+			}
+			pos = separatorEnd
+		default:
+			pos += size
 		}
 	}
+	if start < len(input) {
+		sentences = append(sentences, sentence{text: input[start:]})
+	}
 
-	return results
+	return sentences
 }
 
-func getFirstEmoji(token string, emojiMap map[string][]string) (emoji, substring string) {
-	if emojis, ok := emojiMap[token]; ok {
-		return emojis[0], token
+func isTerminalPunctuation(r rune) bool {
+	switch r {
+	case '.', '!', '?', '…':
+		return true
+	default:
+		return false
 	}
-	return "", ""
 }
 
-func combineTokens(words []string, numWords int) []string {
-	if len(words) < numWords || numWords <= 0 {
-		return []string{}
+func skipRunes(input string, pos int, skip func(rune) bool) int {
+	for pos < len(input) {
+		r, size := utf8.DecodeRuneInString(input[pos:])
+		if !skip(r) {
+			break
+		}
+		pos += size
 	}
+	return pos
+}
 
-	// Pre-allocate slice with known capacity for better performance
-	capacity := len(words) - numWords + 1
-	tokens := make([]string, 0, capacity)
+func getEmojisString(
+	input string,
+	minimumWordLength int,
+	dictionary Dictionary,
+) string {
+	emojiString := ReplaceSubstring{}.Emojify(input, minimumWordLength, dictionary)
+	emojies := extractEmojis(emojiString, dictionary)
+	return strings.Join(emojies, "")
+}
 
-	for i := 0; i < capacity; i++ {
-		token := strings.Join(words[i:i+numWords], " ")
-		tokens = append(tokens, token)
+// extractEmojis returns the emoji sequences of the input which belong to the dictionary.
+// Flags and ZWJ sequences like 🇩🇪 are kept whole instead of being split into their characters.
+// Skin toned and unqualified emojis like 👍🏽 or ❤ are kept if their folded form belongs to it.
+func extractEmojis(input string, dictionary Dictionary) []string {
+	results := make([]string, 0)
+	for len(input) > 0 {
+		length := emojiSequenceLength(input)
+		sequence := input[:length]
+		folded := normalizeSequence(sequence, NormalizeFullyQualified|NormalizeStripSkinTones)
+		if dictionary.ContainsEmoji(sequence) || dictionary.ContainsEmoji(folded) {
+			results = append(results, sequence)
+		}
+		input = input[length:]
 	}
 
-	return tokens
+	return results
 }
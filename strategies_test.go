@@ -1,18 +1,16 @@
 package goemoji
 
 import (
-	"reflect"
 	"testing"
 )
 
 var defaultEmojiTags = map[string][]string{"apple": {"🍎", "🍏"}, "green apple": {"🍏"}, "pineapple": {"🍍"}}
-var defaultEmojiSet = map[string]bool{"🍎": true, "🍏": true, "🍍": true}
+var testDictionary = NewMapDictionary(defaultEmojiTags)
 
 func TestInsertBeforeString_Emojify(t *testing.T) {
 	type args struct {
 		input             string
-		emojiMap          map[string][]string
-		emojiSet          map[string]bool
+		dictionary        Dictionary
 		minimumWordLength int
 	}
 	tests := []struct {
@@ -26,8 +24,7 @@ func TestInsertBeforeString_Emojify(t *testing.T) {
 			i:    InsertBeforeString{},
 			args: args{
 				input:             "they ate an apple",
-				emojiMap:          defaultEmojiTags,
-				emojiSet:          defaultEmojiSet,
+				dictionary:        testDictionary,
 				minimumWordLength: 1,
 			},
 			wantOutput: "🍎 they ate an apple",
@@ -36,8 +33,7 @@ func TestInsertBeforeString_Emojify(t *testing.T) {
 			i:    InsertBeforeString{},
 			args: args{
 				input:             "they ate a green apple",
-				emojiMap:          defaultEmojiTags,
-				emojiSet:          defaultEmojiSet,
+				dictionary:        testDictionary,
 				minimumWordLength: 1,
 			},
 			wantOutput: "🍏 they ate a green apple",
@@ -46,16 +42,24 @@ func TestInsertBeforeString_Emojify(t *testing.T) {
 			i:    InsertBeforeString{},
 			args: args{
 				input:             "they ate an apple and a green apple and a pineapple",
-				emojiMap:          defaultEmojiTags,
-				emojiSet:          defaultEmojiSet,
+				dictionary:        testDictionary,
 				minimumWordLength: 1,
 			},
 			wantOutput: "🍎🍏🍍 they ate an apple and a green apple and a pineapple",
+		}, {
+			name: "no match keeps input",
+			i:    InsertBeforeString{},
+			args: args{
+				input:             "they ate a banana",
+				dictionary:        testDictionary,
+				minimumWordLength: 1,
+			},
+			wantOutput: "they ate a banana",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotOutput := tt.i.Emojify(tt.args.input, tt.args.minimumWordLength, tt.args.emojiMap, tt.args.emojiSet)
+			gotOutput := tt.i.Emojify(tt.args.input, tt.args.minimumWordLength, tt.args.dictionary)
 			if gotOutput != tt.wantOutput {
 				t.Errorf("InsertBeforeString.Emojify() = %v, want %v", gotOutput, tt.wantOutput)
 			}
@@ -65,10 +69,8 @@ func TestInsertBeforeString_Emojify(t *testing.T) {
 
 func TestInsertAfterString_Emojify(t *testing.T) {
 	type args struct {
-		input    string
-		emojiMap map[string][]string
-		emojiSet map[string]bool
-
+		input             string
+		dictionary        Dictionary
 		minimumWordLength int
 	}
 	tests := []struct {
@@ -82,8 +84,7 @@ func TestInsertAfterString_Emojify(t *testing.T) {
 			i:    InsertAfterString{},
 			args: args{
 				input:             "they ate an apple",
-				emojiMap:          defaultEmojiTags,
-				emojiSet:          defaultEmojiSet,
+				dictionary:        testDictionary,
 				minimumWordLength: 1,
 			},
 			wantOutput: "they ate an apple 🍎",
@@ -92,8 +93,7 @@ func TestInsertAfterString_Emojify(t *testing.T) {
 			i:    InsertAfterString{},
 			args: args{
 				input:             "they ate a green apple",
-				emojiMap:          defaultEmojiTags,
-				emojiSet:          defaultEmojiSet,
+				dictionary:        testDictionary,
 				minimumWordLength: 1,
 			},
 			wantOutput: "they ate a green apple 🍏",
@@ -102,16 +102,24 @@ func TestInsertAfterString_Emojify(t *testing.T) {
 			i:    InsertAfterString{},
 			args: args{
 				input:             "they ate an apple and a green apple and a pineapple",
-				emojiMap:          defaultEmojiTags,
-				emojiSet:          defaultEmojiSet,
+				dictionary:        testDictionary,
 				minimumWordLength: 1,
 			},
 			wantOutput: "they ate an apple and a green apple and a pineapple 🍎🍏🍍",
+		}, {
+			name: "no match keeps input",
+			i:    InsertAfterString{},
+			args: args{
+				input:             "they ate a banana",
+				dictionary:        testDictionary,
+				minimumWordLength: 1,
+			},
+			wantOutput: "they ate a banana",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotOutput := tt.i.Emojify(tt.args.input, tt.args.minimumWordLength, tt.args.emojiMap, tt.args.emojiSet)
+			gotOutput := tt.i.Emojify(tt.args.input, tt.args.minimumWordLength, tt.args.dictionary)
 			if gotOutput != tt.wantOutput {
 				t.Errorf("InsertAfterString.Emojify() = %v, want %v", gotOutput, tt.wantOutput)
 			}
@@ -122,8 +130,7 @@ func TestInsertAfterString_Emojify(t *testing.T) {
 func TestReplaceSubstring_Emojify(t *testing.T) {
 	type args struct {
 		input             string
-		emojiMap          map[string][]string
-		emojiSet          map[string]bool
+		dictionary        Dictionary
 		minimumWordLength int
 	}
 	tests := []struct {
@@ -137,8 +144,7 @@ func TestReplaceSubstring_Emojify(t *testing.T) {
 			i:    ReplaceSubstring{},
 			args: args{
 				input:             "they ate an apple",
-				emojiMap:          defaultEmojiTags,
-				emojiSet:          defaultEmojiSet,
+				dictionary:        testDictionary,
 				minimumWordLength: 1,
 			},
 			wantOutput: "they ate an 🍎",
@@ -147,8 +153,7 @@ func TestReplaceSubstring_Emojify(t *testing.T) {
 			i:    ReplaceSubstring{},
 			args: args{
 				input:             "they ate a green apple",
-				emojiMap:          defaultEmojiTags,
-				emojiSet:          defaultEmojiSet,
+				dictionary:        testDictionary,
 				minimumWordLength: 1,
 			},
 			wantOutput: "they ate a 🍏",
@@ -157,8 +162,7 @@ func TestReplaceSubstring_Emojify(t *testing.T) {
 			i:    ReplaceSubstring{},
 			args: args{
 				input:             "they ate an apple and a green apple and a pineapple",
-				emojiMap:          defaultEmojiTags,
-				emojiSet:          defaultEmojiSet,
+				dictionary:        testDictionary,
 				minimumWordLength: 1,
 			},
 			wantOutput: "they ate an 🍎 and a 🍏 and a 🍍",
@@ -166,7 +170,7 @@ func TestReplaceSubstring_Emojify(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotOutput := tt.i.Emojify(tt.args.input, tt.args.minimumWordLength, tt.args.emojiMap, tt.args.emojiSet)
+			gotOutput := tt.i.Emojify(tt.args.input, tt.args.minimumWordLength, tt.args.dictionary)
 			if gotOutput != tt.wantOutput {
 				t.Errorf("got '%v', want '%v'", gotOutput, tt.wantOutput)
 			}
@@ -174,50 +178,127 @@ func TestReplaceSubstring_Emojify(t *testing.T) {
 	}
 }
 
-func Test_combineTokens(t *testing.T) {
+func TestInsertAtSentenceEnd_Emojify(t *testing.T) {
 	type args struct {
-		input    []string
-		numWords int
+		input             string
+		dictionary        Dictionary
+		minimumWordLength int
 	}
 	tests := []struct {
-		name string
-		args args
-		want []string
+		name       string
+		i          InsertAtSentenceEnd
+		args       args
+		wantOutput string
 	}{
 		{
-			name: "single word",
+			name: "single sentence",
+			i:    InsertAtSentenceEnd{},
+			args: args{
+				input:             "they ate an apple.",
+				dictionary:        testDictionary,
+				minimumWordLength: 1,
+			},
+			wantOutput: "they ate an apple. 🍎",
+		}, {
+			name: "multiple sentences",
+			i:    InsertAtSentenceEnd{},
+			args: args{
+				input:             "they ate an apple. then a pineapple! was it good?",
+				dictionary:        testDictionary,
+				minimumWordLength: 1,
+			},
+			wantOutput: "they ate an apple. 🍎 then a pineapple! 🍍 was it good?",
+		}, {
+			name: "before punctuation",
+			i:    InsertAtSentenceEnd{BeforePunctuation: true},
 			args: args{
-				input:    []string{"The", "quick", "brown", "fox"},
-				numWords: 1,
+				input:             "they ate an apple. then a pineapple!!",
+				dictionary:        testDictionary,
+				minimumWordLength: 1,
 			},
-			want: []string{"The", "quick", "brown", "fox"},
+			wantOutput: "they ate an apple 🍎. then a pineapple 🍍!!",
 		}, {
-			name: "multi-word",
+			name: "multiple paragraphs",
+			i:    InsertAtSentenceEnd{},
 			args: args{
-				input:    []string{"The", "quick", "brown", "fox"},
-				numWords: 2,
+				input:             "they ate an apple\n\nand a green apple\n",
+				dictionary:        testDictionary,
+				minimumWordLength: 1,
 			},
-			want: []string{"The quick", "quick brown", "brown fox"},
+			wantOutput: "they ate an apple 🍎\n\nand a green apple 🍏\n",
 		}, {
-			name: "non alphabetical",
+			name: "punctuation inside a word",
+			i:    InsertAtSentenceEnd{},
 			args: args{
-				input:    []string{"Th-", "qu1ck", "br0wn", "f0x"},
-				numWords: 2,
+				input:             "version 1.2 of the apple",
+				dictionary:        testDictionary,
+				minimumWordLength: 1,
 			},
-			want: []string{"Th- qu1ck", "qu1ck br0wn", "br0wn f0x"},
+			wantOutput: "version 1.2 of the apple 🍎",
 		}, {
-			name: "shorter than numWords",
+			name: "no match",
+			i:    InsertAtSentenceEnd{},
 			args: args{
-				input:    []string{"The", "quick", "brown", "fox"},
-				numWords: 5,
+				input:             "nothing to see here. move along!",
+				dictionary:        testDictionary,
+				minimumWordLength: 1,
 			},
-			want: []string{},
+			wantOutput: "nothing to see here. move along!",
+		}, {
+			name: "zwj sequence",
+			i:    InsertAtSentenceEnd{},
+			args: args{
+				input:             "the artist paints.",
+				dictionary:        NewMapDictionary(map[string][]string{"artist": {"🧑\u200d🎨"}}),
+				minimumWordLength: 1,
+			},
+			wantOutput: "the artist paints. 🧑\u200d🎨",
+		}, {
+			name: "multi-rune emojis",
+			i:    InsertAtSentenceEnd{},
+			args: args{
+				input: "greetings from germany! blood type a.",
+				dictionary: NewMapDictionary(map[string][]string{
+					"germany": {"🇩🇪"},
+					"a":       {"🅰️"},
+				}),
+				minimumWordLength: 1,
+			},
+			wantOutput: "greetings from germany! 🇩🇪 blood type a. 🅰️",
+		}, {
+			name: "skin toned emoji",
+			i:    InsertAtSentenceEnd{},
+			args: args{
+				input:             "great work 👍🏽.",
+				dictionary:        NewMapDictionary(map[string][]string{"thumbs": {"👍"}}),
+				minimumWordLength: 1,
+			},
+			wantOutput: "great work 👍🏽. 👍🏽",
+		}, {
+			name: "skin toned zwj sequence",
+			i:    InsertAtSentenceEnd{},
+			args: args{
+				input:             "i am a 👨🏽\u200d💻.",
+				dictionary:        NewMapDictionary(map[string][]string{"technologist": {"👨\u200d💻"}}),
+				minimumWordLength: 1,
+			},
+			wantOutput: "i am a 👨🏽\u200d💻. 👨🏽\u200d💻",
+		}, {
+			name: "unqualified emoji",
+			i:    InsertAtSentenceEnd{},
+			args: args{
+				input:             "sending ❤.",
+				dictionary:        NewMapDictionary(map[string][]string{"heart": {"❤️"}}),
+				minimumWordLength: 1,
+			},
+			wantOutput: "sending ❤. ❤",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := combineTokens(tt.args.input, tt.args.numWords); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("combineTokens() = %v, want %v", got, tt.want)
+			gotOutput := tt.i.Emojify(tt.args.input, tt.args.minimumWordLength, tt.args.dictionary)
+			if gotOutput != tt.wantOutput {
+				t.Errorf("InsertAtSentenceEnd.Emojify() = %v, want %v", gotOutput, tt.wantOutput)
 			}
 		})
 	}
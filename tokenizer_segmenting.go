@@ -0,0 +1,31 @@
+package goemoji
+
+import (
+	"unicode/utf8"
+
+	"github.com/jo-hoe/goemoji/internal/phrase"
+)
+
+// SegmentingTokenizer splits text like WhitespaceTokenizer, but emits every character of
+// scripts written without spaces, like Chinese, Japanese or Thai, as a token of its own.
+// Combined with PhraseMatcher, such text is segmented by the longest dictionary keywords.
+// As keywords of these scripts are short, a minimum word length of 1 is recommended.
+type SegmentingTokenizer struct{}
+
+// Tokenize splits the input into words and characters of unspaced scripts.
+func (s SegmentingTokenizer) Tokenize(input string) []Token {
+	tokens := make([]Token, 0)
+	start := 0
+	for pos := 0; pos < len(input); {
+		r, size := utf8.DecodeRuneInString(input[pos:])
+		if !phrase.IsUnspacedScript(r) {
+			pos += size
+			continue
+		}
+		tokens = append(tokens, tokenizeWords(input, start, pos, "")...)
+		tokens = append(tokens, Token{Text: input[pos : pos+size], Start: pos, End: pos + size})
+		pos += size
+		start = pos
+	}
+	return append(tokens, tokenizeWords(input, start, len(input), "")...)
+}
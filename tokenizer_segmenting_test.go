@@ -0,0 +1,50 @@
+package goemoji
+
+import (
+	"testing"
+)
+
+var unspacedDictionary = NewMapDictionary(map[string][]string{
+	"寿司":    {"🍣"},
+	"猫":     {"🐱"},
+	"ラーメン":  {"🍜"},
+	"แมว":   {"🐈"},
+	"apple": {"🍎"},
+})
+
+func TestSegmentingTokenizer_Emojify(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantOutput string
+	}{
+		{name: "chinese", input: "我喜欢寿司和猫。", wantOutput: "我喜欢🍣和🐱。"},
+		{name: "japanese", input: "ラーメンが好き", wantOutput: "🍜が好き"},
+		{name: "thai", input: "ฉันรักแมว", wantOutput: "ฉันรัก🐈"},
+		{name: "mixed scripts", input: "猫 likes an apple", wantOutput: "🐱 likes an 🍎"},
+	}
+	pipeline := Pipeline{Tokenizer: SegmentingTokenizer{}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotOutput := pipeline.Emojify(tt.input, 1, unspacedDictionary)
+			if gotOutput != tt.wantOutput {
+				t.Errorf("Pipeline.Emojify() = %v, want %v", gotOutput, tt.wantOutput)
+			}
+		})
+	}
+}
+
+func TestSegmentingTokenizer_Tokenize(t *testing.T) {
+	input := "寿司 (tasty)"
+	tokens := SegmentingTokenizer{}.Tokenize(input)
+
+	want := []string{"寿", "司", "tasty"}
+	if len(tokens) != len(want) {
+		t.Fatalf("Tokenize() = %v, want %v", tokens, want)
+	}
+	for i, token := range tokens {
+		if token.Text != want[i] || input[token.Start:token.End] != token.Text {
+			t.Errorf("Tokenize()[%d] = %v, want %v", i, token, want[i])
+		}
+	}
+}
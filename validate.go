@@ -0,0 +1,132 @@
+package goemoji
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Severity classifies a ValidationIssue.
+type Severity int
+
+const (
+	// SeverityWarning marks entries which work but are unlikely to behave as intended.
+	SeverityWarning Severity = iota
+	// SeverityError marks entries which are broken or can never be matched.
+	SeverityError
+)
+
+func (s Severity) String() string {
+	if s == SeverityError {
+		return "error"
+	}
+	return "warning"
+}
+
+// ValidationIssue is a problem found in an entry of a Dictionary.
+type ValidationIssue struct {
+	Severity Severity
+	Keyword  string
+	Message  string
+}
+
+func (i ValidationIssue) String() string {
+	return fmt.Sprintf("%s: keyword '%s' %s", i.Severity, i.Keyword, i.Message)
+}
+
+// ValidationOption configures optional checks of ValidateDictionary.
+type ValidationOption func(*validation)
+
+type validation struct {
+	qualifiedEmojis map[string]bool
+}
+
+// WithQualifiedEmojis reports every emoji of the dictionary which is not in the list
+// of fully-qualified emojis, e.g. as listed in the Unicode emoji-test.txt.
+func WithQualifiedEmojis(emojis []string) ValidationOption {
+	return func(v *validation) {
+		v.qualifiedEmojis = make(map[string]bool, len(emojis))
+		for _, emoji := range emojis {
+			v.qualifiedEmojis[emoji] = true
+		}
+	}
+}
+
+// ValidateDictionary checks the entries of the dictionary for an Emojifier with the minimum
+// word length and the tokenizer of its strategy and returns the issues found, sorted by keyword.
+// Keywords which the tokenizer changes, e.g. by removing punctuation, are reported as they cannot
+// be matched. A nil tokenizer skips this check.
+func ValidateDictionary(
+	dictionary Dictionary,
+	minimumWordLength int,
+	tokenizer Tokenizer,
+	options ...ValidationOption,
+) []ValidationIssue {
+	var v validation
+	for _, option := range options {
+		option(&v)
+	}
+
+	issues := make([]ValidationIssue, 0)
+	report := func(severity Severity, keyword, format string, args ...any) {
+		issues = append(issues, ValidationIssue{Severity: severity, Keyword: keyword, Message: fmt.Sprintf(format, args...)})
+	}
+
+	dictionary.Range(func(keyword string, emojis []string) bool {
+		normalized := strings.Join(strings.Fields(toLower(dictionary, keyword)), " ")
+		if normalized != keyword {
+			if _, ok := dictionary.Lookup(normalized); ok {
+				report(SeverityError, keyword, "duplicates '%s' after normalization", normalized)
+			} else {
+				report(SeverityError, keyword, "is not normalized and cannot be matched, use '%s'", normalized)
+			}
+		}
+		if utf8.RuneCountInString(keyword) < minimumWordLength {
+			report(SeverityWarning, keyword, "is shorter than the minimum word length of %d and cannot be matched",
+				minimumWordLength)
+		}
+		if tokenizer != nil {
+			if tokenized := joinTokens(tokenizer.Tokenize(normalized), dictionary); tokenized != normalized {
+				report(SeverityWarning, keyword, "is tokenized to '%s' and cannot be matched", tokenized)
+			}
+		}
+		v.validateEmojis(keyword, emojis, report)
+		return true
+	})
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Keyword < issues[j].Keyword
+	})
+	return issues
+}
+
+func (v validation) validateEmojis(keyword string, emojis []string,
+	report func(severity Severity, keyword, format string, args ...any)) {
+	if len(emojis) == 0 {
+		report(SeverityError, keyword, "has no emojis")
+	}
+
+	seen := make(map[string]bool, len(emojis))
+	for _, emoji := range emojis {
+		switch {
+		case emoji == "":
+			report(SeverityError, keyword, "has an empty emoji")
+		case seen[emoji]:
+			report(SeverityWarning, keyword, "lists emoji '%s' more than once", emoji)
+		case v.qualifiedEmojis != nil && !v.qualifiedEmojis[emoji]:
+			report(SeverityError, keyword, "has emoji '%s' which is not fully-qualified", emoji)
+		}
+		seen[emoji] = true
+	}
+}
+
+// HasErrors reports whether any of the issues has SeverityError.
+func HasErrors(issues []ValidationIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
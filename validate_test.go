@@ -0,0 +1,68 @@
+package goemoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateDictionary(t *testing.T) {
+	dictionary := NewMapDictionary(map[string][]string{
+		"apple":                 {"🍎"},
+		"Apple":                 {"🍏"},
+		"Green  Apple":          {"🍏"},
+		"cat":                   {"🐈"},
+		"a button (blood type)": {"🅰️"},
+		"nothing":               {},
+		"repeated":              {"🔁", "🔁"},
+		"heart":                 {"❤"},
+	})
+
+	issues := ValidateDictionary(dictionary, 4, WhitespaceTokenizer{},
+		WithQualifiedEmojis([]string{"🍎", "🍏", "🐈", "🅰️", "🔁", "❤️"}))
+
+	expected := []ValidationIssue{
+		{SeverityError, "Apple", "duplicates 'apple' after normalization"},
+		{SeverityError, "Green  Apple", "is not normalized and cannot be matched, use 'green apple'"},
+		{SeverityWarning, "a button (blood type)", "is tokenized to 'a button blood type' and cannot be matched"},
+		{SeverityWarning, "cat", "is shorter than the minimum word length of 4 and cannot be matched"},
+		{SeverityError, "heart", "has emoji '❤' which is not fully-qualified"},
+		{SeverityError, "nothing", "has no emojis"},
+		{SeverityWarning, "repeated", "lists emoji '🔁' more than once"},
+	}
+	if !reflect.DeepEqual(issues, expected) {
+		t.Errorf("Expected %v, got %v", expected, issues)
+	}
+	if !HasErrors(issues) {
+		t.Error("Expected issues to contain errors")
+	}
+}
+
+func TestValidateDictionary_Strategy(t *testing.T) {
+	dictionary := NewMapDictionary(map[string][]string{"cat": {"🐈"}, "a button (blood type)": {"🅰️"}})
+
+	tests := []struct {
+		name              string
+		minimumWordLength int
+		tokenizer         Tokenizer
+		want              int
+	}{
+		{name: "space tokenizer keeps punctuation", minimumWordLength: 3, tokenizer: SpaceTokenizer{}, want: 0},
+		{name: "whitespace tokenizer trims punctuation", minimumWordLength: 3, tokenizer: WhitespaceTokenizer{}, want: 1},
+		{name: "no tokenizer", minimumWordLength: 4, tokenizer: nil, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if issues := ValidateDictionary(dictionary, tt.minimumWordLength, tt.tokenizer); len(issues) != tt.want {
+				t.Errorf("Expected %d issues, got %v", tt.want, issues)
+			}
+		})
+	}
+}
+
+func TestValidationIssue_String(t *testing.T) {
+	issue := ValidationIssue{Severity: SeverityWarning, Keyword: "cat", Message: "is short"}
+
+	if got := issue.String(); got != "warning: keyword 'cat' is short" {
+		t.Errorf("Expected formatted issue, got '%s'", got)
+	}
+}
@@ -0,0 +1,139 @@
+package goemoji
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	clockFaceOneOClock = '\U0001F550'
+	clockFaceOneThirty = '\U0001F55C'
+	newMoon            = '\U0001F311'
+	heavyMinusSign     = "➖"
+	moonPhases         = 8
+	synodicMonth       = time.Duration(29.530588853 * 24 * float64(time.Hour))
+)
+
+// referenceNewMoon is the new moon of January 6, 2000, from which moon phases are computed.
+var referenceNewMoon = time.Date(2000, time.January, 6, 18, 14, 0, 0, time.UTC)
+
+// ValueKind selects the values EmojifyValues converts.
+type ValueKind int
+
+const (
+	// ValueNumbers converts integers to keycaps, e.g. 42 to 4️⃣2️⃣.
+	ValueNumbers ValueKind = 1 << iota
+	// ValueTimes converts times like 14:30, 9:05:00 or 3pm to clock faces.
+	ValueTimes
+)
+
+// Keycaps returns the integer as keycap sequences, e.g. 4️⃣2️⃣ for 42.
+// Negative integers start with ➖.
+func Keycaps(n int) string {
+	digits, negative := strings.CutPrefix(strconv.Itoa(n), "-")
+	if negative {
+		return heavyMinusSign + keycapDigits(digits)
+	}
+	return keycapDigits(digits)
+}
+
+// keycapDigits returns each digit of the string as keycap sequence.
+func keycapDigits(digits string) string {
+	var builder strings.Builder
+	for _, digit := range digits {
+		builder.WriteRune(digit)
+		builder.WriteRune(variationSelector16)
+		builder.WriteRune(combiningEnclosingKeycap)
+	}
+	return builder.String()
+}
+
+// ClockFace returns the clock face closest to the time, rounded to the half hour,
+// e.g. 🕒 for 15:10 and 🕞 for 15:20.
+func ClockFace(t time.Time) string {
+	minutes := t.Hour()*60 + t.Minute()
+	halfHours := int(math.Round(float64(minutes)/30)) % 24
+	// clock faces start at one o'clock, twelve o'clock is the last one
+	hour := (halfHours/2 + 11) % 12
+	if halfHours%2 == 1 {
+		return string(clockFaceOneThirty + rune(hour))
+	}
+	return string(clockFaceOneOClock + rune(hour))
+}
+
+// MoonPhase returns the phase of the moon at the time, from 🌑 new moon over
+// 🌕 full moon to 🌘 waning crescent.
+func MoonPhase(t time.Time) string {
+	age := t.Sub(referenceNewMoon) % synodicMonth
+	if age < 0 {
+		age += synodicMonth
+	}
+	phase := int(math.Round(float64(age)/float64(synodicMonth)*moonPhases)) % moonPhases
+	return string(newMoon + rune(phase))
+}
+
+// EmojifyValues replaces the integers and times of the selected kinds in the text by
+// keycaps and clock faces, e.g. "deploy at 14:00 on 3 hosts" by "deploy at 🕑 on 3️⃣ hosts".
+// Values within words, URLs and Markdown code are kept.
+func EmojifyValues(text string, kinds ValueKind) string {
+	return replaceOutsideCode(text, func(prose string) string {
+		var builder strings.Builder
+		end := 0
+		for _, token := range tokenizeWords(prose, 0, len(prose), "") {
+			emoji, ok := valueEmoji(token.Text, kinds)
+			if !ok {
+				continue
+			}
+			builder.WriteString(prose[end:token.Start])
+			builder.WriteString(emoji)
+			end = token.End
+		}
+		builder.WriteString(prose[end:])
+		return builder.String()
+	})
+}
+
+// WithValues converts the integers and times of the selected kinds in the text to
+// emojis before the strategy is applied, see EmojifyValues.
+func WithValues(kinds ValueKind) Option {
+	return func(e *Emojifier) {
+		e.values = kinds
+	}
+}
+
+func valueEmoji(word string, kinds ValueKind) (string, bool) {
+	if kinds&ValueNumbers != 0 && isDigits(word) {
+		return keycapDigits(word), true
+	}
+	if kinds&ValueTimes != 0 {
+		if t, ok := parseClockTime(word); ok {
+			return ClockFace(t), true
+		}
+	}
+	return "", false
+}
+
+// parseClockTime parses times like 14:30, 14:30:00, 2:30pm or 3pm.
+func parseClockTime(word string) (time.Time, bool) {
+	lower := strings.ToLower(word)
+	for _, layout := range []string{"15:04", "15:04:05", "3:04pm", "3:04:05pm", "3pm"} {
+		if t, err := time.Parse(layout, lower); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func isDigits(word string) bool {
+	if word == "" {
+		return false
+	}
+	for _, r := range word {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,96 @@
+package goemoji
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKeycaps(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{n: 42, want: "4️⃣2️⃣"},
+		{n: 0, want: "0️⃣"},
+		{n: -7, want: "➖7️⃣"},
+	}
+	for _, tt := range tests {
+		if got := Keycaps(tt.n); got != tt.want {
+			t.Errorf("Keycaps(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestClockFace(t *testing.T) {
+	tests := []struct {
+		hour, minute int
+		want         string
+	}{
+		{hour: 15, minute: 0, want: "🕒"},
+		{hour: 15, minute: 10, want: "🕒"},
+		{hour: 15, minute: 20, want: "🕞"},
+		{hour: 15, minute: 50, want: "🕓"},
+		{hour: 0, minute: 0, want: "🕛"},
+		{hour: 12, minute: 30, want: "🕧"},
+		{hour: 1, minute: 0, want: "🕐"},
+		{hour: 23, minute: 50, want: "🕛"},
+	}
+	for _, tt := range tests {
+		if got := ClockFace(time.Date(2024, time.May, 1, tt.hour, tt.minute, 0, 0, time.UTC)); got != tt.want {
+			t.Errorf("ClockFace(%02d:%02d) = %s, want %s", tt.hour, tt.minute, got, tt.want)
+		}
+	}
+}
+
+func TestMoonPhase(t *testing.T) {
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		{date: time.Date(2024, time.January, 11, 11, 57, 0, 0, time.UTC), want: "🌑"},
+		{date: time.Date(2024, time.January, 18, 3, 52, 0, 0, time.UTC), want: "🌓"},
+		{date: time.Date(2024, time.January, 25, 17, 54, 0, 0, time.UTC), want: "🌕"},
+		{date: time.Date(2024, time.February, 2, 23, 18, 0, 0, time.UTC), want: "🌗"},
+		{date: time.Date(1999, time.December, 22, 17, 31, 0, 0, time.UTC), want: "🌕"},
+	}
+	for _, tt := range tests {
+		if got := MoonPhase(tt.date); got != tt.want {
+			t.Errorf("MoonPhase(%s) = %s, want %s", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestEmojifyValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		kinds ValueKind
+		want  string
+	}{
+		{name: "numbers", text: "3 hosts, 12 pods.", kinds: ValueNumbers, want: "3️⃣ hosts, 1️⃣2️⃣ pods."},
+		{name: "times", text: "deploy at 14:00 or 9:35, latest 3PM!", kinds: ValueTimes, want: "deploy at 🕑 or 🕤, latest 🕒!"},
+		{name: "times with seconds", text: "(at 2:30:00pm)", kinds: ValueTimes, want: "(at 🕝)"},
+		{name: "both", text: "2 jobs at 10:00", kinds: ValueNumbers | ValueTimes, want: "2️⃣ jobs at 🕙"},
+		{name: "times only", text: "2 jobs at 10:00", kinds: ValueTimes, want: "2 jobs at 🕙"},
+		{name: "no values", text: "v2 3.5 25:00 http://host:8080 `42`", kinds: ValueNumbers | ValueTimes,
+			want: "v2 3.5 25:00 http://host:8080 `42`"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EmojifyValues(tt.text, tt.kinds); got != tt.want {
+				t.Errorf("EmojifyValues() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithValues(t *testing.T) {
+	dictionary := NewMapDictionary(map[string][]string{"deploy": {"🚀"}})
+	emojifier, err := NewEmojifier(ReplaceSubstring{}, 4, WithDictionary(dictionary), WithValues(ValueTimes))
+	if err != nil {
+		t.Fatalf("NewEmojifier() error = %v", err)
+	}
+	if got, want := emojifier.Emojify("deploy at 16:30"), "🚀 at 🕟"; got != want {
+		t.Errorf("Emojify() = %q, want %q", got, want)
+	}
+}
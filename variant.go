@@ -0,0 +1,165 @@
+package goemoji
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SkinTone selects the Fitzpatrick modifier applied to emojis which support skin tones.
+type SkinTone uint8
+
+const (
+	// SkinToneDefault keeps the default yellow form of emojis.
+	SkinToneDefault SkinTone = iota
+	SkinToneLight
+	SkinToneMediumLight
+	SkinToneMedium
+	SkinToneMediumDark
+	SkinToneDark
+)
+
+const (
+	zeroWidthJoiner     = "\u200d"
+	variationSelector16 = '\uFE0F'
+	// skinToneModifierLight is the modifier of SkinToneLight, the others follow it.
+	skinToneModifierLight = '\U0001F3FB'
+	// handshake connects the people of sequences like 🧑‍🤝‍🧑 and is not modified in them.
+	handshake = '\U0001F91D'
+)
+
+// Modifier returns the Fitzpatrick modifier of the skin tone or 0 for SkinToneDefault.
+func (t SkinTone) Modifier() rune {
+	if t == SkinToneDefault || t > SkinToneDark {
+		return 0
+	}
+	return skinToneModifierLight + rune(t-SkinToneLight)
+}
+
+// Gender selects between the gender neutral, female and male forms of emojis.
+type Gender uint8
+
+const (
+	// GenderNeutral keeps the form of the dictionary, which is usually gender neutral.
+	GenderNeutral Gender = iota
+	GenderFemale
+	GenderMale
+)
+
+// Preferences select the variants of emojis. Emojis which do not support a
+// variant are kept as they are.
+type Preferences struct {
+	SkinTone SkinTone
+	Gender   Gender
+}
+
+// Apply returns the variant of the emoji which matches the preferences.
+func (p Preferences) Apply(emoji string) string {
+	return ApplySkinTone(ApplyGender(emoji, p.Gender), p.SkinTone)
+}
+
+// emojiVariant records which variants of an emoji exist.
+type emojiVariant struct {
+	emoji     string
+	skinTones bool
+	female    string
+	male      string
+}
+
+// findVariant returns the variants of the fully-qualified emoji from the generated defaultVariants.
+func findVariant(emoji string) (emojiVariant, bool) {
+	i, found := slices.BinarySearchFunc(defaultVariants, emoji, func(variant emojiVariant, target string) int {
+		return strings.Compare(variant.emoji, target)
+	})
+	if !found {
+		return emojiVariant{}, false
+	}
+	return defaultVariants[i], true
+}
+
+// ApplySkinTone returns the emoji with the skin tone, e.g. 👍🏽 for 👍 and SkinToneMedium.
+// Emojis which do not support skin tones are returned unchanged.
+func ApplySkinTone(emoji string, tone SkinTone) string {
+	modifier := tone.Modifier()
+	if variant, ok := findVariant(emoji); modifier == 0 || !ok || !variant.skinTones {
+		return emoji
+	}
+
+	components := strings.Split(emoji, zeroWidthJoiner)
+	for i, component := range components {
+		base, size := utf8.DecodeRuneInString(component)
+		if !unicode.Is(emojiModifierBase, base) || (base == handshake && len(components) > 1) {
+			continue
+		}
+		// the modifier replaces the variation selector of the base
+		rest := strings.TrimPrefix(component[size:], string(variationSelector16))
+		components[i] = string(base) + string(modifier) + rest
+	}
+	return strings.Join(components, zeroWidthJoiner)
+}
+
+// ApplyGender returns the female or male form of the emoji, e.g. 👩‍🎨 for 🧑‍🎨 and GenderFemale.
+// Emojis without gendered forms are returned unchanged.
+func ApplyGender(emoji string, gender Gender) string {
+	variant, ok := findVariant(emoji)
+	switch {
+	case ok && gender == GenderFemale && variant.female != "":
+		return variant.female
+	case ok && gender == GenderMale && variant.male != "":
+		return variant.male
+	default:
+		return emoji
+	}
+}
+
+// WithSkinTone sets the skin tone of emojis inserted by the Emojifier.
+func WithSkinTone(tone SkinTone) Option {
+	return func(e *Emojifier) {
+		e.preferences.SkinTone = tone
+	}
+}
+
+// WithGender sets the gender of emojis inserted by the Emojifier.
+func WithGender(gender Gender) Option {
+	return func(e *Emojifier) {
+		e.preferences.Gender = gender
+	}
+}
+
+// preferredDictionary returns the variants of the emojis of a Dictionary which match the preferences.
+type preferredDictionary struct {
+	Dictionary
+	preferences Preferences
+}
+
+func (p preferredDictionary) Lookup(keyword string) (emojis []string, ok bool) {
+	emojis, ok = p.Dictionary.Lookup(keyword)
+	emojis = slices.Clone(emojis)
+	for i, emoji := range emojis {
+		emojis[i] = p.preferences.Apply(emoji)
+	}
+	return emojis, ok
+}
+
+func (p preferredDictionary) LookupWeighted(keyword string) ([]WeightedEmoji, bool) {
+	emojis, ok := lookupWeighted(p.Dictionary, keyword)
+	emojis = slices.Clone(emojis)
+	for i := range emojis {
+		emojis[i].Emoji = p.preferences.Apply(emojis[i].Emoji)
+	}
+	return emojis, ok
+}
+
+// ContainsEmoji also reports the skin tone and gender variants of the emojis, so
+// strategies which extract the inserted emojis keep the preferred ones.
+func (p preferredDictionary) ContainsEmoji(emoji string) bool {
+	untoned := NormalizeFullyQualified | NormalizeStripSkinTones
+	return p.Dictionary.ContainsEmoji(emoji) ||
+		p.Dictionary.ContainsEmoji(normalizeEmoji(emoji, untoned)) ||
+		p.Dictionary.ContainsEmoji(normalizeEmoji(emoji, untoned|NormalizeStripGender))
+}
+
+func (p preferredDictionary) ToLower(text string) string {
+	return toLower(p.Dictionary, text)
+}
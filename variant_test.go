@@ -0,0 +1,143 @@
+package goemoji
+
+import (
+	"testing"
+)
+
+func TestApplySkinTone(t *testing.T) {
+	tests := []struct {
+		name  string
+		emoji string
+		tone  SkinTone
+		want  string
+	}{
+		{name: "single character", emoji: "👍", tone: SkinToneMedium, want: "👍🏽"},
+		{name: "variation selector", emoji: "✌️", tone: SkinToneDark, want: "✌🏿"},
+		{name: "gendered sequence", emoji: "🏃\u200d♀️", tone: SkinToneLight, want: "🏃🏻\u200d♀️"},
+		{name: "profession", emoji: "🧑\u200d🎨", tone: SkinToneMediumDark, want: "🧑🏾\u200d🎨"},
+		{name: "direction", emoji: "🚶\u200d➡️", tone: SkinToneDark, want: "🚶🏿\u200d➡️"},
+		{name: "multiple people", emoji: "🧑\u200d🤝\u200d🧑", tone: SkinToneMediumLight, want: "🧑🏼\u200d🤝\u200d🧑🏼"},
+		{name: "default tone", emoji: "👍", tone: SkinToneDefault, want: "👍"},
+		{name: "unsupported", emoji: "🍎", tone: SkinToneMedium, want: "🍎"},
+		{name: "invalid tone", emoji: "👍", tone: SkinToneDark + 1, want: "👍"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ApplySkinTone(tt.emoji, tt.tone); got != tt.want {
+				t.Errorf("ApplySkinTone() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyGender(t *testing.T) {
+	tests := []struct {
+		name   string
+		emoji  string
+		gender Gender
+		want   string
+	}{
+		{name: "female sign", emoji: "🏃", gender: GenderFemale, want: "🏃\u200d♀️"},
+		{name: "male sign", emoji: "🏃", gender: GenderMale, want: "🏃\u200d♂️"},
+		{name: "person", emoji: "🧑\u200d🎨", gender: GenderFemale, want: "👩\u200d🎨"},
+		{name: "direction", emoji: "🧑\u200d🦯\u200d➡️", gender: GenderMale, want: "👨\u200d🦯\u200d➡️"},
+		{name: "neutral", emoji: "🏃", gender: GenderNeutral, want: "🏃"},
+		{name: "unsupported", emoji: "👍", gender: GenderMale, want: "👍"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ApplyGender(tt.emoji, tt.gender); got != tt.want {
+				t.Errorf("ApplyGender() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreferences_Apply(t *testing.T) {
+	preferences := Preferences{SkinTone: SkinToneMedium, Gender: GenderFemale}
+
+	if got, want := preferences.Apply("🧑\u200d🎨"), "👩🏽\u200d🎨"; got != want {
+		t.Errorf("Apply() = %q, want %q", got, want)
+	}
+}
+
+func TestEmojifier_Preferences(t *testing.T) {
+	dictionary := NewMapDictionary(map[string][]string{
+		"thumbs": {"👍"},
+		"artist": {"🧑\u200d🎨"},
+		"apple":  {"🍎"},
+	})
+	emojifier, err := NewEmojifier(ReplaceSubstring{}, 4, WithDictionary(dictionary),
+		WithSkinTone(SkinToneDark), WithGender(GenderMale))
+	if err != nil {
+		t.Fatalf("NewEmojifier() error = %v", err)
+	}
+
+	if got, want := emojifier.Emojify("thumbs artist apple"), "👍🏿 👨🏿\u200d🎨 🍎"; got != want {
+		t.Errorf("Emojify() = %q, want %q", got, want)
+	}
+	if got, want := emojifier.EmojifyWith("thumbs artist", Preferences{}), "👍 🧑\u200d🎨"; got != want {
+		t.Errorf("EmojifyWith() = %q, want %q", got, want)
+	}
+	if got, want := emojifier.EmojifyWith("artist", Preferences{Gender: GenderFemale}), "👩\u200d🎨"; got != want {
+		t.Errorf("EmojifyWith() = %q, want %q", got, want)
+	}
+}
+
+func TestEmojifier_PreferencesInsertStrategies(t *testing.T) {
+	dictionary := NewMapDictionary(map[string][]string{
+		"thumbsup": {"👍"},
+		"artist":   {"🧑\u200d🎨"},
+	})
+	preferences := []Option{WithDictionary(dictionary), WithSkinTone(SkinToneMedium), WithGender(GenderFemale)}
+	tests := []struct {
+		name     string
+		strategy EmojifyStrategy
+		input    string
+		want     string
+	}{
+		{"insert before skin tone", InsertBeforeString{}, "thumbsup", "👍🏽 thumbsup"},
+		{"insert after skin tone", InsertAfterString{}, "thumbsup", "thumbsup 👍🏽"},
+		{"insert after gender", InsertAfterString{}, "artist", "artist 👩🏽\u200d🎨"},
+		{"insert before gender", InsertBeforeString{}, "artist", "👩🏽\u200d🎨 artist"},
+		{"sentence end", InsertAtSentenceEnd{}, "the artist paints. thumbsup!", "the artist paints. 👩🏽\u200d🎨 thumbsup! 👍🏽"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emojifier, err := NewEmojifier(tt.strategy, 1, preferences...)
+			if err != nil {
+				t.Fatalf("NewEmojifier() error = %v", err)
+			}
+			if got := emojifier.Emojify(tt.input); got != tt.want {
+				t.Errorf("Emojify() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreferredDictionary_ContainsEmoji(t *testing.T) {
+	dictionary := preferredDictionary{
+		Dictionary:  NewMapDictionary(map[string][]string{"artist": {"🧑\u200d🎨"}, "peace": {"✌️"}}),
+		preferences: Preferences{SkinTone: SkinToneDark, Gender: GenderMale},
+	}
+	for _, emoji := range []string{"🧑\u200d🎨", "👨🏿\u200d🎨", "👨\u200d🎨", "✌🏿"} {
+		if !dictionary.ContainsEmoji(emoji) {
+			t.Errorf("ContainsEmoji(%q) = false, want true", emoji)
+		}
+	}
+	if dictionary.ContainsEmoji("👍🏿") {
+		t.Errorf("ContainsEmoji(%q) = true, want false", "👍🏿")
+	}
+}
+
+func TestEmojifier_PreferencesKeepLocale(t *testing.T) {
+	emojifier, err := NewEmojifier(ReplaceSubstring{}, 1, WithLocale("tr"), WithSkinTone(SkinToneLight),
+		WithDictionary(NewMapDictionary(map[string][]string{"ıyı": {"👍"}})))
+	if err != nil {
+		t.Fatalf("NewEmojifier() error = %v", err)
+	}
+
+	if got, want := emojifier.Emojify("IYI"), "👍🏻"; got != want {
+		t.Errorf("Emojify() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,156 @@
+package goemoji
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// KeywordSource tells where the relation between a keyword and an emoji comes from.
+type KeywordSource uint8
+
+const (
+	// SourceUnknown is used for dictionaries which do not record sources.
+	SourceUnknown KeywordSource = iota
+	// SourceDescription marks keywords which are the name of the emoji.
+	SourceDescription
+	// SourceAlias marks keywords which are an alternative name of the emoji.
+	SourceAlias
+	// SourceTag marks keywords which are loosely associated with the emoji.
+	SourceTag
+	// SourceCustom marks keywords which were added by curation or at runtime.
+	SourceCustom
+)
+
+// Default weights of the keyword sources. Higher weights are more relevant.
+const (
+	WeightTag         = 10
+	WeightUnknown     = 15
+	WeightAlias       = 20
+	WeightDescription = 30
+	WeightCustom      = 40
+)
+
+var keywordSourceNames = []string{
+	SourceUnknown:     "unknown",
+	SourceDescription: "description",
+	SourceAlias:       "alias",
+	SourceTag:         "tag",
+	SourceCustom:      "custom",
+}
+
+func (s KeywordSource) String() string {
+	if int(s) < len(keywordSourceNames) {
+		return keywordSourceNames[s]
+	}
+	return fmt.Sprintf("KeywordSource(%d)", s)
+}
+
+// MarshalText encodes the source as its name.
+func (s KeywordSource) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a source from its name.
+func (s *KeywordSource) UnmarshalText(text []byte) error {
+	i := slices.Index(keywordSourceNames, string(text))
+	if i < 0 {
+		return fmt.Errorf("unknown keyword source '%s'", text)
+	}
+	*s = KeywordSource(i)
+	return nil
+}
+
+// DefaultWeight returns the weight of keywords of the source.
+func (s KeywordSource) DefaultWeight() int {
+	switch s {
+	case SourceDescription:
+		return WeightDescription
+	case SourceAlias:
+		return WeightAlias
+	case SourceTag:
+		return WeightTag
+	case SourceCustom:
+		return WeightCustom
+	default:
+		return WeightUnknown
+	}
+}
+
+// WeightedEmoji is an emoji of a keyword together with the source and weight of their relation.
+type WeightedEmoji struct {
+	Emoji  string        `json:"emoji"`
+	Source KeywordSource `json:"source"`
+	Weight int           `json:"weight"`
+}
+
+// UnmarshalJSON decodes a WeightedEmoji from an object or, for maps without
+// sources, from a plain emoji string.
+func (w *WeightedEmoji) UnmarshalJSON(data []byte) error {
+	var emoji string
+	if err := json.Unmarshal(data, &emoji); err == nil {
+		*w = unweighted(emoji)
+		return nil
+	}
+
+	type plain WeightedEmoji
+	var decoded plain
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*w = WeightedEmoji(decoded)
+	return nil
+}
+
+// WeightedDictionary is implemented by dictionaries which know the source and weight
+// of the relation between their keywords and emojis.
+type WeightedDictionary interface {
+	Dictionary
+	// LookupWeighted returns the emojis of the keyword ordered by descending weight.
+	LookupWeighted(keyword string) (emojis []WeightedEmoji, ok bool)
+}
+
+// lookupWeighted looks up the keyword with weights. Emojis of dictionaries
+// without weights have SourceUnknown and its default weight.
+func lookupWeighted(dictionary Dictionary, keyword string) ([]WeightedEmoji, bool) {
+	if weighted, ok := dictionary.(WeightedDictionary); ok {
+		return weighted.LookupWeighted(keyword)
+	}
+	emojis, ok := dictionary.Lookup(keyword)
+	return weightEmojis(emojis, SourceUnknown), ok
+}
+
+func unweighted(emoji string) WeightedEmoji {
+	return WeightedEmoji{Emoji: emoji, Source: SourceUnknown, Weight: WeightUnknown}
+}
+
+// weightEmojis gives all emojis the source and its default weight.
+func weightEmojis(emojis []string, source KeywordSource) []WeightedEmoji {
+	if emojis == nil {
+		return nil
+	}
+	result := make([]WeightedEmoji, len(emojis))
+	for i, emoji := range emojis {
+		result[i] = WeightedEmoji{Emoji: emoji, Source: source, Weight: source.DefaultWeight()}
+	}
+	return result
+}
+
+// EmojiStrings returns the emojis without their weights.
+func EmojiStrings(emojis []WeightedEmoji) []string {
+	if emojis == nil {
+		return nil
+	}
+	result := make([]string, len(emojis))
+	for i, emoji := range emojis {
+		result[i] = emoji.Emoji
+	}
+	return result
+}
+
+// SortByWeight orders the emojis by descending weight, keeping the order of equal weights.
+func SortByWeight(emojis []WeightedEmoji) {
+	slices.SortStableFunc(emojis, func(a, b WeightedEmoji) int {
+		return b.Weight - a.Weight
+	})
+}
@@ -0,0 +1,119 @@
+package goemoji
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestKeywordSource_Text(t *testing.T) {
+	for _, source := range []KeywordSource{SourceUnknown, SourceDescription, SourceAlias, SourceTag, SourceCustom} {
+		text, err := source.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText() error = %v", err)
+		}
+		var decoded KeywordSource
+		if err := decoded.UnmarshalText(text); err != nil || decoded != source {
+			t.Errorf("UnmarshalText(%s) = %v, %v, want %v", text, decoded, err, source)
+		}
+	}
+
+	var source KeywordSource
+	if err := source.UnmarshalText([]byte("rumor")); err == nil {
+		t.Error("Expected an error for an unknown source")
+	}
+}
+
+func TestWeightedEmoji_UnmarshalJSON(t *testing.T) {
+	var emojis []WeightedEmoji
+	err := json.Unmarshal([]byte(`["🍎", {"emoji": "🍏", "source": "tag", "weight": 10}]`), &emojis)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	want := []WeightedEmoji{
+		{Emoji: "🍎", Source: SourceUnknown, Weight: WeightUnknown},
+		{Emoji: "🍏", Source: SourceTag, Weight: WeightTag},
+	}
+	if !reflect.DeepEqual(emojis, want) {
+		t.Errorf("Unmarshal() = %v, want %v", emojis, want)
+	}
+}
+
+func TestNewWeightedMapDictionary(t *testing.T) {
+	dictionary := NewWeightedMapDictionary(map[string][]WeightedEmoji{
+		"apple": {
+			{Emoji: "🍏", Source: SourceTag, Weight: WeightTag},
+			{Emoji: "🍎", Source: SourceDescription, Weight: WeightDescription},
+		},
+	})
+
+	if emojis, _ := dictionary.Lookup("apple"); !reflect.DeepEqual(emojis, []string{"🍎", "🍏"}) {
+		t.Errorf("Expected emojis ordered by weight, got %v", emojis)
+	}
+	weighted, _ := dictionary.LookupWeighted("apple")
+	if weighted[0].Source != SourceDescription {
+		t.Errorf("Expected description first, got %v", weighted)
+	}
+}
+
+func TestReadJSONDictionary_Weighted(t *testing.T) {
+	input := `{"apple": [
+		{"emoji": "🍏", "source": "tag", "weight": 10},
+		{"emoji": "🍎", "source": "description", "weight": 30}
+	]}`
+	dictionary, err := ReadJSONDictionary(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ReadJSONDictionary() error = %v", err)
+	}
+
+	if emojis, _ := dictionary.Lookup("apple"); !reflect.DeepEqual(emojis, []string{"🍎", "🍏"}) {
+		t.Errorf("Expected emojis ordered by weight, got %v", emojis)
+	}
+}
+
+func TestLookupWeighted(t *testing.T) {
+	overlay := newOverlayDictionary(testDictionary, nil, nil).withKeyword("deploy", []string{"🚀"})
+	localized := localizedDictionary{Dictionary: overlay, toLower: strings.ToLower}
+
+	tests := []struct {
+		keyword string
+		want    WeightedEmoji
+	}{
+		{keyword: "deploy", want: WeightedEmoji{Emoji: "🚀", Source: SourceCustom, Weight: WeightCustom}},
+		{keyword: "apple", want: WeightedEmoji{Emoji: "🍎", Source: SourceUnknown, Weight: WeightUnknown}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.keyword, func(t *testing.T) {
+			emojis, ok := lookupWeighted(localized, tt.keyword)
+			if !ok || emojis[0] != tt.want {
+				t.Errorf("lookupWeighted() = %v, %v, want %v", emojis, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultDictionary_Weights(t *testing.T) {
+	tests := []struct {
+		keyword string
+		want    []KeywordSource
+	}{
+		{keyword: "camel", want: []KeywordSource{SourceUnknown, SourceUnknown}},
+		{keyword: "party", want: []KeywordSource{SourceCustom, SourceUnknown, SourceUnknown, SourceUnknown}},
+		{keyword: "congrats", want: []KeywordSource{SourceCustom}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.keyword, func(t *testing.T) {
+			emojis, ok := lookupWeighted(defaultDictionary, tt.keyword)
+			if !ok || len(emojis) != len(tt.want) {
+				t.Fatalf("Expected %d emojis for %s, got %v", len(tt.want), tt.keyword, emojis)
+			}
+			for i, emoji := range emojis {
+				if emoji.Source != tt.want[i] || emoji.Weight != tt.want[i].DefaultWeight() {
+					t.Errorf("Expected candidate %d to come from %s, got %v", i, tt.want[i], emoji)
+				}
+			}
+		})
+	}
+}
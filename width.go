@@ -0,0 +1,144 @@
+package goemoji
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DisplayWidth returns the number of terminal cells the text occupies. Emojis,
+// including flags and ZWJ sequences, take two cells, wide and fullwidth East Asian
+// characters take two cells, combining marks and control characters take none.
+// Emojis displayed as text, like ❤ without U+FE0F or 😀 with U+FE0E, take one cell.
+func DisplayWidth(text string) int {
+	width := 0
+	for position := 0; position < len(text); {
+		length, clusterWidth := nextCluster(text[position:])
+		width += clusterWidth
+		position += length
+	}
+	return width
+}
+
+// Truncate shortens the text to at most width cells. If the text is shortened, the
+// ellipsis is appended within the width. Emoji sequences and characters with their
+// combining marks are never split. If the ellipsis does not fit, it is omitted.
+func Truncate(text string, width int, ellipsis string) string {
+	if DisplayWidth(text) <= width {
+		return text
+	}
+	limit := width - DisplayWidth(ellipsis)
+	if limit < 0 {
+		limit = width
+		ellipsis = ""
+	}
+
+	end, used := 0, 0
+	for end < len(text) {
+		length, clusterWidth := nextCluster(text[end:])
+		if used+clusterWidth > limit {
+			break
+		}
+		used += clusterWidth
+		end += length
+	}
+	return text[:end] + ellipsis
+}
+
+// Wrap breaks the text into lines of at most width cells. Lines are broken between
+// words and at existing line breaks; whitespace between words is collapsed into a
+// single space. Words wider than the width are broken between characters, but emoji
+// sequences are never split. A width below one returns the lines of the text unchanged.
+func Wrap(text string, width int) []string {
+	paragraphs := strings.Split(text, "\n")
+	if width < 1 {
+		return paragraphs
+	}
+
+	lines := make([]string, 0, len(paragraphs))
+	for _, paragraph := range paragraphs {
+		lines = append(lines, wrapParagraph(paragraph, width)...)
+	}
+	return lines
+}
+
+func wrapParagraph(paragraph string, width int) []string {
+	lines := make([]string, 0)
+	var line strings.Builder
+	lineWidth := 0
+	for _, word := range strings.Fields(paragraph) {
+		wordWidth := DisplayWidth(word)
+		switch {
+		case lineWidth > 0 && lineWidth+1+wordWidth <= width:
+			line.WriteString(" ")
+			line.WriteString(word)
+			lineWidth += 1 + wordWidth
+			continue
+		case lineWidth > 0:
+			lines = append(lines, line.String())
+			line.Reset()
+			lineWidth = 0
+		}
+
+		for wordWidth > width {
+			head := Truncate(word, width, "")
+			if head == "" {
+				// a single cluster is wider than the line and gets a line of its own
+				head = word[:clusterLength(word)]
+			}
+			lines = append(lines, head)
+			word = word[len(head):]
+			wordWidth = DisplayWidth(word)
+		}
+		line.WriteString(word)
+		lineWidth = wordWidth
+	}
+	if lineWidth > 0 || len(lines) == 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+// clusterLength returns the length of the first emoji sequence or character with its
+// combining marks.
+func clusterLength(text string) int {
+	length, _ := nextCluster(text)
+	return length
+}
+
+// nextCluster returns the length and the display width of the first emoji sequence
+// or character with its combining marks.
+func nextCluster(text string) (length, width int) {
+	length = emojiSequenceLength(text)
+	switch {
+	case strings.ContainsRune(text[:length], variationSelector15):
+		width = 1
+	case isEmojiSequence(text[:length]):
+		width = 2
+	default:
+		for _, r := range text[:length] {
+			width += runeWidth(r)
+		}
+	}
+
+	for length < len(text) {
+		r, size := utf8.DecodeRuneInString(text[length:])
+		if runeWidth(r) != 0 || unicode.IsControl(r) {
+			break
+		}
+		length += size
+	}
+	return length, width
+}
+
+// runeWidth returns the display width of a character outside of emoji sequences.
+func runeWidth(r rune) int {
+	switch {
+	case unicode.IsControl(r), unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case unicode.Is(eastAsianWide, r):
+		return 2
+	default:
+		return 1
+	}
+}
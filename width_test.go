@@ -0,0 +1,88 @@
+package goemoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{text: "", want: 0},
+		{text: "hello", want: 5},
+		{text: "😀", want: 2},
+		{text: "👍🏽", want: 2},
+		{text: "👨\u200d👩\u200d👧", want: 2},
+		{text: "🇩🇪", want: 2},
+		{text: "#️⃣", want: 2},
+		{text: "❤️", want: 2},
+		{text: "❤", want: 1},
+		{text: "❤\uFE0E", want: 1},
+		{text: "😀\uFE0E", want: 1},
+		{text: "日本語", want: 6},
+		{text: "ｈｉ", want: 4},
+		{text: "\u31EF\u2FFC", want: 4},
+		{text: "e\u0301", want: 1},
+		{text: "a\tb", want: 2},
+		{text: "Hi 👋 世界", want: 10},
+	}
+	for _, tt := range tests {
+		if got := DisplayWidth(tt.text); got != tt.want {
+			t.Errorf("DisplayWidth(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		width    int
+		ellipsis string
+		want     string
+	}{
+		{name: "fits", text: "hello", width: 5, ellipsis: "…", want: "hello"},
+		{name: "ascii", text: "hello world", width: 8, ellipsis: "...", want: "hello..."},
+		{name: "emoji kept whole", text: "ab👨\u200d👩\u200d👧cd", width: 4, ellipsis: "…", want: "ab…"},
+		{name: "emoji fits", text: "ab👨\u200d👩\u200d👧cd", width: 5, ellipsis: "…", want: "ab👨\u200d👩\u200d👧…"},
+		{name: "flag kept whole", text: "🇩🇪🇫🇷", width: 3, ellipsis: "", want: "🇩🇪"},
+		{name: "wide characters", text: "日本語テキスト", width: 7, ellipsis: "…", want: "日本語…"},
+		{name: "combining mark", text: "cafe\u0301s", width: 4, ellipsis: "", want: "cafe\u0301"},
+		{name: "ellipsis too wide", text: "hello", width: 2, ellipsis: "...", want: "he"},
+		{name: "zero width", text: "hello", width: 0, ellipsis: "…", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Truncate(tt.text, tt.width, tt.ellipsis); got != tt.want {
+				t.Errorf("Truncate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		width int
+		want  []string
+	}{
+		{name: "words", text: "the quick brown fox", width: 10, want: []string{"the quick", "brown fox"}},
+		{name: "emojis", text: "go 🚀 ship 👩\u200d💻 now", width: 7, want: []string{"go 🚀", "ship 👩\u200d💻", "now"}},
+		{name: "long word", text: "abcdefgh", width: 3, want: []string{"abc", "def", "gh"}},
+		{name: "long emoji word", text: "🇩🇪🇫🇷🇮🇹", width: 5, want: []string{"🇩🇪🇫🇷", "🇮🇹"}},
+		{name: "emoji wider than line", text: "😀😀", width: 1, want: []string{"😀", "😀"}},
+		{name: "line breaks", text: "a b\n\nc", width: 10, want: []string{"a b", "", "c"}},
+		{name: "collapsed whitespace", text: "a   b", width: 10, want: []string{"a b"}},
+		{name: "no width", text: "a b\nc", width: 0, want: []string{"a b", "c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Wrap(tt.text, tt.width); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Wrap() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}